@@ -0,0 +1,188 @@
+// Package bundle packs the sources, providers and engines used by a set of units into a portable
+// archive, and imports such an archive on a host without network access.
+package bundle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+)
+
+const (
+	// ManifestVersion is the version of the manifest format written by this release.
+	ManifestVersion = 1
+
+	// ManifestFileName is the name of the manifest file at the root of a bundle.
+	ManifestFileName = "manifest.json"
+
+	// CASDir is the bundle directory with the CAS store holding the unit sources.
+	CASDir = "cas"
+
+	// ProvidersDir is the bundle directory with the providers, in the filesystem mirror unpacked layout.
+	ProvidersDir = "providers"
+
+	// EngineDir is the bundle directory used as the engine cache path.
+	EngineDir = "engine"
+
+	// CLIConfigFileName is the name of the OpenTofu/Terraform CLI configuration written on import.
+	CLIConfigFileName = "terraform.rc"
+
+	// EnvFileName is the name of the file with the environment variables written on import.
+	EnvFileName = "bundle.env"
+)
+
+// Manifest describes the content of a bundle.
+type Manifest struct {
+	CreatedAt         time.Time         `json:"created_at"`
+	Checksums         map[string]string `json:"checksums"`
+	TerragruntVersion string            `json:"terragrunt_version"`
+	Platforms         []string          `json:"platforms"`
+	Sources           []*Source         `json:"sources"`
+	Providers         []*Provider       `json:"providers"`
+	Engines           []*Engine         `json:"engines"`
+	Version           int               `json:"version"`
+}
+
+// Source is a unit source stored in the bundle CAS.
+type Source struct {
+	// URL is the canonical source URL, without the `//subdir` selector.
+	URL string `json:"url"`
+	// Ref is the CAS reference of the stored tree.
+	Ref string `json:"ref"`
+	// Units are the units using the source, relative to the working directory.
+	Units []string `json:"units"`
+}
+
+// Provider is a provider version stored in the bundle for every bundle platform.
+type Provider struct {
+	Address string `json:"address"`
+	Version string `json:"version"`
+}
+
+// Engine is an engine stored in the bundle for the platform the bundle was created on.
+type Engine struct {
+	Source  string `json:"source"`
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+// readManifest reads the manifest from the given bundle directory.
+func readManifest(fsys vfs.FS, dir string) (*Manifest, error) {
+	data, err := vfs.ReadFile(fsys, filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("read bundle manifest: %w", err)
+	}
+
+	manifest := new(Manifest)
+
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("parse bundle manifest: %w", err)
+	}
+
+	if manifest.Version > ManifestVersion {
+		return nil, UnsupportedManifestVersionError{Version: manifest.Version}
+	}
+
+	return manifest, nil
+}
+
+// writeManifest writes the manifest to the given bundle directory.
+func writeManifest(fsys vfs.FS, dir string, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return vfs.WriteFile(fsys, filepath.Join(dir, ManifestFileName), data, filePerms)
+}
+
+// computeChecksums returns the sha256 checksums of all files in the bundle directory, except the manifest,
+// keyed by their slash-separated paths relative to the directory.
+func computeChecksums(fsys vfs.FS, dir string) (map[string]string, error) {
+	checksums := make(map[string]string)
+
+	err := vfs.WalkDir(fsys, dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+		if rel == ManifestFileName {
+			return nil
+		}
+
+		sum, err := fileChecksum(fsys, path)
+		if err != nil {
+			return err
+		}
+
+		checksums[rel] = sum
+
+		return nil
+	})
+
+	return checksums, err
+}
+
+// verifyChecksums checks that the files in the bundle directory match the manifest checksums exactly.
+func verifyChecksums(fsys vfs.FS, dir string, manifest *Manifest) error {
+	checksums, err := computeChecksums(fsys, dir)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(manifest.Checksums))
+	for name := range manifest.Checksums {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		actual, ok := checksums[name]
+		if !ok {
+			return MissingFileError{Path: name}
+		}
+
+		if expected := manifest.Checksums[name]; actual != expected {
+			return ChecksumMismatchError{Path: name, Expected: expected, Actual: actual}
+		}
+
+		delete(checksums, name)
+	}
+
+	for name := range checksums {
+		return UnexpectedFileError{Path: name}
+	}
+
+	return nil
+}
+
+func fileChecksum(fsys vfs.FS, path string) (string, error) {
+	file, err := fsys.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close() //nolint:errcheck
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,179 @@
+package bundle_test
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/bundle"
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/internal/git"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndImportBundle(t *testing.T) {
+	t.Parallel()
+
+	srv, err := git.NewServer()
+	if err != nil {
+		t.Skipf("git is not available: %v", err)
+	}
+
+	t.Cleanup(func() { _ = srv.Close() })
+
+	mainTF := []byte(`resource "null_resource" "app" {}`)
+	require.NoError(t, srv.CommitFile(t.Context(), "modules/app/main.tf", mainTF, "add app module"))
+
+	repoURL, err := srv.Start(t.Context())
+	require.NoError(t, err)
+
+	rootDir := helpers.TmpDirWOSymlinks(t)
+
+	for _, name := range []string{"app", "app-copy"} {
+		unitDir := filepath.Join(rootDir, name)
+		require.NoError(t, os.MkdirAll(unitDir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(unitDir, "terragrunt.hcl"), []byte(`terraform {
+  source = "git::`+repoURL+`//modules/app"
+}
+`), 0o644))
+	}
+
+	opts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	l := logger.CreateLogger()
+	v := run.OSVenv()
+	out := filepath.Join(helpers.TmpDirWOSymlinks(t), "bundle.zip")
+
+	manifest, err := bundle.Create(t.Context(), l, v, opts, &bundle.CreateOptions{Out: out})
+	require.NoError(t, err)
+
+	require.Len(t, manifest.Sources, 1)
+	assert.Equal(t, []string{"app", "app-copy"}, manifest.Sources[0].Units)
+	assert.Empty(t, manifest.Providers)
+	assert.Empty(t, manifest.Engines)
+	assert.Equal(t, []string{bundle.DefaultPlatform()}, manifest.Platforms)
+	assert.NotEmpty(t, manifest.Checksums)
+
+	// The upstream repository is gone on the air-gapped side.
+	require.NoError(t, srv.Close())
+
+	importDir := filepath.Join(helpers.TmpDirWOSymlinks(t), "bundle")
+	storePath := filepath.Join(helpers.TmpDirWOSymlinks(t), "store")
+
+	imported, err := bundle.Import(l, v, &bundle.ImportOptions{File: out, Dir: importDir, CASStorePath: storePath})
+	require.NoError(t, err)
+	assert.Equal(t, manifest.Sources, imported.Sources)
+
+	assert.FileExists(t, filepath.Join(importDir, bundle.CLIConfigFileName))
+	assert.FileExists(t, filepath.Join(importDir, bundle.EnvFileName))
+	assert.NoDirExists(t, filepath.Join(importDir, bundle.CASDir))
+
+	c, err := cas.New(cas.WithStorePath(storePath))
+	require.NoError(t, err)
+
+	casVenv := cas.Venv{FS: v.FS}
+
+	index, err := c.ReadSourceIndex(casVenv)
+	require.NoError(t, err)
+
+	ref, ok := index.Resolve(manifest.Sources[0].URL + "//modules/app")
+	require.True(t, ok)
+	assert.Equal(t, manifest.Sources[0].Ref+"//modules/app", ref)
+
+	hash, err := cas.ParseCASRef(manifest.Sources[0].Ref[len(cas.CASProtocolPrefix):])
+	require.NoError(t, err)
+
+	dst := filepath.Join(helpers.TmpDirWOSymlinks(t), "materialized")
+	require.NoError(t, c.MaterializeTree(t.Context(), l, casVenv, hash, dst))
+
+	content, err := os.ReadFile(filepath.Join(dst, "modules", "app", "main.tf"))
+	require.NoError(t, err)
+	assert.Equal(t, mainTF, content)
+
+	// Importing again replaces the previously imported bundle.
+	_, err = bundle.Import(l, v, &bundle.ImportOptions{File: out, Dir: importDir, CASStorePath: storePath})
+	require.NoError(t, err)
+}
+
+func TestImportBundleChecksumMismatch(t *testing.T) {
+	t.Parallel()
+
+	manifest := &bundle.Manifest{
+		Version:   bundle.ManifestVersion,
+		Checksums: map[string]string{"providers/README": "0000"},
+	}
+
+	out := filepath.Join(helpers.TmpDirWOSymlinks(t), "bundle.zip")
+	writeZip(t, out, map[string][]byte{
+		bundle.ManifestFileName: mustJSON(t, manifest),
+		"providers/README":      []byte("tampered"),
+	})
+
+	importDir := filepath.Join(helpers.TmpDirWOSymlinks(t), "bundle")
+
+	_, err := bundle.Import(logger.CreateLogger(), run.OSVenv(), &bundle.ImportOptions{
+		File:         out,
+		Dir:          importDir,
+		CASStorePath: filepath.Join(helpers.TmpDirWOSymlinks(t), "store"),
+	})
+
+	var mismatchErr bundle.ChecksumMismatchError
+
+	require.ErrorAs(t, err, &mismatchErr)
+	assert.Equal(t, "providers/README", mismatchErr.Path)
+	assert.NoDirExists(t, importDir)
+}
+
+func TestImportBundleRefusesNonEmptyDir(t *testing.T) {
+	t.Parallel()
+
+	importDir := helpers.TmpDirWOSymlinks(t)
+	require.NoError(t, os.WriteFile(filepath.Join(importDir, "keep.txt"), []byte("keep"), 0o644))
+
+	_, err := bundle.Import(logger.CreateLogger(), run.OSVenv(), &bundle.ImportOptions{
+		File: filepath.Join(importDir, "missing.zip"),
+		Dir:  importDir,
+	})
+
+	var dirErr bundle.DirNotEmptyError
+
+	require.ErrorAs(t, err, &dirErr)
+	assert.FileExists(t, filepath.Join(importDir, "keep.txt"))
+}
+
+func writeZip(t *testing.T, path string, files map[string][]byte) {
+	t.Helper()
+
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	writer := zip.NewWriter(file)
+
+	for name, content := range files {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.Close())
+	require.NoError(t, file.Close())
+}
+
+func mustJSON(t *testing.T, v any) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return data
+}
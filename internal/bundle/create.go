@@ -0,0 +1,559 @@
+package bundle
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/engine"
+	"github.com/gruntwork-io/terragrunt/internal/getter"
+	"github.com/gruntwork-io/terragrunt/internal/git"
+	"github.com/gruntwork-io/terragrunt/internal/prepare"
+	"github.com/gruntwork-io/terragrunt/internal/providercache"
+	pcoptions "github.com/gruntwork-io/terragrunt/internal/providercache/options"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run"
+	"github.com/gruntwork-io/terragrunt/internal/tf"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/handlers"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/services"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cliconfig"
+	"github.com/gruntwork-io/terragrunt/internal/tf/getproviders"
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/internal/version"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	filePerms = 0o644
+	dirPerms  = 0o755
+
+	// providerCacheRequestID groups the provider caching requests made while creating a bundle.
+	providerCacheRequestID = "bundle"
+)
+
+// CreateOptions configures the bundle creation.
+type CreateOptions struct {
+	// Out is the path of the bundle archive to write.
+	Out string
+	// Platforms are the provider platforms to bundle, in the `os_arch` form. Defaults to the current platform.
+	Platforms []string
+}
+
+// DefaultPlatform returns the platform Terragrunt runs on, in the `os_arch` form.
+func DefaultPlatform() string {
+	return runtime.GOOS + "_" + runtime.GOARCH
+}
+
+// unit holds what a discovered unit needs from the bundle.
+type unit struct {
+	engine    *engine.EngineConfig
+	providers map[string]string
+	path      string
+	source    string
+}
+
+// Create bundles the sources, providers and engines of the units discovered in the working directory
+// into a single archive written to `createOpts.Out`.
+func Create(ctx context.Context, l log.Logger, v run.Venv, opts *options.TerragruntOptions, createOpts *CreateOptions) (*Manifest, error) {
+	platforms := createOpts.Platforms
+	if len(platforms) == 0 {
+		platforms = []string{DefaultPlatform()}
+	}
+
+	units, err := discoverUnits(ctx, l, v, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	stagingDir, err := vfs.MkdirTemp(v.FS, "", "terragrunt-bundle-*")
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := v.FS.RemoveAll(stagingDir); err != nil {
+			l.Warnf("Failed to remove bundle staging directory %s: %v", stagingDir, err)
+		}
+	}()
+
+	manifest := &Manifest{
+		Version:           ManifestVersion,
+		CreatedAt:         time.Now().UTC(),
+		TerragruntVersion: version.GetVersion(),
+		Platforms:         platforms,
+	}
+
+	if manifest.Sources, err = bundleSources(ctx, l, v, opts, stagingDir, units); err != nil {
+		return nil, err
+	}
+
+	if manifest.Providers, err = bundleProviders(ctx, l, v, stagingDir, units, platforms); err != nil {
+		return nil, err
+	}
+
+	if manifest.Engines, err = bundleEngines(ctx, l, opts, stagingDir, units); err != nil {
+		return nil, err
+	}
+
+	// The git store only speeds up later clones, the trees are materialized from the tree stores.
+	if err := v.FS.RemoveAll(filepath.Join(stagingDir, CASDir, "git")); err != nil {
+		return nil, err
+	}
+
+	if manifest.Checksums, err = computeChecksums(v.FS, stagingDir); err != nil {
+		return nil, err
+	}
+
+	if err := writeManifest(v.FS, stagingDir, manifest); err != nil {
+		return nil, err
+	}
+
+	if err := writeArchive(v.FS, stagingDir, createOpts.Out); err != nil {
+		return nil, fmt.Errorf("write bundle %s: %w", createOpts.Out, err)
+	}
+
+	l.Infof(
+		"Bundle %s created with %d source(s), %d provider(s) and %d engine(s)",
+		createOpts.Out, len(manifest.Sources), len(manifest.Providers), len(manifest.Engines),
+	)
+
+	return manifest, nil
+}
+
+// discoverUnits discovers the units in the working directory and reads their sources, locked providers and engines.
+func discoverUnits(ctx context.Context, l log.Logger, v run.Venv, opts *options.TerragruntOptions) ([]*unit, error) {
+	d := discovery.NewDiscovery(opts.WorkingDir).WithExec(v.Exec)
+
+	components, err := d.Discover(ctx, l, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	configFilename := config.DefaultTerragruntConfigPath
+	if len(opts.TerragruntConfigPath) > 0 {
+		configFilename = filepath.Base(opts.TerragruntConfigPath)
+	}
+
+	var (
+		units []*unit
+		errs  []error
+	)
+
+	for _, c := range components.Filter(component.UnitKind).Sort() {
+		unitOpts := opts.Clone()
+		unitOpts.WorkingDir = c.Path()
+		unitOpts.TerragruntConfigPath = filepath.Join(c.Path(), configFilename)
+		unitOpts.OriginalTerragruntConfigPath = unitOpts.TerragruntConfigPath
+
+		unit, err := readUnit(ctx, l, v, unitOpts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("read unit %s: %w", c.Path(), err))
+			continue
+		}
+
+		if unit.path, err = filepath.Rel(opts.WorkingDir, c.Path()); err != nil {
+			return nil, err
+		}
+
+		unit.path = filepath.ToSlash(unit.path)
+		units = append(units, unit)
+	}
+
+	return units, errors.Join(errs...)
+}
+
+func readUnit(ctx context.Context, l log.Logger, v run.Venv, opts *options.TerragruntOptions) (*unit, error) {
+	prepared, err := prepare.PrepareConfig(ctx, l, v, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := prepared.Cfg
+	unit := new(unit)
+
+	if unit.engine, err = cfg.EngineOptions(); err != nil {
+		return nil, err
+	}
+
+	sourceURL, err := config.GetTerraformSourceURL("", opts.SourceMap, opts.TerragruntConfigPath, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceURL != "" {
+		src, err := tf.NewSource(l, sourceURL, "", opts.WorkingDir, false)
+		if err != nil {
+			return nil, err
+		}
+
+		// Local sources are part of the repository and are not bundled.
+		if !tf.IsLocalSource(src.CanonicalSourceURL) {
+			unit.source = src.CanonicalSourceURL.String()
+		}
+	}
+
+	if unit.providers, err = getproviders.LockedProviderVersions(opts.WorkingDir); err != nil {
+		return nil, err
+	}
+
+	if len(unit.providers) == 0 {
+		l.Warnf("Unit %s has no %s file, its providers are not bundled", opts.WorkingDir, tf.TerraformLockFile)
+	}
+
+	return unit, nil
+}
+
+// bundleSources fetches the unit sources through the Terragrunt CAS and stores them in the bundle CAS.
+func bundleSources(
+	ctx context.Context,
+	l log.Logger,
+	v run.Venv,
+	opts *options.TerragruntOptions,
+	stagingDir string,
+	units []*unit,
+) ([]*Source, error) {
+	sources := []*Source{}
+
+	for _, unit := range units {
+		if unit.source == "" {
+			continue
+		}
+
+		idx := slices.IndexFunc(sources, func(source *Source) bool { return source.URL == unit.source })
+		if idx == -1 {
+			sources = append(sources, &Source{URL: unit.source})
+			idx = len(sources) - 1
+		}
+
+		sources[idx].Units = append(sources[idx].Units, unit.path)
+	}
+
+	if len(sources) == 0 {
+		return sources, nil
+	}
+
+	if err := cas.ValidateCASCloneDepth(opts.CASCloneDepth); err != nil {
+		return nil, err
+	}
+
+	hostCAS, err := cas.New(cas.WithCloneDepth(opts.CASCloneDepth), cas.WithRemote(opts.CASRemote))
+	if err != nil {
+		return nil, err
+	}
+
+	bundleCAS, err := cas.New(cas.WithStorePath(filepath.Join(stagingDir, CASDir)))
+	if err != nil {
+		return nil, err
+	}
+
+	gitRunner, err := git.NewGitRunner(v.Exec)
+	if err != nil {
+		return nil, err
+	}
+
+	casVenv := cas.Venv{FS: v.FS, Git: gitRunner}
+
+	for _, source := range sources {
+		treeKey, err := fetchSource(ctx, l, v, opts, hostCAS, bundleCAS, casVenv, source.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		source.Ref = cas.FormatCASRef(treeKey)
+
+		l.Infof("Bundled source %s as %s", source.URL, source.Ref)
+	}
+
+	return sources, nil
+}
+
+// fetchSource downloads the source through the Terragrunt CAS and ingests it into the bundle CAS, returning the tree key.
+func fetchSource(
+	ctx context.Context,
+	l log.Logger,
+	v run.Venv,
+	opts *options.TerragruntOptions,
+	hostCAS, bundleCAS *cas.CAS,
+	casVenv cas.Venv,
+	sourceURL string,
+) (string, error) {
+	tempDir, cleanup, err := hostCAS.MakeFetchTempDir(l, casVenv)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	dst := filepath.Join(tempDir, "source")
+
+	client := getter.NewClient(
+		getter.WithLogger(l),
+		getter.WithTFRegistry(getter.NewRegistryGetter(l, v.FS).WithTofuImplementation(opts.TofuImplementation)),
+		getter.WithCAS(hostCAS, casVenv, &cas.CloneOptions{Dir: dst, Mutable: true}),
+	)
+
+	if _, err := client.Get(ctx, &getter.Request{
+		Src:     sourceURL,
+		Dst:     dst,
+		GetMode: getter.ModeAny,
+	}); err != nil {
+		return "", fmt.Errorf("download source %s: %w", sourceURL, err)
+	}
+
+	return bundleCAS.IngestDirectory(l, casVenv, dst, "")
+}
+
+// bundleProviders caches the locked providers for every platform into the bundle, using the filesystem mirror layout.
+func bundleProviders(
+	ctx context.Context,
+	l log.Logger,
+	v run.Venv,
+	stagingDir string,
+	units []*unit,
+	platforms []string,
+) ([]*Provider, error) {
+	providers := []*Provider{}
+
+	for _, unit := range units {
+		for address, version := range unit.providers {
+			if !slices.ContainsFunc(providers, func(provider *Provider) bool {
+				return provider.Address == address && provider.Version == version
+			}) {
+				providers = append(providers, &Provider{Address: address, Version: version})
+			}
+		}
+	}
+
+	if len(providers) == 0 {
+		return providers, nil
+	}
+
+	slices.SortFunc(providers, func(a, b *Provider) int {
+		return strings.Compare(a.Address+" "+a.Version, b.Address+" "+b.Version)
+	})
+
+	cliCfg, err := cliconfig.LoadUserConfig(cliconfig.WithFS(v.FS))
+	if err != nil {
+		return nil, err
+	}
+
+	registryNames := providercache.AppendCustomHostRegistries(cliCfg.Hosts, pcoptions.DefaultRegistryNames)
+
+	providerHandlers, err := handlers.NewProviderHandlers(cliCfg, l, registryNames)
+	if err != nil {
+		return nil, fmt.Errorf("creating provider handlers failed: %w", err)
+	}
+
+	// The user plugins directory does not exist, so providers are copied into the bundle instead of linked.
+	userProviderDir := filepath.Join(stagingDir, ".user-plugins")
+
+	providerService := services.NewProviderService(
+		filepath.Join(stagingDir, ProvidersDir),
+		userProviderDir,
+		cliCfg.CredentialsSource(),
+		l,
+		services.WithFS(v.FS),
+	)
+
+	serviceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	runErrCh := make(chan error, 1)
+
+	go func() {
+		runErrCh <- providerService.Run(serviceCtx)
+	}()
+
+	select {
+	case <-providerService.Ready():
+	case err := <-runErrCh:
+		return nil, err
+	}
+
+	cacheErr := cacheProviders(ctx, providerService, providerHandlers, providers, platforms)
+
+	cancel()
+
+	if err := errors.Join(cacheErr, <-runErrCh); err != nil {
+		return nil, err
+	}
+
+	for _, provider := range providers {
+		l.Infof("Bundled provider %s v%s for %s", provider.Address, provider.Version, strings.Join(platforms, ", "))
+	}
+
+	return providers, nil
+}
+
+func cacheProviders(
+	ctx context.Context,
+	providerService *services.ProviderService,
+	providerHandlers handlers.ProviderHandlers,
+	providers []*Provider,
+	platforms []string,
+) error {
+	for _, provider := range providers {
+		for _, platform := range platforms {
+			target := models.ParseProvider(provider.Address)
+			target.Version = provider.Version
+			target.OS, target.Arch, _ = strings.Cut(platform, "_")
+
+			for _, handler := range providerHandlers {
+				if !handler.CanHandleProvider(target) {
+					continue
+				}
+
+				resp, err := handler.GetPlatform(ctx, target)
+				if err != nil {
+					providerService.Logger().Debugf("Failed to get provider platform from %q: %v", handler, err)
+				}
+
+				if resp != nil {
+					target.ResponseBody = resp
+					break
+				}
+			}
+
+			if target.ResponseBody == nil {
+				return ProviderNotFoundError{Address: provider.Address, Version: provider.Version, Platform: platform}
+			}
+
+			providerService.CacheProvider(ctx, providerCacheRequestID, target)
+		}
+	}
+
+	_, err := providerService.WaitForCacheReady(providerCacheRequestID)
+
+	return err
+}
+
+// bundleEngines downloads the unit engines into the bundle engine cache.
+func bundleEngines(
+	ctx context.Context,
+	l log.Logger,
+	opts *options.TerragruntOptions,
+	stagingDir string,
+	units []*unit,
+) ([]*Engine, error) {
+	engines := []*Engine{}
+
+	engineOpts := new(engine.EngineOptions)
+	if opts.EngineOptions != nil {
+		*engineOpts = *opts.EngineOptions
+	}
+
+	engineOpts.CachePath = filepath.Join(stagingDir, EngineDir)
+
+	ctx = engine.WithEngineValues(ctx)
+
+	for _, unit := range units {
+		engineCfg := unit.engine
+		if engineCfg == nil {
+			continue
+		}
+
+		if util.FileExists(engineCfg.Source) {
+			l.Warnf("Engine %s of unit %s is a local file and is not bundled", engineCfg.Source, unit.path)
+			continue
+		}
+
+		if engineCfg.Version == "" {
+			l.Warnf("Engine %s of unit %s has no version, pin the bundled version to run it without network access", engineCfg.Source, unit.path)
+		}
+
+		if err := engine.Download(ctx, l, &engine.ExecutionOptions{
+			EngineOptions: engineOpts,
+			EngineConfig:  engineCfg,
+		}); err != nil {
+			return nil, fmt.Errorf("download engine %s for unit %s: %w", engineCfg.Source, unit.path, err)
+		}
+
+		bundled := &Engine{Source: engineCfg.Source, Type: engineCfg.Type, Version: engineCfg.Version}
+
+		if !slices.ContainsFunc(engines, func(engine *Engine) bool { return *engine == *bundled }) {
+			engines = append(engines, bundled)
+		}
+	}
+
+	return engines, nil
+}
+
+// writeArchive writes the content of the directory into a zip archive. The archive is written
+// to a temporary file first, so an interrupted run never leaves a truncated bundle behind.
+func writeArchive(fsys vfs.FS, dir, out string) error {
+	if err := fsys.MkdirAll(filepath.Dir(out), dirPerms); err != nil {
+		return err
+	}
+
+	tempFile := out + ".tmp"
+
+	file, err := fsys.OpenFile(tempFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, filePerms)
+	if err != nil {
+		return err
+	}
+
+	writer := zip.NewWriter(file)
+
+	walkErr := vfs.WalkDir(fsys, dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		return addArchiveFile(fsys, writer, dir, path)
+	})
+
+	if err := errors.Join(walkErr, writer.Close(), file.Close()); err != nil {
+		return errors.Join(err, fsys.Remove(tempFile))
+	}
+
+	return fsys.Rename(tempFile, out)
+}
+
+func addArchiveFile(fsys vfs.FS, writer *zip.Writer, dir, path string) error {
+	info, err := fsys.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return err
+	}
+
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+
+	header.Name = filepath.ToSlash(rel)
+	header.Method = zip.Deflate
+
+	w, err := writer.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	file, err := fsys.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close() //nolint:errcheck
+
+	_, err = io.Copy(w, file)
+
+	return err
+}
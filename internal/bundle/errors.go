@@ -0,0 +1,63 @@
+package bundle
+
+import (
+	"fmt"
+)
+
+// ChecksumMismatchError is returned when a bundle file does not match the manifest checksum.
+type ChecksumMismatchError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (err ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("bundle file %s has checksum %s, expected %s", err.Path, err.Actual, err.Expected)
+}
+
+// MissingFileError is returned when a file listed in the manifest is missing from the bundle.
+type MissingFileError struct {
+	Path string
+}
+
+func (err MissingFileError) Error() string {
+	return fmt.Sprintf("bundle file %s listed in the manifest is missing", err.Path)
+}
+
+// UnexpectedFileError is returned when the bundle contains a file not listed in the manifest.
+type UnexpectedFileError struct {
+	Path string
+}
+
+func (err UnexpectedFileError) Error() string {
+	return fmt.Sprintf("bundle file %s is not listed in the manifest", err.Path)
+}
+
+// UnsupportedManifestVersionError is returned when the bundle was created by a newer Terragrunt release.
+type UnsupportedManifestVersionError struct {
+	Version int
+}
+
+func (err UnsupportedManifestVersionError) Error() string {
+	return fmt.Sprintf("bundle manifest version %d is not supported, upgrade Terragrunt to import this bundle", err.Version)
+}
+
+// DirNotEmptyError is returned when the import directory is not empty and does not hold a previously imported bundle.
+type DirNotEmptyError struct {
+	Dir string
+}
+
+func (err DirNotEmptyError) Error() string {
+	return fmt.Sprintf("bundle directory %s is not empty and does not contain an imported bundle", err.Dir)
+}
+
+// ProviderNotFoundError is returned when no registry serves the provider package for a platform.
+type ProviderNotFoundError struct {
+	Address  string
+	Version  string
+	Platform string
+}
+
+func (err ProviderNotFoundError) Error() string {
+	return fmt.Sprintf("provider %s v%s is not available for %s", err.Address, err.Version, err.Platform)
+}
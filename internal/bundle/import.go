@@ -0,0 +1,244 @@
+package bundle
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// ImportOptions configures the bundle import.
+type ImportOptions struct {
+	// File is the path of the bundle archive.
+	File string
+	// Dir is the directory the bundle providers and engines are extracted to.
+	Dir string
+	// CASStorePath is the CAS store the bundle sources are imported into. Defaults to the Terragrunt CAS store.
+	CASStorePath string
+}
+
+// Import verifies the bundle archive, imports its sources into the CAS store and extracts its providers and
+// engines into `importOpts.Dir`, along with a CLI configuration using the providers as a filesystem mirror and
+// an env file pointing OpenTofu/Terraform and Terragrunt at them.
+func Import(l log.Logger, v run.Venv, importOpts *ImportOptions) (*Manifest, error) {
+	dir, err := filepath.Abs(importOpts.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkImportDir(v.FS, dir); err != nil {
+		return nil, err
+	}
+
+	if err := v.FS.MkdirAll(filepath.Dir(dir), dirPerms); err != nil {
+		return nil, err
+	}
+
+	tempDir, err := vfs.MkdirTemp(v.FS, filepath.Dir(dir), "."+filepath.Base(dir)+"-*")
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := v.FS.RemoveAll(tempDir); err != nil {
+			l.Warnf("Failed to remove bundle extraction directory %s: %v", tempDir, err)
+		}
+	}()
+
+	if err := vfs.NewZipDecompressor().Unzip(l, v.FS, tempDir, importOpts.File, 0); err != nil {
+		return nil, fmt.Errorf("extract bundle %s: %w", importOpts.File, err)
+	}
+
+	manifest, err := readManifest(v.FS, tempDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyChecksums(v.FS, tempDir, manifest); err != nil {
+		return nil, err
+	}
+
+	casStorePath, err := importSources(v.FS, tempDir, manifest, importOpts.CASStorePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sources now live in the CAS store, the bundle directory keeps only the providers and engines.
+	if err := v.FS.RemoveAll(filepath.Join(tempDir, CASDir)); err != nil {
+		return nil, err
+	}
+
+	if err := v.FS.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+
+	if err := v.FS.Rename(tempDir, dir); err != nil {
+		return nil, err
+	}
+
+	if err := writeCLIConfig(v.FS, dir); err != nil {
+		return nil, err
+	}
+
+	if err := writeEnvFile(v.FS, dir); err != nil {
+		return nil, err
+	}
+
+	l.Infof(
+		"Imported %d source(s) into the CAS store %s, %d provider(s) and %d engine(s) into %s",
+		len(manifest.Sources), casStorePath, len(manifest.Providers), len(manifest.Engines), dir,
+	)
+	l.Infof("Load the bundle environment before running Terragrunt: `set -a; . %s; set +a`", filepath.Join(dir, EnvFileName))
+
+	return manifest, nil
+}
+
+// checkImportDir checks that the import directory is missing, empty, or holds a previously imported bundle,
+// so an import never replaces unrelated files.
+func checkImportDir(fsys vfs.FS, dir string) error {
+	info, err := fsys.Stat(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !info.IsDir() {
+		return DirNotEmptyError{Dir: dir}
+	}
+
+	if ok, err := vfs.FileExists(fsys, filepath.Join(dir, ManifestFileName)); err != nil || ok {
+		return err
+	}
+
+	file, err := fsys.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer file.Close() //nolint:errcheck
+
+	if _, err := file.Readdirnames(1); errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	return DirNotEmptyError{Dir: dir}
+}
+
+// importSources copies the bundle CAS into the CAS store and records the bundle sources in the store source index.
+func importSources(fsys vfs.FS, bundleDir string, manifest *Manifest, storePath string) (string, error) {
+	var casOpts []cas.Option
+	if storePath != "" {
+		casOpts = append(casOpts, cas.WithStorePath(storePath))
+	}
+
+	c, err := cas.New(casOpts...)
+	if err != nil {
+		return "", err
+	}
+
+	if len(manifest.Sources) == 0 {
+		return c.StorePath(), nil
+	}
+
+	srcDir := filepath.Join(bundleDir, CASDir)
+
+	err = vfs.WalkDir(fsys, srcDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+
+		return copyStoreFile(fsys, path, filepath.Join(c.StorePath(), rel))
+	})
+	if err != nil {
+		return "", fmt.Errorf("import bundle sources into %s: %w", c.StorePath(), err)
+	}
+
+	casVenv := cas.Venv{FS: fsys}
+
+	index, err := c.ReadSourceIndex(casVenv)
+	if err != nil {
+		return "", err
+	}
+
+	for _, source := range manifest.Sources {
+		index[source.URL] = source.Ref
+	}
+
+	if err := c.WriteSourceIndex(casVenv, index); err != nil {
+		return "", err
+	}
+
+	return c.StorePath(), nil
+}
+
+// copyStoreFile copies a content-addressed file into the store, keeping its mode. Files already in the store
+// hold the same content and are left untouched.
+func copyStoreFile(fsys vfs.FS, src, dst string) error {
+	if ok, err := vfs.FileExists(fsys, dst); err != nil || ok {
+		return err
+	}
+
+	info, err := fsys.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	if err := fsys.MkdirAll(filepath.Dir(dst), cas.DefaultDirPerms); err != nil {
+		return err
+	}
+
+	data, err := vfs.ReadFile(fsys, src)
+	if err != nil {
+		return err
+	}
+
+	tempFile := dst + ".tmp"
+
+	if err := vfs.WriteFile(fsys, tempFile, data, info.Mode().Perm()); err != nil {
+		return err
+	}
+
+	return fsys.Rename(tempFile, dst)
+}
+
+// writeCLIConfig writes an OpenTofu/Terraform CLI configuration that installs providers only from the bundle.
+func writeCLIConfig(fsys vfs.FS, dir string) error {
+	providersDir := filepath.Join(dir, ProvidersDir)
+
+	if err := fsys.MkdirAll(providersDir, dirPerms); err != nil {
+		return err
+	}
+
+	content := fmt.Sprintf(`provider_installation {
+  filesystem_mirror {
+    path = %q
+  }
+}
+`, filepath.ToSlash(providersDir))
+
+	return vfs.WriteFile(fsys, filepath.Join(dir, CLIConfigFileName), []byte(content), filePerms)
+}
+
+// writeEnvFile writes the environment variables pointing OpenTofu/Terraform and Terragrunt at the bundle.
+func writeEnvFile(fsys vfs.FS, dir string) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "TF_CLI_CONFIG_FILE=%q\n", filepath.Join(dir, CLIConfigFileName))
+	fmt.Fprintf(&sb, "TG_ENGINE_CACHE_PATH=%q\n", filepath.Join(dir, EngineDir))
+
+	return vfs.WriteFile(fsys, filepath.Join(dir, EnvFileName), []byte(sb.String()), filePerms)
+}
@@ -6,6 +6,7 @@
 package cas
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha1"
 	"encoding/hex"
@@ -94,12 +95,15 @@ func WithMutable(mutable bool) CloneOption {
 
 // CAS clones a git repository using content-addressable storage.
 type CAS struct {
-	blobStore  *Store
-	treeStore  *Store
-	synthStore *Store
-	gitStore   *GitStore
-	storePath  string
-	cloneDepth int
+	remote      Remote
+	blobStore   *Store
+	treeStore   *Store
+	synthStore  *Store
+	commitStore *Store
+	gitStore    *GitStore
+	remoteOpts  RemoteOptions
+	storePath   string
+	cloneDepth  int
 }
 
 // WithStorePath specifies a custom path for the content store.
@@ -141,8 +145,18 @@ func New(opts ...Option) (*CAS, error) {
 	c.blobStore = NewStore(filepath.Join(c.storePath, "blobs"))
 	c.treeStore = NewStore(filepath.Join(c.storePath, "trees"))
 	c.synthStore = NewStore(filepath.Join(c.storePath, "synth", "trees"))
+	c.commitStore = NewStore(filepath.Join(c.storePath, "commits"))
 	c.gitStore = NewGitStore(filepath.Join(c.storePath, "git"))
 
+	if c.remoteOpts.URL != "" {
+		remote, err := NewRemote(c.remoteOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		c.remote = remote
+	}
+
 	return c, nil
 }
 
@@ -155,6 +169,9 @@ func (c *CAS) TreeStore() *Store { return c.treeStore }
 // SynthStore returns the store for synthetic tree content.
 func (c *CAS) SynthStore() *Store { return c.synthStore }
 
+// CommitStore returns the store for the commit objects git-derived trees are listed from.
+func (c *CAS) CommitStore() *Store { return c.commitStore }
+
 // StorePath returns the root directory containing every CAS store.
 func (c *CAS) StorePath() string { return c.storePath }
 
@@ -170,7 +187,7 @@ func (c *CAS) ensureStorePaths(v Venv) error {
 		return fmt.Errorf("create CAS store path: %w", err)
 	}
 
-	for _, s := range []*Store{c.blobStore, c.treeStore, c.synthStore} {
+	for _, s := range []*Store{c.blobStore, c.treeStore, c.synthStore, c.commitStore} {
 		if err := v.FS.MkdirAll(s.Path(), DefaultDirPerms); err != nil {
 			return fmt.Errorf("create CAS store subdirectory %s: %w", s.Path(), err)
 		}
@@ -636,6 +653,10 @@ func (c *CAS) storeTreeRecursive(
 		return err
 	}
 
+	if err := c.storeCommit(ctx, l, v, runner, hash); err != nil {
+		return err
+	}
+
 	treeContent := NewContent(c.treeStore)
 	if err := treeContent.EnsureWithWait(l, v, hash, tree.Data()); err != nil {
 		return err
@@ -644,6 +665,28 @@ func (c *CAS) storeTreeRecursive(
 	return nil
 }
 
+// storeCommit stores the commit object at hash, which lets a remote CAS
+// verify the tree listed from it, see [CAS.pullCommit]. A hash naming
+// another kind of object, e.g. an annotated tag, is skipped.
+func (c *CAS) storeCommit(ctx context.Context, l log.Logger, v Venv, runner *git.GitRunner, hash string) error {
+	if !c.commitStore.NeedsWrite(v, hash) {
+		return nil
+	}
+
+	var buf bytes.Buffer
+
+	if err := runner.CatFile(ctx, hash, &buf); err != nil {
+		return err
+	}
+
+	if gitObjectID(DetectHashAlgorithm(hash), gitObjectCommit, buf.Bytes()) != hash {
+		l.Debugf("cas: %s is not a commit object, not storing it", hash)
+		return nil
+	}
+
+	return NewContent(c.commitStore).EnsureWithWait(l, v, hash, buf.Bytes())
+}
+
 // storeBlobs stores blobs in the CAS. Gitlink entries (type "commit")
 // name objects that live in another repository entirely, so only blob
 // entries are written; submodule contents arrive via
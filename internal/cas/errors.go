@@ -123,3 +123,67 @@ func (e *UpdateSourceWithCASRequiresCASError) Error() string {
 		subject, e.Path,
 	)
 }
+
+// InvalidGCMaxAgeError is returned when a garbage collection retention age cannot be parsed.
+type InvalidGCMaxAgeError struct {
+	Value string
+}
+
+func (e *InvalidGCMaxAgeError) Error() string {
+	return fmt.Sprintf("invalid CAS garbage collection max age %q: expected a duration such as 720h or a number of days such as 30d", e.Value)
+}
+
+// InvalidGCMaxSizeError is returned when a garbage collection size limit cannot be parsed.
+type InvalidGCMaxSizeError struct {
+	Value string
+}
+
+func (e *InvalidGCMaxSizeError) Error() string {
+	return fmt.Sprintf("invalid CAS garbage collection max size %q: expected a size such as 500MiB or 20GiB", e.Value)
+}
+
+// ErrBlobHashMismatch is returned when the content of a blob pulled from the remote CAS does not match its hash.
+var ErrBlobHashMismatch = errors.New("blob content does not match its hash")
+
+// ErrCommitNotFound is returned when a git-derived tree pulled from the remote CAS has no commit object to verify it.
+var ErrCommitNotFound = errors.New("commit object of the tree not found")
+
+// ErrCommitHashMismatch is returned when a commit object pulled from the remote CAS does not match its hash.
+var ErrCommitHashMismatch = errors.New("commit object does not match its hash")
+
+// ErrTreeCommitMismatch is returned when a tree pulled from the remote CAS is not the tree of its commit.
+var ErrTreeCommitMismatch = errors.New("tree does not match the tree of its commit")
+
+// UnsupportedRemoteError is returned when the remote CAS URL has an unsupported scheme.
+type UnsupportedRemoteError struct {
+	URL string
+}
+
+func (e *UnsupportedRemoteError) Error() string {
+	return fmt.Sprintf("unsupported remote CAS URL %q: expected s3://<bucket>/<prefix> or an http(s):// URL", e.URL)
+}
+
+// RemoteIntegrityError is returned when an object pulled from the remote CAS fails verification.
+type RemoteIntegrityError struct {
+	Err error
+	Key string
+}
+
+func (e *RemoteIntegrityError) Error() string {
+	return fmt.Sprintf("remote CAS object %s failed verification: %v", e.Key, e.Err)
+}
+
+func (e *RemoteIntegrityError) Unwrap() error {
+	return e.Err
+}
+
+// RemoteStatusError is returned when the remote CAS server responds with an unexpected status.
+type RemoteStatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+}
+
+func (e *RemoteStatusError) Error() string {
+	return fmt.Sprintf("remote CAS %s %s responded with status %d", e.Method, e.URL, e.StatusCode)
+}
@@ -0,0 +1,468 @@
+package cas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+
+	"github.com/gruntwork-io/terragrunt/internal/git"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// AutoGCInterval is the minimum time between two automatic garbage collections of a store.
+	AutoGCInterval = 24 * time.Hour
+
+	// gcMarkerFileName is the file in the CAS store path whose modification time records the last garbage collection.
+	gcMarkerFileName = "gc.last"
+)
+
+// GCOptions configures a garbage collection of the store.
+type GCOptions struct {
+	// MaxAge drops trees not used within this duration. Zero keeps every tree regardless of age.
+	MaxAge time.Duration
+	// MaxSize is the maximum total size in bytes of the content kept, evicting the least recently
+	// used trees first. Zero means unlimited.
+	MaxSize int64
+	// DryRun reports what would be removed without removing anything.
+	DryRun bool
+}
+
+// GCStats reports the outcome of a garbage collection.
+type GCStats struct {
+	// Trees is the number of trees found in the store.
+	Trees int
+	// LiveTrees is the number of trees kept.
+	LiveTrees int
+	// RemovedFiles is the number of blobs, trees and commit objects removed, or that would be removed on a dry run.
+	RemovedFiles int
+	// RemovedBytes is the total size of the removed files.
+	RemovedBytes int64
+	// KeptBytes is the total size of the content kept.
+	KeptBytes int64
+}
+
+// gcEntry is a content file found in one of the stores.
+type gcEntry struct {
+	modTime time.Time
+	store   *Store
+	hash    string
+	size    int64
+}
+
+// gcSet is a set of content files, keyed by store and hash.
+type gcSet map[*Store]map[string]bool
+
+func (set gcSet) add(store *Store, hash string) bool {
+	hashes, ok := set[store]
+	if !ok {
+		hashes = make(map[string]bool)
+		set[store] = hashes
+	}
+
+	if hashes[hash] {
+		return false
+	}
+
+	hashes[hash] = true
+
+	return true
+}
+
+func (set gcSet) has(store *Store, hash string) bool {
+	return set[store][hash]
+}
+
+// GC removes the blobs and trees no longer reachable from recently used trees.
+//
+// Every tree in the tree and synth stores is a candidate root, and its
+// modification time records when it was last used, since materializing a
+// tree touches it. Roots used within opts.MaxAge, along with the trees
+// pinned by the source index, are live. When opts.MaxSize is set, the
+// least recently used roots are dropped until the content reachable from
+// the remaining ones fits. Everything not reachable from a live root is
+// removed under its store lock, trees before blobs, so a concurrent reader
+// never finds a tree whose blobs are gone. Trees used during the collection
+// are kept along with their content.
+//
+// Requires v.FS.
+func (c *CAS) GC(ctx context.Context, l log.Logger, v Venv, opts GCOptions) (*GCStats, error) {
+	v.RequireFS()
+
+	startedAt := time.Now()
+	stats := new(GCStats)
+
+	entries := make(map[*Store]map[string]*gcEntry)
+
+	for _, store := range []*Store{c.synthStore, c.treeStore, c.blobStore, c.commitStore} {
+		storeEntries, err := scanStore(v, store)
+		if err != nil {
+			return nil, err
+		}
+
+		entries[store] = storeEntries
+	}
+
+	pinned, err := c.pinnedTrees(v)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]*gcEntry, 0, len(entries[c.synthStore])+len(entries[c.treeStore]))
+
+	for _, store := range []*Store{c.synthStore, c.treeStore} {
+		for _, entry := range entries[store] {
+			roots = append(roots, entry)
+		}
+	}
+
+	stats.Trees = len(roots)
+
+	// Pinned trees are marked first, then the most recently used ones, so
+	// a size limit evicts the least recently used trees.
+	sort.Slice(roots, func(i, j int) bool {
+		pi, pj := pinned[roots[i].hash], pinned[roots[j].hash]
+		if pi != pj {
+			return pi
+		}
+
+		return roots[i].modTime.After(roots[j].modTime)
+	})
+
+	live := make(gcSet)
+
+	for _, root := range roots {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		isPinned := pinned[root.hash]
+
+		if !isPinned && opts.MaxAge > 0 && startedAt.Sub(root.modTime) > opts.MaxAge {
+			continue
+		}
+
+		reachable := make(gcSet)
+		c.markTree(l, v, root.store, root.hash, live, reachable)
+
+		var size int64
+
+		for store, hashes := range reachable {
+			for hash := range hashes {
+				if entry, ok := entries[store][hash]; ok {
+					size += entry.size
+				}
+			}
+		}
+
+		if !isPinned && opts.MaxSize > 0 && stats.KeptBytes+size > opts.MaxSize {
+			continue
+		}
+
+		for store, hashes := range reachable {
+			for hash := range hashes {
+				live.add(store, hash)
+			}
+		}
+
+		stats.KeptBytes += size
+		stats.LiveTrees++
+	}
+
+	// Trees are swept before blobs. Trees used since the collection started are kept along with their content,
+	// both before sweeping the trees, so their subtrees are kept, and before sweeping the blobs, so the blobs of
+	// the trees kept by sweepEntry are too.
+	c.markUsedTrees(l, v, entries, live, startedAt)
+
+	for _, store := range []*Store{c.synthStore, c.treeStore, c.blobStore, c.commitStore} {
+		if store == c.blobStore {
+			c.markUsedTrees(l, v, entries, live, startedAt)
+		}
+
+		for hash, entry := range entries[store] {
+			if live.has(store, hash) {
+				continue
+			}
+
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			removed, err := sweepEntry(v, entry, startedAt, opts.DryRun)
+			if err != nil {
+				return nil, err
+			}
+
+			if removed {
+				stats.RemovedFiles++
+				stats.RemovedBytes += entry.size
+			}
+		}
+	}
+
+	if opts.DryRun {
+		return stats, nil
+	}
+
+	if err := c.touchGCMarker(v, startedAt); err != nil {
+		l.Warnf("Failed to record CAS garbage collection time: %v", err)
+	}
+
+	return stats, nil
+}
+
+// AutoGC runs [CAS.GC] unless the store was already collected within [AutoGCInterval].
+// It returns nil stats when the collection is skipped.
+//
+// Requires v.FS.
+func (c *CAS) AutoGC(ctx context.Context, l log.Logger, v Venv, opts GCOptions) (*GCStats, error) {
+	v.RequireFS()
+
+	info, err := v.FS.Stat(filepath.Join(c.storePath, gcMarkerFileName))
+	if err == nil && time.Since(info.ModTime()) < AutoGCInterval {
+		return nil, nil
+	}
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	// Without a store there is nothing to collect.
+	if _, err := v.FS.Stat(c.storePath); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	return c.GC(ctx, l, v, opts)
+}
+
+// ParseGCMaxAge parses a retention age, either a Go duration such as 720h or a number of days such as 30d.
+func ParseGCMaxAge(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, &InvalidGCMaxAgeError{Value: value}
+		}
+
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	age, err := time.ParseDuration(value)
+	if err != nil || age <= 0 {
+		return 0, &InvalidGCMaxAgeError{Value: value}
+	}
+
+	return age, nil
+}
+
+// ParseGCMaxSize parses a human-readable size such as 500MiB or 20GiB into bytes.
+func ParseGCMaxSize(value string) (int64, error) {
+	size, err := units.RAMInBytes(value)
+	if err != nil || size <= 0 {
+		return 0, &InvalidGCMaxSizeError{Value: value}
+	}
+
+	return size, nil
+}
+
+// touchTree records that the tree was just used, so garbage collection keeps it along with its content. It reports
+// false when the tree is no longer stored: a concurrent collection removed it after it was read, and may remove its
+// blobs too, so it must not be materialized. Other failures are not fatal, the tree is at worst collected earlier
+// than it should be.
+func touchTree(v Venv, store *Store, hash string) bool {
+	now := time.Now()
+
+	err := v.FS.Chtimes(NewContent(store).getPath(hash), now, now)
+
+	return !errors.Is(err, fs.ErrNotExist)
+}
+
+// touchGCMarker records the time of the last garbage collection.
+func (c *CAS) touchGCMarker(v Venv, at time.Time) error {
+	path := filepath.Join(c.storePath, gcMarkerFileName)
+
+	if _, err := v.FS.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		if err := vfs.WriteFile(v.FS, path, nil, RegularFilePerms); err != nil {
+			return err
+		}
+	}
+
+	return v.FS.Chtimes(path, at, at)
+}
+
+// pinnedTrees returns the hashes of the trees referenced by the source index, which are never collected.
+func (c *CAS) pinnedTrees(v Venv) (map[string]bool, error) {
+	index, err := c.ReadSourceIndex(v)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := make(map[string]bool, len(index))
+
+	for _, ref := range index {
+		ref = strings.TrimPrefix(ref, CASProtocolPrefix)
+		ref, _, _ = strings.Cut(ref, "//")
+
+		hash, err := ParseCASRef(ref)
+		if err != nil {
+			return nil, fmt.Errorf("parse CAS source index reference %q: %w", ref, err)
+		}
+
+		pinned[hash] = true
+	}
+
+	return pinned, nil
+}
+
+// markUsedTrees adds the trees used since startedAt to live, along with the content reachable from them.
+func (c *CAS) markUsedTrees(l log.Logger, v Venv, entries map[*Store]map[string]*gcEntry, live gcSet, startedAt time.Time) {
+	for _, store := range []*Store{c.synthStore, c.treeStore} {
+		for hash := range entries[store] {
+			if live.has(store, hash) {
+				continue
+			}
+
+			info, err := v.FS.Stat(NewContent(store).getPath(hash))
+			if err != nil || !info.ModTime().After(startedAt) {
+				continue
+			}
+
+			c.markTree(l, v, store, hash, live, live)
+		}
+	}
+}
+
+// markTree adds the tree and the content reachable from it to reachable, skipping content already in live.
+// Subtrees and submodule trees live in the same store as their parent tree. Missing or unreadable trees are
+// skipped, their content is unreachable anyway.
+func (c *CAS) markTree(l log.Logger, v Venv, store *Store, hash string, live, reachable gcSet) {
+	if live.has(store, hash) || !reachable.add(store, hash) {
+		return
+	}
+
+	// The commit object a git-derived tree was listed from goes with it.
+	if store == c.treeStore && !live.has(c.commitStore, hash) {
+		reachable.add(c.commitStore, hash)
+	}
+
+	data, err := NewContent(store).Read(v, hash)
+	if err != nil {
+		return
+	}
+
+	tree, err := git.ParseTree(data, "")
+	if err != nil {
+		l.Warnf("Failed to parse CAS tree %s, keeping it without its content: %v", hash, err)
+		return
+	}
+
+	for _, entry := range tree.Entries() {
+		switch entry.Type {
+		case git.EntryTypeBlob:
+			if !live.has(c.blobStore, entry.Hash) {
+				reachable.add(c.blobStore, entry.Hash)
+			}
+		case git.EntryTypeTree, git.EntryTypeCommit:
+			c.markTree(l, v, store, entry.Hash, live, reachable)
+		}
+	}
+}
+
+// scanStore returns the content files of the store, skipping lock and temporary files.
+func scanStore(v Venv, store *Store) (map[string]*gcEntry, error) {
+	entries := make(map[string]*gcEntry)
+
+	partitions, err := vfs.ReadDirEntries(v.FS, store.Path())
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return entries, nil
+		}
+
+		return nil, fmt.Errorf("read CAS store %s: %w", store.Path(), err)
+	}
+
+	for _, partition := range partitions {
+		if !partition.IsDir() {
+			continue
+		}
+
+		files, err := vfs.ReadDirEntries(v.FS, filepath.Join(store.Path(), partition.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read CAS store partition %s: %w", partition.Name(), err)
+		}
+
+		for _, file := range files {
+			hash := file.Name()
+
+			if file.IsDir() || len(hash) < 2 || hash[:2] != partition.Name() || strings.Contains(hash, ".") {
+				continue
+			}
+
+			info, err := file.Info()
+			if err != nil {
+				continue
+			}
+
+			entries[hash] = &gcEntry{
+				store:   store,
+				hash:    hash,
+				size:    info.Size(),
+				modTime: info.ModTime(),
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// sweepEntry removes the content file under its store lock. Files modified since the collection started were
+// written or used concurrently and are kept.
+func sweepEntry(v Venv, entry *gcEntry, startedAt time.Time, dryRun bool) (bool, error) {
+	if dryRun {
+		return true, nil
+	}
+
+	lock, err := entry.store.AcquireLock(v, entry.hash)
+	if err != nil {
+		return false, fmt.Errorf("lock CAS content %s: %w", entry.hash, err)
+	}
+
+	defer lock.Unlock() //nolint:errcheck
+
+	path := NewContent(entry.store).getPath(entry.hash)
+
+	info, err := v.FS.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if info.ModTime().After(startedAt) {
+		return false, nil
+	}
+
+	// Stored files are read-only, which prevents their removal on Windows.
+	if runtime.GOOS == WindowsOS {
+		if err := v.FS.Chmod(path, RegularFilePerms); err != nil {
+			return false, err
+		}
+	}
+
+	if err := v.FS.Remove(path); err != nil {
+		return false, fmt.Errorf("remove CAS content %s: %w", entry.hash, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,188 @@
+package cas_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gcFixture stores a tree with the given blobs, last used at the given time, and returns the tree hash.
+func gcFixture(t *testing.T, c *cas.CAS, v cas.Venv, name string, usedAt time.Time, blobs ...string) string {
+	t.Helper()
+
+	l := logger.CreateLogger()
+	blobContent := cas.NewContent(c.BlobStore())
+
+	var treeData []byte
+
+	for _, blob := range blobs {
+		hash := cas.HashSHA256.Sum([]byte(blob))
+		require.NoError(t, blobContent.Ensure(l, v, hash, []byte(blob)))
+
+		treeData = fmt.Appendf(treeData, "100644 blob %s\t%s\n", hash, blob)
+	}
+
+	treeHash := cas.HashSHA256.Sum([]byte(name))
+	require.NoError(t, cas.NewContent(c.TreeStore()).Store(l, v, treeHash, treeData))
+	require.NoError(t, v.FS.Chtimes(storedPath(c.TreeStore(), treeHash), usedAt, usedAt))
+
+	return treeHash
+}
+
+func storedPath(store *cas.Store, hash string) string {
+	return filepath.Join(store.Path(), hash[:2], hash)
+}
+
+func assertStored(t *testing.T, v cas.Venv, store *cas.Store, content string, stored bool) {
+	t.Helper()
+
+	_, err := v.FS.Stat(storedPath(store, cas.HashSHA256.Sum([]byte(content))))
+	assert.Equal(t, stored, err == nil, "stored %q", content)
+}
+
+func TestGCMaxAge(t *testing.T) {
+	t.Parallel()
+
+	v := newMemVenv(t)
+	l := logger.CreateLogger()
+
+	c, err := cas.New(cas.WithStorePath(defaultStorePath))
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	gcFixture(t, c, v, "recent", now.Add(-time.Hour), "shared.tf", "recent.tf")
+	gcFixture(t, c, v, "stale", now.Add(-48*time.Hour), "shared.tf", "stale.tf")
+
+	orphan := cas.HashSHA256.Sum([]byte("orphan.tf"))
+	require.NoError(t, cas.NewContent(c.BlobStore()).Ensure(l, v, orphan, []byte("orphan.tf")))
+
+	stats, err := c.GC(t.Context(), l, v, cas.GCOptions{MaxAge: 24 * time.Hour})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stats.Trees)
+	assert.Equal(t, 1, stats.LiveTrees)
+	assert.Equal(t, 3, stats.RemovedFiles)
+
+	assertStored(t, v, c.TreeStore(), "recent", true)
+	assertStored(t, v, c.TreeStore(), "stale", false)
+	assertStored(t, v, c.BlobStore(), "shared.tf", true)
+	assertStored(t, v, c.BlobStore(), "recent.tf", true)
+	assertStored(t, v, c.BlobStore(), "stale.tf", false)
+	assertStored(t, v, c.BlobStore(), "orphan.tf", false)
+}
+
+func TestGCMaxSize(t *testing.T) {
+	t.Parallel()
+
+	v := newMemVenv(t)
+	l := logger.CreateLogger()
+
+	c, err := cas.New(cas.WithStorePath(defaultStorePath))
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	gcFixture(t, c, v, "newest", now.Add(-time.Minute), "newest.tf")
+	gcFixture(t, c, v, "oldest", now.Add(-time.Hour), "oldest.tf")
+
+	// Enough room for one tree and its blob only.
+	stats, err := c.GC(t.Context(), l, v, cas.GCOptions{MaxSize: 150})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, stats.LiveTrees)
+	assert.LessOrEqual(t, stats.KeptBytes, int64(150))
+
+	assertStored(t, v, c.TreeStore(), "newest", true)
+	assertStored(t, v, c.BlobStore(), "newest.tf", true)
+	assertStored(t, v, c.TreeStore(), "oldest", false)
+	assertStored(t, v, c.BlobStore(), "oldest.tf", false)
+}
+
+func TestGCKeepsSourceIndexTrees(t *testing.T) {
+	t.Parallel()
+
+	v := newMemVenv(t)
+	l := logger.CreateLogger()
+
+	c, err := cas.New(cas.WithStorePath(defaultStorePath))
+	require.NoError(t, err)
+
+	pinned := gcFixture(t, c, v, "bundled", time.Now().Add(-365*24*time.Hour), "bundled.tf")
+
+	require.NoError(t, c.WriteSourceIndex(v, cas.SourceIndex{
+		"git::https://example.com/modules.git": cas.FormatCASRef(pinned),
+	}))
+
+	_, err = c.GC(t.Context(), l, v, cas.GCOptions{MaxAge: time.Hour, MaxSize: 1})
+	require.NoError(t, err)
+
+	assertStored(t, v, c.TreeStore(), "bundled", true)
+	assertStored(t, v, c.BlobStore(), "bundled.tf", true)
+}
+
+func TestGCDryRun(t *testing.T) {
+	t.Parallel()
+
+	v := newMemVenv(t)
+	l := logger.CreateLogger()
+
+	c, err := cas.New(cas.WithStorePath(defaultStorePath))
+	require.NoError(t, err)
+
+	gcFixture(t, c, v, "stale", time.Now().Add(-48*time.Hour), "stale.tf")
+
+	stats, err := c.GC(t.Context(), l, v, cas.GCOptions{MaxAge: time.Hour, DryRun: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stats.RemovedFiles)
+	assertStored(t, v, c.TreeStore(), "stale", true)
+	assertStored(t, v, c.BlobStore(), "stale.tf", true)
+}
+
+func TestAutoGCRunsOncePerInterval(t *testing.T) {
+	t.Parallel()
+
+	v := newMemVenv(t)
+	l := logger.CreateLogger()
+
+	c, err := cas.New(cas.WithStorePath(defaultStorePath))
+	require.NoError(t, err)
+
+	gcFixture(t, c, v, "stale", time.Now().Add(-48*time.Hour), "stale.tf")
+
+	stats, err := c.AutoGC(t.Context(), l, v, cas.GCOptions{MaxAge: time.Hour})
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+	assert.Equal(t, 2, stats.RemovedFiles)
+
+	stats, err = c.AutoGC(t.Context(), l, v, cas.GCOptions{MaxAge: time.Hour})
+	require.NoError(t, err)
+	assert.Nil(t, stats)
+}
+
+func TestMaterializeTreeRecordsUse(t *testing.T) {
+	t.Parallel()
+
+	v := newMemVenv(t)
+	l := logger.CreateLogger()
+
+	c, err := cas.New(cas.WithStorePath(defaultStorePath))
+	require.NoError(t, err)
+
+	tree := gcFixture(t, c, v, "used", time.Now().Add(-48*time.Hour), "used.tf")
+
+	require.NoError(t, c.MaterializeTree(t.Context(), l, v, tree, "/dest"))
+
+	_, err = c.GC(t.Context(), l, v, cas.GCOptions{MaxAge: time.Hour})
+	require.NoError(t, err)
+
+	assertStored(t, v, c.TreeStore(), "used", true)
+	assertStored(t, v, c.BlobStore(), "used.tf", true)
+}
@@ -0,0 +1,142 @@
+package cas
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/git"
+)
+
+// Git object types, as written in the header git hashes an object with.
+const (
+	gitObjectBlob   = "blob"
+	gitObjectTree   = "tree"
+	gitObjectCommit = "commit"
+
+	// gitTreeDirMode is the mode git records for a directory in a tree object.
+	gitTreeDirMode = "40000"
+)
+
+// gitObjectID returns the id git assigns to the object of the given type and content.
+func gitObjectID(alg HashAlgorithm, objectType string, data []byte) string {
+	h := alg.NewHash()
+	fmt.Fprintf(h, "%s %d\x00", objectType, len(data))
+	h.Write(data)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// commitTreeID returns the id of the root tree of a commit object, read
+// from its leading `tree <id>` header, or "" if the object has none.
+func commitTreeID(commit []byte) string {
+	header, _, _ := bytes.Cut(commit, []byte("\n"))
+
+	id, ok := strings.CutPrefix(string(header), gitObjectTree+" ")
+	if !ok {
+		return ""
+	}
+
+	return id
+}
+
+// gitTreeID returns the id of the root tree object of a recursive
+// `git ls-tree -r` listing, rebuilding the tree objects of the intermediate
+// directories from the entry paths.
+func gitTreeID(entries []git.TreeEntry, alg HashAlgorithm) (string, error) {
+	root := newGitTreeNode()
+
+	for _, entry := range entries {
+		if entry.Type == git.EntryTypeTree {
+			return "", fmt.Errorf("unexpected tree entry %q in a recursive tree listing", entry.Path)
+		}
+
+		dir := root
+		names := strings.Split(entry.Path, "/")
+
+		for _, name := range names[:len(names)-1] {
+			child, ok := dir.dirs[name]
+			if !ok {
+				child = newGitTreeNode()
+				dir.dirs[name] = child
+			}
+
+			dir = child
+		}
+
+		dir.entries[names[len(names)-1]] = entry
+	}
+
+	return root.id(alg)
+}
+
+// gitTreeNode is a directory of a tree listing being rebuilt into tree objects.
+type gitTreeNode struct {
+	dirs    map[string]*gitTreeNode
+	entries map[string]git.TreeEntry
+}
+
+func newGitTreeNode() *gitTreeNode {
+	return &gitTreeNode{
+		dirs:    make(map[string]*gitTreeNode),
+		entries: make(map[string]git.TreeEntry),
+	}
+}
+
+// id returns the id of the tree object of the directory.
+func (node *gitTreeNode) id(alg HashAlgorithm) (string, error) {
+	type treeItem struct {
+		name string
+		mode string
+		hash string
+	}
+
+	items := make([]treeItem, 0, len(node.dirs)+len(node.entries))
+
+	for name, dir := range node.dirs {
+		if _, ok := node.entries[name]; ok {
+			return "", fmt.Errorf("tree listing has both a file and a directory named %q", name)
+		}
+
+		hash, err := dir.id(alg)
+		if err != nil {
+			return "", err
+		}
+
+		items = append(items, treeItem{name: name, mode: gitTreeDirMode, hash: hash})
+	}
+
+	for name, entry := range node.entries {
+		items = append(items, treeItem{name: name, mode: entry.Mode, hash: entry.Hash})
+	}
+
+	// Git sorts the entries of a tree by name, comparing directory names as
+	// if they ended with a slash.
+	sortName := func(item treeItem) string {
+		if item.mode == gitTreeDirMode {
+			return item.name + "/"
+		}
+
+		return item.name
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return sortName(items[i]) < sortName(items[j])
+	})
+
+	var buf bytes.Buffer
+
+	for _, item := range items {
+		hash, err := hex.DecodeString(item.hash)
+		if err != nil || len(hash) != alg.NewHash().Size() {
+			return "", fmt.Errorf("invalid %s hash %q for tree entry %q", alg, item.hash, item.name)
+		}
+
+		fmt.Fprintf(&buf, "%s %s\x00", item.mode, item.name)
+		buf.Write(hash)
+	}
+
+	return gitObjectID(alg, gitObjectTree, buf.Bytes()), nil
+}
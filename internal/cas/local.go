@@ -50,6 +50,10 @@ func (c *CAS) StoreLocalDirectory(
 		return fmt.Errorf("failed to parse local tree: %w", err)
 	}
 
+	if !touchTree(v, c.treeStore, hash) {
+		return fmt.Errorf("failed to store local content: tree %s: %w", hash, fs.ErrNotExist)
+	}
+
 	return LinkTree(ctx, v, c.blobStore, c.treeStore, tree, targetDir, opts...)
 }
 
@@ -117,7 +117,8 @@ func FormatCASRefWithSubdir(hash, subdir string) string {
 }
 
 // MaterializeTree reads a tree from the CAS store and links its contents to the destination directory.
-// It tries the synth store first, then falls back to the git tree store.
+// It tries the synth store first, then falls back to the git tree store,
+// pulling the tree from the remote tier when neither has it.
 //
 // Requires v.FS for reading the stored tree and writing links. v.Git is
 // not used because materialization is a pure FS operation.
@@ -131,6 +132,10 @@ func (c *CAS) MaterializeTree(
 ) error {
 	v.RequireFS()
 
+	if c.synthStore.NeedsWrite(v, hash) && c.treeStore.NeedsWrite(v, hash) {
+		c.pullTree(ctx, l, v, hash, c.synthStore, c.treeStore)
+	}
+
 	var treeData []byte
 
 	var treeStoreUsed *Store
@@ -164,6 +169,14 @@ func (c *CAS) MaterializeTree(
 		return fmt.Errorf("failed to parse CAS tree %s: %w", hash, err)
 	}
 
+	if !touchTree(v, treeStoreUsed, hash) {
+		return &WrappedError{
+			Op:   "materialize_tree",
+			Path: hash,
+			Err:  ErrTreeNotFound,
+		}
+	}
+
 	return LinkTree(ctx, v, c.blobStore, treeStoreUsed, tree, dest, opts...)
 }
 
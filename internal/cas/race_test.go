@@ -3,10 +3,12 @@
 package cas_test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/internal/cas"
 	"github.com/gruntwork-io/terragrunt/internal/getter"
@@ -182,3 +184,60 @@ func TestContentLinkConcurrentSameTargetWithRacing(t *testing.T) {
 		require.NoErrorf(t, e, "worker %d failed to link the shared target", i)
 	}
 }
+
+func TestGCConcurrentWithMaterializeTreeWithRacing(t *testing.T) {
+	t.Parallel()
+
+	// A tree materialized during a collection is either collected before it is used, failing the materialization,
+	// or kept along with all of its blobs. Many blobs widen the window between sweeping the trees and the blobs.
+	blobs := make([]string, 200)
+	for i := range blobs {
+		blobs[i] = fmt.Sprintf("file%d.tf", i)
+	}
+
+	for range 20 {
+		v := newMemVenv(t)
+		l := logger.CreateLogger()
+
+		c, err := cas.New(cas.WithStorePath(defaultStorePath))
+		require.NoError(t, err)
+
+		tree := gcFixture(t, c, v, "stale", time.Now().Add(-48*time.Hour), blobs...)
+
+		var (
+			wg             sync.WaitGroup
+			gcErr          error
+			materializeErr error
+		)
+
+		start := make(chan struct{})
+
+		wg.Go(func() {
+			<-start
+
+			_, gcErr = c.GC(t.Context(), l, v, cas.GCOptions{MaxAge: time.Hour})
+		})
+
+		wg.Go(func() {
+			<-start
+
+			materializeErr = c.MaterializeTree(t.Context(), l, v, tree, "/dest")
+		})
+
+		close(start)
+		wg.Wait()
+
+		require.NoError(t, gcErr)
+
+		if materializeErr != nil {
+			require.ErrorIs(t, materializeErr, cas.ErrTreeNotFound)
+			continue
+		}
+
+		assertStored(t, v, c.TreeStore(), "stale", true)
+
+		for _, blob := range blobs {
+			assertStored(t, v, c.BlobStore(), blob, true)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package cas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/gruntwork-io/terragrunt/internal/git"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// remoteWorkers caps the number of concurrent remote requests made while
+// pulling or pushing the blobs of a tree.
+const remoteWorkers = 16
+
+// ErrRemoteNotFound is returned by a [Remote] when it has no object for a key.
+var ErrRemoteNotFound = errors.New("object not found in remote CAS")
+
+// Remote is a shared tier behind the local store. Objects are addressed by
+// keys mirroring the local store layout, `<namespace>/<hash[:2]>/<hash>` where
+// the namespace is `blobs`, `trees` or `synth/trees`, so a copy of a local
+// store can be served as a remote as-is.
+type Remote interface {
+	// Get returns the object for key, or [ErrRemoteNotFound].
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Has reports whether the remote has an object for key.
+	Has(ctx context.Context, key string) (bool, error)
+	// Put uploads the object for key.
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// RemoteOptions configures the remote tier of the store.
+type RemoteOptions struct {
+	// URL is the remote location: `s3://<bucket>/<prefix>` for an S3-compatible
+	// bucket, or an `http://` or `https://` base URL for a content server.
+	URL string
+	// Token is sent as a bearer token to HTTP remotes.
+	Token string
+	// ReadOnly pulls from the remote without ever pushing to it.
+	ReadOnly bool
+}
+
+// WithRemote configures a remote tier the store pulls from on a local miss
+// and pushes to after a fetch.
+func WithRemote(opts RemoteOptions) Option {
+	return func(c *CAS) {
+		c.remoteOpts = opts
+	}
+}
+
+// NewRemote returns the remote for opts.URL.
+func NewRemote(opts RemoteOptions) (Remote, error) {
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse remote CAS URL %q: %w", opts.URL, err)
+	}
+
+	switch u.Scheme {
+	case "s3":
+		if u.Host == "" {
+			return nil, &UnsupportedRemoteError{URL: opts.URL}
+		}
+
+		return &S3Remote{
+			Bucket:   u.Host,
+			Prefix:   strings.Trim(u.Path, "/"),
+			Region:   u.Query().Get("region"),
+			Endpoint: u.Query().Get("endpoint"),
+		}, nil
+	case "http", "https":
+		return NewHTTPRemote(opts.URL, opts.Token), nil
+	default:
+		return nil, &UnsupportedRemoteError{URL: opts.URL}
+	}
+}
+
+// remoteKey returns the remote key of the object at hash in store.
+func (c *CAS) remoteKey(store *Store, hash string) string {
+	namespace, err := filepath.Rel(c.storePath, store.Path())
+	if err != nil {
+		namespace = filepath.Base(store.Path())
+	}
+
+	return filepath.ToSlash(namespace) + "/" + hash[:2] + "/" + hash
+}
+
+// pullTree fetches the tree at hash into the local store from the remote,
+// trying each store in order. It reports whether the tree is now stored
+// locally. Remote failures are logged and reported as a miss, so a broken
+// remote never breaks a fetch.
+func (c *CAS) pullTree(ctx context.Context, l log.Logger, v Venv, hash string, stores ...*Store) bool {
+	if c.remote == nil {
+		return false
+	}
+
+	for _, store := range stores {
+		err := c.pullTreeInto(ctx, l, v, store, hash)
+		if err == nil {
+			l.Debugf("Pulled CAS tree %s from the remote CAS", hash)
+
+			return true
+		}
+
+		if !errors.Is(err, ErrRemoteNotFound) {
+			l.Warnf("Failed to pull CAS tree %s from the remote CAS: %v", hash, err)
+
+			return false
+		}
+	}
+
+	return false
+}
+
+// pullTreeInto fetches the tree at hash and the content it references into
+// store. Blobs and subtrees are written before the tree itself, so a local
+// tree hit still implies its content is present.
+//
+// Blobs are verified against their hash. Git-derived trees, keyed by the
+// commit they were listed from, are verified against the commit object
+// pulled alongside them, see [CAS.pullCommit]. Synthetic trees, keyed by a
+// hash of their source rather than of their content, cannot be verified:
+// the remote is trusted with their layout.
+func (c *CAS) pullTreeInto(ctx context.Context, l log.Logger, v Venv, store *Store, hash string) error {
+	if !store.NeedsWrite(v, hash) {
+		return nil
+	}
+
+	data, err := c.remote.Get(ctx, c.remoteKey(store, hash))
+	if err != nil {
+		return err
+	}
+
+	tree, err := git.ParseTree(data, "")
+	if err != nil {
+		return &RemoteIntegrityError{Key: c.remoteKey(store, hash), Err: err}
+	}
+
+	if store == c.treeStore {
+		if err := c.pullCommit(ctx, l, v, hash, tree); err != nil {
+			return err
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(remoteWorkers)
+
+	var pullErr error
+
+entries:
+	for _, entry := range tree.Entries() {
+		switch entry.Type {
+		case git.EntryTypeBlob:
+			g.Go(func() error {
+				return c.pullBlob(gctx, l, v, entry.Hash)
+			})
+		case git.EntryTypeTree:
+			if err := c.pullTreeInto(gctx, l, v, store, entry.Hash); err != nil {
+				pullErr = fmt.Errorf("pull subtree %s: %w", entry.Path, err)
+				break entries
+			}
+		case git.EntryTypeCommit:
+			// Submodules without a stored tree are materialized as empty
+			// directories, so a missing one is not an error.
+			if err := c.pullTreeInto(gctx, l, v, store, entry.Hash); err != nil && !errors.Is(err, ErrRemoteNotFound) {
+				pullErr = fmt.Errorf("pull submodule %s: %w", entry.Path, err)
+				break entries
+			}
+		}
+	}
+
+	// The blob pulls are waited for even when a subtree failed, and fail
+	// first since they cancel the subtree pulls.
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	if pullErr != nil {
+		return pullErr
+	}
+
+	return NewContent(store).EnsureWithWait(l, v, hash, data)
+}
+
+// pullCommit fetches the commit object at hash into the commit store,
+// verifying that it hashes to hash and that the tree it points to is the
+// one rebuilt from the entries of tree.
+//
+// A tree without a commit object in the remote is synthetic, unless its key
+// is a SHA-1 hash: only git ingestion produces those.
+func (c *CAS) pullCommit(ctx context.Context, l log.Logger, v Venv, hash string, tree *git.Tree) error {
+	key := c.remoteKey(c.commitStore, hash)
+	alg := DetectHashAlgorithm(hash)
+
+	data, err := c.remote.Get(ctx, key)
+	if errors.Is(err, ErrRemoteNotFound) {
+		if alg == HashSHA1 {
+			return &RemoteIntegrityError{Key: key, Err: ErrCommitNotFound}
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("pull commit %s: %w", hash, err)
+	}
+
+	if gitObjectID(alg, gitObjectCommit, data) != hash {
+		return &RemoteIntegrityError{Key: key, Err: ErrCommitHashMismatch}
+	}
+
+	treeID, err := gitTreeID(tree.Entries(), alg)
+	if err != nil {
+		return &RemoteIntegrityError{Key: c.remoteKey(c.treeStore, hash), Err: err}
+	}
+
+	if commitTreeID(data) != treeID {
+		return &RemoteIntegrityError{Key: c.remoteKey(c.treeStore, hash), Err: ErrTreeCommitMismatch}
+	}
+
+	return NewContent(c.commitStore).EnsureWithWait(l, v, hash, data)
+}
+
+// pullBlob fetches the blob at hash into the blob store, verifying that its
+// content matches the hash.
+func (c *CAS) pullBlob(ctx context.Context, l log.Logger, v Venv, hash string) error {
+	if !c.blobStore.NeedsWrite(v, hash) {
+		return nil
+	}
+
+	key := c.remoteKey(c.blobStore, hash)
+
+	data, err := c.remote.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("pull blob %s: %w", hash, err)
+	}
+
+	if !blobMatchesHash(data, hash) {
+		return &RemoteIntegrityError{Key: key, Err: ErrBlobHashMismatch}
+	}
+
+	return NewContent(c.blobStore).EnsureWithWait(l, v, hash, data)
+}
+
+// blobMatchesHash reports whether data is the content of the blob at hash.
+// Blobs ingested from git are keyed by their git object hash, other blobs by
+// the plain hash of their content.
+func blobMatchesHash(data []byte, hash string) bool {
+	alg := DetectHashAlgorithm(hash)
+
+	return alg.Sum(data) == hash || gitObjectID(alg, gitObjectBlob, data) == hash
+}
+
+// pushTree uploads the tree at hash in store, and the content it references,
+// to the remote. Failures are logged, they never fail the fetch.
+func (c *CAS) pushTree(ctx context.Context, l log.Logger, v Venv, store *Store, hash string) {
+	if c.remote == nil || c.remoteOpts.ReadOnly {
+		return
+	}
+
+	if err := c.pushTreeFrom(ctx, v, store, hash); err != nil {
+		l.Warnf("Failed to push CAS tree %s to the remote CAS: %v", hash, err)
+
+		return
+	}
+
+	l.Debugf("Pushed CAS tree %s to the remote CAS", hash)
+}
+
+// pushTreeFrom uploads the tree at hash and the content it references, blobs
+// and subtrees first, so a remote tree hit implies its content is present.
+// Content the remote already has is skipped.
+func (c *CAS) pushTreeFrom(ctx context.Context, v Venv, store *Store, hash string) error {
+	key := c.remoteKey(store, hash)
+
+	// The commit object of a git-derived tree is pushed before the tree, and
+	// even when the remote already has the tree, so that pulls can verify it.
+	if store == c.treeStore && !c.commitStore.NeedsWrite(v, hash) {
+		if err := c.pushObject(ctx, v, c.commitStore, hash); err != nil {
+			return err
+		}
+	}
+
+	if ok, err := c.remote.Has(ctx, key); err != nil || ok {
+		return err
+	}
+
+	data, err := NewContent(store).Read(v, hash)
+	if err != nil {
+		return err
+	}
+
+	tree, err := git.ParseTree(data, "")
+	if err != nil {
+		return err
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(remoteWorkers)
+
+	for _, entry := range tree.Entries() {
+		switch entry.Type {
+		case git.EntryTypeBlob:
+			g.Go(func() error {
+				return c.pushObject(gctx, v, c.blobStore, entry.Hash)
+			})
+		case git.EntryTypeTree, git.EntryTypeCommit:
+			if store.NeedsWrite(v, entry.Hash) {
+				continue
+			}
+
+			if err := c.pushTreeFrom(ctx, v, store, entry.Hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	return c.remote.Put(ctx, key, data)
+}
+
+// pushObject uploads the object at hash in store, unless the remote already has it.
+func (c *CAS) pushObject(ctx context.Context, v Venv, store *Store, hash string) error {
+	key := c.remoteKey(store, hash)
+
+	if ok, err := c.remote.Has(ctx, key); err != nil || ok {
+		return err
+	}
+
+	data, err := NewContent(store).Read(v, hash)
+	if err != nil {
+		return err
+	}
+
+	return c.remote.Put(ctx, key, data)
+}
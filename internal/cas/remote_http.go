@@ -0,0 +1,107 @@
+package cas
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// HTTPRemote is a [Remote] backed by a plain HTTP content server. Objects are
+// read with GET, checked with HEAD and written with PUT at `<base URL>/<key>`.
+type HTTPRemote struct {
+	// Client is the HTTP client used for requests. Nil means [http.DefaultClient].
+	Client *http.Client
+	// BaseURL is the URL objects are addressed relative to.
+	BaseURL string
+	// Token, when set, is sent as a bearer token.
+	Token string
+}
+
+// NewHTTPRemote returns a remote backed by the HTTP content server at baseURL.
+func NewHTTPRemote(baseURL, token string) *HTTPRemote {
+	return &HTTPRemote{
+		BaseURL: strings.TrimSuffix(baseURL, "/"),
+		Token:   token,
+	}
+}
+
+// Get implements [Remote].
+func (r *HTTPRemote) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := r.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	return io.ReadAll(resp.Body)
+}
+
+// Has implements [Remote].
+func (r *HTTPRemote) Has(ctx context.Context, key string) (bool, error) {
+	resp, err := r.do(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		if errors.Is(err, ErrRemoteNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, resp.Body.Close()
+}
+
+// Put implements [Remote].
+func (r *HTTPRemote) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := r.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// do sends the request for key and returns the response of a successful
+// request, or [ErrRemoteNotFound] for a 404.
+func (r *HTTPRemote) do(ctx context.Context, method, key string, data []byte) (*http.Response, error) {
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
+	}
+
+	reqURL := r.BaseURL + "/" + key
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.Token)
+	}
+
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		resp.Body.Close() //nolint:errcheck,gosec
+
+		return nil, ErrRemoteNotFound
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		resp.Body.Close() //nolint:errcheck,gosec
+
+		return nil, &RemoteStatusError{Method: method, URL: reqURL, StatusCode: resp.StatusCode}
+	}
+
+	return resp, nil
+}
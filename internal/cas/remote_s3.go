@@ -0,0 +1,153 @@
+package cas
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3API is the subset of *s3.Client an [S3Remote] depends on.
+type S3API interface {
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+}
+
+// S3Remote is a [Remote] backed by an Amazon S3 or S3-compatible bucket.
+// Objects are stored at `<prefix>/<key>`.
+type S3Remote struct {
+	client S3API
+	err    error
+	// NewClient builds the S3 client on first use. Nil means the AWS SDK
+	// default config (env, profile, IMDS), with Region and Endpoint applied.
+	NewClient func(ctx context.Context) (S3API, error)
+	// Bucket is the bucket name.
+	Bucket string
+	// Prefix is the key prefix objects are stored under.
+	Prefix string
+	// Region is the bucket region. Empty means the AWS SDK default.
+	Region string
+	// Endpoint is the endpoint of an S3-compatible service, addressed in path style.
+	Endpoint string
+	once     sync.Once
+}
+
+// Get implements [Remote].
+func (r *S3Remote) Get(ctx context.Context, key string) ([]byte, error) {
+	client, err := r.s3Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.Bucket),
+		Key:    aws.String(r.objectKey(key)),
+	})
+	if err != nil {
+		return nil, s3RemoteError(err)
+	}
+	defer out.Body.Close() //nolint:errcheck
+
+	return io.ReadAll(out.Body)
+}
+
+// Has implements [Remote].
+func (r *S3Remote) Has(ctx context.Context, key string) (bool, error) {
+	client, err := r.s3Client(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(r.Bucket),
+		Key:    aws.String(r.objectKey(key)),
+	})
+	if err != nil {
+		if err = s3RemoteError(err); errors.Is(err, ErrRemoteNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Put implements [Remote].
+func (r *S3Remote) Put(ctx context.Context, key string, data []byte) error {
+	client, err := r.s3Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(r.Bucket),
+		Key:           aws.String(r.objectKey(key)),
+		Body:          bytes.NewReader(data),
+		ContentLength: aws.Int64(int64(len(data))),
+	})
+
+	return err
+}
+
+func (r *S3Remote) objectKey(key string) string {
+	if r.Prefix == "" {
+		return key
+	}
+
+	return path.Join(r.Prefix, key)
+}
+
+// s3Client returns the S3 client, building it on first use.
+func (r *S3Remote) s3Client(ctx context.Context) (S3API, error) {
+	r.once.Do(func() {
+		if r.NewClient != nil {
+			r.client, r.err = r.NewClient(ctx)
+			return
+		}
+
+		var opts []func(*config.LoadOptions) error
+		if r.Region != "" {
+			opts = append(opts, config.WithRegion(r.Region))
+		}
+
+		cfg, err := config.LoadDefaultConfig(ctx, opts...)
+		if err != nil {
+			r.err = err
+			return
+		}
+
+		r.client = s3.NewFromConfig(cfg, func(o *s3.Options) {
+			// S3-compatible services do not all support the checksums
+			// the SDK sends by default.
+			o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+			o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+
+			if r.Endpoint != "" {
+				o.BaseEndpoint = aws.String(r.Endpoint)
+				o.UsePathStyle = true
+			}
+		})
+	})
+
+	return r.client, r.err
+}
+
+// s3RemoteError maps a missing object to [ErrRemoteNotFound].
+func s3RemoteError(err error) error {
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound {
+		return ErrRemoteNotFound
+	}
+
+	return err
+}
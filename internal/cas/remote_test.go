@@ -0,0 +1,418 @@
+package cas_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteServer is a local stand-in for a remote CAS content server, keeping
+// objects in memory by request path.
+type remoteServer struct {
+	objects map[string][]byte
+	token   string
+	mu      sync.Mutex
+}
+
+func newRemoteServer(t *testing.T, token string) (*remoteServer, string) {
+	t.Helper()
+
+	rs := &remoteServer{objects: make(map[string][]byte), token: token}
+
+	srv := httptest.NewServer(rs)
+	t.Cleanup(srv.Close)
+
+	return rs, srv.URL
+}
+
+func (rs *remoteServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if rs.token != "" && r.Header.Get("Authorization") != "Bearer "+rs.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		data, ok := rs.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		rs.objects[key] = data
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (rs *remoteServer) keys(prefix string) []string {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	var keys []string
+
+	for key := range rs.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func (rs *remoteServer) set(key string, data []byte) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.objects[key] = data
+}
+
+func (rs *remoteServer) get(t *testing.T, key string) []byte {
+	t.Helper()
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	data, ok := rs.objects[key]
+	require.True(t, ok, "remote has no object %s", key)
+
+	return data
+}
+
+func (rs *remoteServer) remove(key string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	delete(rs.objects, key)
+}
+
+func newRemoteCAS(t *testing.T, opts cas.RemoteOptions) (*cas.CAS, cas.Venv) {
+	t.Helper()
+
+	storePath := filepath.Join(helpers.TmpDirWOSymlinks(t), "store")
+	c, err := cas.New(cas.WithStorePath(storePath), cas.WithRemote(opts))
+	require.NoError(t, err)
+
+	v, err := cas.OSVenv()
+	require.NoError(t, err)
+
+	return c, v
+}
+
+func fetchFromRemoteCAS(t *testing.T, c *cas.CAS, v cas.Venv, files map[string]string, calls *atomic.Int32) string {
+	t.Helper()
+
+	dst := filepath.Join(t.TempDir(), "dst")
+	require.NoError(t, c.FetchSource(t.Context(), logger.CreateLogger(), v, &cas.CloneOptions{Dir: dst}, cas.SourceRequest{
+		Scheme: "http",
+		URL:    "https://example.com/mod.tgz",
+		Resolver: &fakeResolver{
+			scheme: "http",
+			key:    cas.OpaqueKey("http", "https://example.com/mod.tgz", "etag-abc"),
+		},
+		Fetch: fakeFetcher(c, files, calls),
+	}))
+
+	return dst
+}
+
+func TestRemote_PullsContentFetchedElsewhere(t *testing.T) {
+	t.Parallel()
+
+	rs, url := newRemoteServer(t, "secret")
+	opts := cas.RemoteOptions{URL: url, Token: "secret"}
+	files := map[string]string{"main.tf": "# hello", "sub/x.tf": `variable "x" {}`}
+
+	var pushCalls atomic.Int32
+
+	pusher, pv := newRemoteCAS(t, opts)
+	fetchFromRemoteCAS(t, pusher, pv, files, &pushCalls)
+
+	require.Equal(t, int32(1), pushCalls.Load())
+	assert.NotEmpty(t, rs.keys("blobs/"))
+	assert.NotEmpty(t, rs.keys("trees/"))
+
+	var pullCalls atomic.Int32
+
+	puller, v := newRemoteCAS(t, opts)
+	dst := fetchFromRemoteCAS(t, puller, v, files, &pullCalls)
+
+	assert.Equal(t, int32(0), pullCalls.Load(), "a remote hit must not re-fetch the source")
+	assert.FileExists(t, filepath.Join(dst, "main.tf"))
+	assert.FileExists(t, filepath.Join(dst, "sub", "x.tf"))
+}
+
+func TestRemote_TamperedBlobFallsBackToFetch(t *testing.T) {
+	t.Parallel()
+
+	rs, url := newRemoteServer(t, "")
+	opts := cas.RemoteOptions{URL: url}
+	files := map[string]string{"main.tf": "# hello"}
+
+	var calls atomic.Int32
+
+	pusher, pv := newRemoteCAS(t, opts)
+	fetchFromRemoteCAS(t, pusher, pv, files, &calls)
+
+	for _, key := range rs.keys("blobs/") {
+		rs.set(key, []byte("tampered"))
+	}
+
+	puller, v := newRemoteCAS(t, opts)
+	dst := fetchFromRemoteCAS(t, puller, v, files, &calls)
+
+	assert.Equal(t, int32(2), calls.Load(), "a blob failing verification must fall back to fetching the source")
+
+	content, err := vfs.ReadFile(v.FS, filepath.Join(dst, "main.tf"))
+	require.NoError(t, err)
+	assert.Equal(t, "# hello", string(content))
+}
+
+func TestRemote_ReadOnlyNeverPushes(t *testing.T) {
+	t.Parallel()
+
+	rs, url := newRemoteServer(t, "")
+
+	var calls atomic.Int32
+
+	c, v := newRemoteCAS(t, cas.RemoteOptions{URL: url, ReadOnly: true})
+	fetchFromRemoteCAS(t, c, v, map[string]string{"main.tf": "# hello"}, &calls)
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Empty(t, rs.keys(""))
+}
+
+func TestRemote_MaterializeTreePullsMissingTree(t *testing.T) {
+	t.Parallel()
+
+	_, url := newRemoteServer(t, "")
+	opts := cas.RemoteOptions{URL: url}
+
+	var calls atomic.Int32
+
+	pusher, pv := newRemoteCAS(t, opts)
+	fetchFromRemoteCAS(t, pusher, pv, map[string]string{"main.tf": "# hello"}, &calls)
+
+	treeHash := cas.OpaqueKey("http", "https://example.com/mod.tgz", "etag-abc")
+
+	puller, v := newRemoteCAS(t, opts)
+	dst := filepath.Join(t.TempDir(), "dst")
+
+	require.NoError(t, puller.MaterializeTree(t.Context(), logger.CreateLogger(), v, treeHash, dst))
+	assert.FileExists(t, filepath.Join(dst, "main.tf"))
+}
+
+func TestRemote_UnreachableRemoteFallsBackToFetch(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	var calls atomic.Int32
+
+	c, v := newRemoteCAS(t, cas.RemoteOptions{URL: srv.URL})
+	dst := fetchFromRemoteCAS(t, c, v, map[string]string{"main.tf": "# hello"}, &calls)
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.FileExists(t, filepath.Join(dst, "main.tf"))
+}
+
+func TestNewRemote(t *testing.T) {
+	t.Parallel()
+
+	tc := []struct {
+		name    string
+		url     string
+		want    cas.Remote
+		wantErr bool
+	}{
+		{
+			name: "http",
+			url:  "https://cas.example.com/",
+			want: cas.NewHTTPRemote("https://cas.example.com", ""),
+		},
+		{
+			name: "s3",
+			url:  "s3://bucket/some/prefix?region=eu-west-1&endpoint=http://localhost:9000",
+			want: &cas.S3Remote{Bucket: "bucket", Prefix: "some/prefix", Region: "eu-west-1", Endpoint: "http://localhost:9000"},
+		},
+		{
+			name:    "s3 without bucket",
+			url:     "s3:///prefix",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			url:     "ftp://cas.example.com",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			remote, err := cas.NewRemote(cas.RemoteOptions{URL: tt.url})
+			if tt.wantErr {
+				var unsupported *cas.UnsupportedRemoteError
+				require.ErrorAs(t, err, &unsupported)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, remote)
+		})
+	}
+}
+
+func TestS3Remote(t *testing.T) {
+	t.Parallel()
+
+	// The stand-in serves path-style requests, `/<bucket>/<key>`.
+	rs, url := newRemoteServer(t, "")
+
+	remote := &cas.S3Remote{
+		Bucket: "bucket",
+		Prefix: "cas",
+		NewClient: func(context.Context) (cas.S3API, error) {
+			return s3.New(s3.Options{
+				BaseEndpoint: aws.String(url),
+				UsePathStyle: true,
+				Region:       "us-east-1",
+				Credentials:  aws.AnonymousCredentials{},
+			}), nil
+		},
+	}
+
+	ctx := t.Context()
+
+	_, err := remote.Get(ctx, "blobs/ab/abc")
+	require.ErrorIs(t, err, cas.ErrRemoteNotFound)
+
+	ok, err := remote.Has(ctx, "blobs/ab/abc")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, remote.Put(ctx, "blobs/ab/abc", []byte("content")))
+	assert.Equal(t, []string{"bucket/cas/blobs/ab/abc"}, rs.keys(""))
+
+	ok, err = remote.Has(ctx, "blobs/ab/abc")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	data, err := remote.Get(ctx, "blobs/ab/abc")
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(data))
+}
+
+func TestRemote_VerifiesGitTrees(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		tamper func(t *testing.T, rs *remoteServer, hash string)
+		name   string
+		pulled bool
+	}{
+		{
+			name:   "untampered",
+			tamper: func(*testing.T, *remoteServer, string) {},
+			pulled: true,
+		},
+		{
+			name: "tree with an extra file",
+			tamper: func(t *testing.T, rs *remoteServer, hash string) {
+				t.Helper()
+
+				key := "trees/" + hash[:2] + "/" + hash
+				tree := rs.get(t, key)
+				blob := strings.Fields(string(tree))[2]
+
+				rs.set(key, append(tree, []byte("100755 blob "+blob+"\tinjected.sh\n")...))
+			},
+		},
+		{
+			name: "tampered commit",
+			tamper: func(t *testing.T, rs *remoteServer, hash string) {
+				t.Helper()
+
+				rs.set("commits/"+hash[:2]+"/"+hash, []byte("tree 0000000000000000000000000000000000000000\n"))
+			},
+		},
+		{
+			name: "missing commit",
+			tamper: func(t *testing.T, rs *remoteServer, hash string) {
+				t.Helper()
+
+				rs.remove("commits/" + hash[:2] + "/" + hash)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoURL := startTestServer(t)
+			rs, url := newRemoteServer(t, "")
+			opts := cas.RemoteOptions{URL: url}
+			l := logger.CreateLogger()
+
+			pusher, pv := newRemoteCAS(t, opts)
+			require.NoError(t, pusher.Clone(t.Context(), l, pv, repoURL, cas.WithDir(filepath.Join(t.TempDir(), "clone"))))
+
+			treeKeys := rs.keys("trees/")
+			require.Len(t, treeKeys, 1)
+
+			hash := path.Base(treeKeys[0])
+			require.Len(t, rs.keys("commits/"), 1, "the commit object must be pushed along with its tree")
+
+			tc.tamper(t, rs, hash)
+
+			puller, v := newRemoteCAS(t, opts)
+			dst := filepath.Join(t.TempDir(), "dst")
+
+			err := puller.MaterializeTree(t.Context(), l, v, hash, dst)
+			if !tc.pulled {
+				require.ErrorIs(t, err, cas.ErrTreeNotFound, "a tree failing verification must not be pulled")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.FileExists(t, filepath.Join(dst, "main.tf"))
+		})
+	}
+}
@@ -70,6 +70,10 @@ type SourceRequest struct {
 // cached tree into opts.Dir without invoking Fetch. On a probe miss it
 // calls Fetch and links the resulting tree.
 //
+// With a remote tier configured, a probe key missing from the local store
+// is pulled from the remote before falling back to Fetch, and fetched
+// trees are pushed to the remote.
+//
 // opts.Dir is the destination. opts.Mutable selects copy vs hardlink
 // for the final link, matching the git path.
 //
@@ -105,7 +109,7 @@ func (c *CAS) FetchSource(
 	return tlm.Collect(ctx, "cas_fetch_source", attrs, func(childCtx context.Context) error {
 		suggestedKey := c.probeSource(childCtx, l, src)
 
-		if suggestedKey != "" && !c.treeStore.NeedsWrite(v, suggestedKey) {
+		if suggestedKey != "" && (!c.treeStore.NeedsWrite(v, suggestedKey) || c.pullTree(childCtx, l, v, suggestedKey, c.treeStore)) {
 			recordFetchOutcome(childCtx, true)
 
 			return c.linkStoredTree(childCtx, v, opts, suggestedKey)
@@ -118,6 +122,8 @@ func (c *CAS) FetchSource(
 			return fmt.Errorf("fetch %s: %w", src.URL, err)
 		}
 
+		c.pushTree(childCtx, l, v, c.treeStore, treeKey)
+
 		return c.linkStoredTree(childCtx, v, opts, treeKey)
 	})
 }
@@ -253,6 +259,10 @@ func (c *CAS) linkStoredTree(ctx context.Context, v Venv, opts *CloneOptions, ke
 		return fmt.Errorf("parse cached tree %s: %w", key, err)
 	}
 
+	if !touchTree(v, c.treeStore, key) {
+		return fmt.Errorf("read cached tree %s: %w", key, fs.ErrNotExist)
+	}
+
 	var linkOpts []LinkTreeOption
 	if opts.Mutable {
 		linkOpts = append(linkOpts, WithForceCopy())
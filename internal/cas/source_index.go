@@ -0,0 +1,90 @@
+package cas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/hashicorp/go-getter/v2"
+)
+
+// SourceIndexFileName is the name of the source index file in the CAS store path.
+const SourceIndexFileName = "sources.json"
+
+// SourceIndex maps source package URLs, without the `//subdir` selector, to
+// the CAS references of the trees stored for them. It is written when an
+// offline bundle is imported, so those sources resolve from the local store
+// without reaching the network.
+type SourceIndex map[string]string
+
+// Resolve returns the CAS reference for the given source URL, keeping its
+// `//subdir` selector, if the source package is in the index.
+func (index SourceIndex) Resolve(source string) (string, bool) {
+	pkg, subdir := getter.SourceDirSubdir(source)
+
+	ref, ok := index[pkg]
+	if !ok {
+		return "", false
+	}
+
+	if subdir != "" {
+		ref += "//" + subdir
+	}
+
+	return ref, true
+}
+
+// SourceIndexPath returns the path to the source index file.
+func (c *CAS) SourceIndexPath() string {
+	return filepath.Join(c.storePath, SourceIndexFileName)
+}
+
+// ReadSourceIndex reads the source index of the store. A missing index is
+// returned as an empty one.
+//
+// Requires v.FS.
+func (c *CAS) ReadSourceIndex(v Venv) (SourceIndex, error) {
+	v.RequireFS()
+
+	index := make(SourceIndex)
+
+	data, err := vfs.ReadFile(v.FS, c.SourceIndexPath())
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return index, nil
+		}
+
+		return nil, fmt.Errorf("read CAS source index: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("parse CAS source index %s: %w", c.SourceIndexPath(), err)
+	}
+
+	return index, nil
+}
+
+// WriteSourceIndex writes the source index of the store, replacing the existing one.
+//
+// Requires v.FS.
+func (c *CAS) WriteSourceIndex(v Venv, index SourceIndex) error {
+	v.RequireFS()
+
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := v.FS.MkdirAll(c.storePath, DefaultDirPerms); err != nil {
+		return fmt.Errorf("create CAS store path: %w", err)
+	}
+
+	if err := vfs.WriteFile(v.FS, c.SourceIndexPath(), data, RegularFilePerms); err != nil {
+		return fmt.Errorf("write CAS source index: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package cas_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceIndexResolve(t *testing.T) {
+	t.Parallel()
+
+	index := cas.SourceIndex{
+		"git::https://github.com/acme/modules.git?ref=v1.0.0": "cas::sha256:abc",
+	}
+
+	testCases := []struct {
+		name   string
+		source string
+		ref    string
+		found  bool
+	}{
+		{
+			name:   "package",
+			source: "git::https://github.com/acme/modules.git?ref=v1.0.0",
+			ref:    "cas::sha256:abc",
+			found:  true,
+		},
+		{
+			name:   "subdir",
+			source: "git::https://github.com/acme/modules.git//vpc?ref=v1.0.0",
+			ref:    "cas::sha256:abc//vpc",
+			found:  true,
+		},
+		{
+			name:   "other version",
+			source: "git::https://github.com/acme/modules.git?ref=v2.0.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ref, found := index.Resolve(tc.source)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.ref, ref)
+		})
+	}
+}
+
+func TestSourceIndexReadWrite(t *testing.T) {
+	t.Parallel()
+
+	c, err := cas.New(cas.WithStorePath("/store"))
+	require.NoError(t, err)
+
+	v := cas.Venv{FS: vfs.NewMemMapFS()}
+
+	index, err := c.ReadSourceIndex(v)
+	require.NoError(t, err)
+	assert.Empty(t, index)
+
+	index["https://example.com/module.zip"] = "cas::sha256:abc"
+	require.NoError(t, c.WriteSourceIndex(v, index))
+
+	readIndex, err := c.ReadSourceIndex(v)
+	require.NoError(t, err)
+	assert.Equal(t, index, readIndex)
+}
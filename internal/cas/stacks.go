@@ -259,7 +259,7 @@ func (c *CAS) processDirectory(
 	}
 
 	if _, err := v.FS.Stat(unitFile); err == nil {
-		return c.processUnitFile(l, v, repoRoot, dirPath, unitFile, refHash, hashAlg)
+		return c.processUnitFile(ctx, l, v, repoRoot, dirPath, unitFile, refHash, hashAlg)
 	}
 
 	return nil
@@ -301,7 +301,7 @@ func (c *CAS) processStackFile(
 			return fmt.Errorf("failed to process %s %q source: %w", block.BlockType, block.Name, err)
 		}
 
-		synthHash, err := c.buildSyntheticTree(l, v, targetDir, refHash, repoRoot, hashAlg)
+		synthHash, err := c.buildSyntheticTree(ctx, l, v, targetDir, refHash, repoRoot, hashAlg)
 		if err != nil {
 			return fmt.Errorf("failed to build synthetic tree for %s %q: %w", block.BlockType, block.Name, err)
 		}
@@ -337,6 +337,7 @@ func (c *CAS) processStackFile(
 // (e.g. source = "../bar") resolve correctly. Sources without "//" produce a
 // shallow tree of just the leaf module and a CAS reference with no subdir.
 func (c *CAS) processUnitFile(
+	ctx context.Context,
 	l log.Logger,
 	v Venv,
 	repoRoot, dirPath, unitFile, refHash string,
@@ -382,7 +383,7 @@ func (c *CAS) processUnitFile(
 		}
 	}
 
-	synthHash, err := c.buildSyntheticTree(l, v, treeDir, refHash, repoRoot, hashAlg)
+	synthHash, err := c.buildSyntheticTree(ctx, l, v, treeDir, refHash, repoRoot, hashAlg)
 	if err != nil {
 		return fmt.Errorf("failed to build synthetic tree for terraform source %q: %w", source, err)
 	}
@@ -418,7 +419,7 @@ func (c *CAS) processUnitFile(
 // CAS protocol getter materializes synthetic trees into a self-contained
 // destination directory and any escape would dangle.
 func (c *CAS) buildSyntheticTree(
-	l log.Logger, v Venv, dirPath, refHash, repoRoot string, hashAlg HashAlgorithm,
+	ctx context.Context, l log.Logger, v Venv, dirPath, refHash, repoRoot string, hashAlg HashAlgorithm,
 ) (string, error) {
 	var treeData []byte
 
@@ -495,6 +496,8 @@ func (c *CAS) buildSyntheticTree(
 		return "", fmt.Errorf("failed to store synthetic tree: %w", err)
 	}
 
+	c.pushTree(ctx, l, v, c.synthStore, treeHash)
+
 	return treeHash, nil
 }
 
@@ -0,0 +1,219 @@
+// Package audit provides the ability to check remote state backends for drift.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/configbridge"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/telemetry"
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+// DriftDetectedError is returned when at least one unit has remote state drift.
+type DriftDetectedError struct {
+	Units int
+}
+
+func (err DriftDetectedError) Error() string {
+	return fmt.Sprintf("remote state drift detected in %d unit(s)", err.Units)
+}
+
+// Result is the audit result of a single unit.
+type Result struct {
+	Path    string          `json:"path"`
+	Backend string          `json:"backend"`
+	Error   string          `json:"error,omitempty"`
+	Drifts  []backend.Drift `json:"drifts"`
+	// NotSupported is set when the backend of the unit can't be audited, in which case Drifts is always empty.
+	NotSupported bool `json:"not_supported,omitempty"`
+}
+
+// Results is the list of audited units.
+type Results []*Result
+
+// DriftCount returns the number of units with drift.
+func (results Results) DriftCount() int {
+	var count int
+
+	for _, result := range results {
+		if len(result.Drifts) > 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
+func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	var (
+		results Results
+		err     error
+	)
+
+	if opts.RunAll {
+		results, err = runAll(ctx, l, opts.TerragruntOptions)
+	} else {
+		var result *Result
+
+		result, err = runAudit(ctx, l, opts.TerragruntOptions)
+		if result != nil {
+			results = append(results, result)
+		}
+	}
+
+	if writeErr := writeResults(opts.Writers.Writer, opts.Format, results); writeErr != nil {
+		return errors.Join(err, writeErr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if count := results.DriftCount(); count > 0 {
+		return clihelper.NewExitError(DriftDetectedError{Units: count}, clihelper.ExitCodeGeneralError)
+	}
+
+	return nil
+}
+
+func runAudit(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (*Result, error) {
+	var result *Result
+
+	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "backend_audit", map[string]any{
+		"working_dir":            opts.WorkingDir,
+		"terragrunt_config_path": opts.TerragruntConfigPath,
+	}, func(ctx context.Context) error {
+		_, pctx := configbridge.NewParsingContext(ctx, l, opts)
+
+		remoteState, err := config.ParseRemoteState(ctx, l, pctx)
+		if err != nil || remoteState == nil {
+			return err
+		}
+
+		result = &Result{Path: opts.WorkingDir, Backend: remoteState.BackendName}
+
+		result.Drifts, err = remoteState.Audit(ctx, l, configbridge.RemoteStateOptsFromOpts(opts))
+		if errors.As(err, new(backend.AuditNotSupportedError)) {
+			l.Debugf("Skipping audit of %s: %v", opts.WorkingDir, err)
+
+			result.NotSupported = true
+
+			return nil
+		}
+
+		return err
+	})
+
+	return result, err
+}
+
+func runAll(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (Results, error) {
+	d := discovery.NewDiscovery(opts.WorkingDir)
+
+	components, err := d.Discover(ctx, l, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	units := components.Filter(component.UnitKind).Sort()
+
+	var results Results
+
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "backend_audit_all", map[string]any{
+		"working_dir": opts.WorkingDir,
+		"unit_count":  len(units),
+		"fail_fast":   opts.FailFast,
+	}, func(ctx context.Context) error {
+		var errs []error
+
+		for _, unit := range units {
+			unitOpts := opts.Clone()
+			unitOpts.WorkingDir = unit.Path()
+
+			configFilename := config.DefaultTerragruntConfigPath
+			if len(opts.TerragruntConfigPath) > 0 {
+				configFilename = filepath.Base(opts.TerragruntConfigPath)
+			}
+
+			unitOpts.TerragruntConfigPath = filepath.Join(unit.Path(), configFilename)
+			unitOpts.OriginalTerragruntConfigPath = unitOpts.TerragruntConfigPath
+
+			result, err := runAudit(ctx, l, unitOpts)
+			if err != nil {
+				if opts.FailFast {
+					return err
+				}
+
+				if result == nil {
+					result = &Result{Path: unit.Path()}
+				}
+
+				result.Error = err.Error()
+
+				errs = append(errs, fmt.Errorf("backend audit for unit %s failed: %w", unit.Path(), err))
+			}
+
+			if result != nil {
+				results = append(results, result)
+			}
+		}
+
+		return errors.Join(errs...)
+	})
+
+	return results, err
+}
+
+func writeResults(w io.Writer, format string, results Results) error {
+	if format == FormatJSON {
+		if results == nil {
+			results = Results{}
+		}
+
+		for _, result := range results {
+			if result.Drifts == nil {
+				result.Drifts = []backend.Drift{}
+			}
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(results)
+	}
+
+	var sb strings.Builder
+
+	for _, result := range results {
+		switch {
+		case result.Error != "":
+			fmt.Fprintf(&sb, "%s (%s): audit failed: %s\n", result.Path, result.Backend, result.Error)
+		case result.NotSupported:
+			fmt.Fprintf(&sb, "%s (%s): audit not supported\n", result.Path, result.Backend)
+		case len(result.Drifts) == 0:
+			fmt.Fprintf(&sb, "%s (%s): no drift\n", result.Path, result.Backend)
+		default:
+			fmt.Fprintf(&sb, "%s (%s): %d drift(s)\n", result.Path, result.Backend, len(result.Drifts))
+
+			for _, drift := range result.Drifts {
+				sb.WriteString("  - " + drift.String() + "\n")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
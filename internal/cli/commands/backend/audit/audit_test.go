@@ -0,0 +1,95 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend/audit"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunAll(t *testing.T) {
+	t.Parallel()
+
+	rootDir := helpers.TmpDirWOSymlinks(t)
+
+	units := map[string]string{
+		"in-sync": `
+remote_state {
+  backend = "local"
+  config = {
+    path = "terraform.tfstate"
+  }
+}
+`,
+		"drifted": `
+remote_state {
+  backend = "local"
+  config = {
+    path = "state/terraform.tfstate"
+  }
+}
+`,
+		"unsupported": `
+remote_state {
+  backend = "http"
+  config = {
+    address = "https://state.example.com/unsupported"
+  }
+}
+`,
+	}
+
+	for name, cfg := range units {
+		require.NoError(t, os.MkdirAll(filepath.Join(rootDir, name), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(rootDir, name, "terragrunt.hcl"), []byte(cfg), 0o644))
+	}
+
+	tgOpts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	tgOpts.WorkingDir = rootDir
+
+	var stdout bytes.Buffer
+
+	tgOpts.Writers.Writer = &stdout
+
+	opts := audit.NewOptions(tgOpts)
+	opts.RunAll = true
+	opts.Format = audit.FormatJSON
+
+	err = audit.Run(t.Context(), logger.CreateLogger(), opts)
+
+	var driftErr audit.DriftDetectedError
+
+	require.ErrorAs(t, err, &driftErr)
+	assert.Equal(t, 1, driftErr.Units)
+
+	var results audit.Results
+
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &results), stdout.String())
+	require.Len(t, results, 3)
+
+	byPath := make(map[string]*audit.Result, len(results))
+	for _, result := range results {
+		byPath[filepath.Base(result.Path)] = result
+	}
+
+	assert.Empty(t, byPath["in-sync"].Drifts)
+	assert.False(t, byPath["in-sync"].NotSupported)
+
+	require.Len(t, byPath["drifted"].Drifts, 1)
+	assert.Equal(t, filepath.Join(rootDir, "drifted", "state"), byPath["drifted"].Drifts[0].Resource)
+
+	assert.Equal(t, "http", byPath["unsupported"].Backend)
+	assert.True(t, byPath["unsupported"].NotSupported)
+	assert.Empty(t, byPath["unsupported"].Drifts)
+	assert.Empty(t, byPath["unsupported"].Error)
+}
@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend/bootstrap"
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags/shared"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	CommandName = "audit"
+
+	FormatFlagName = "format"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) clihelper.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return clihelper.Flags{
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.Format,
+			Usage:       "Output format for audit results. Valid values: text, json.",
+			DefaultText: FormatText,
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *clihelper.Command {
+	cmdOpts := NewOptions(opts)
+
+	cmdFlags := bootstrap.NewFlags(opts)
+	cmdFlags = append(cmdFlags, NewFlags(cmdOpts, nil)...)
+	cmdFlags = append(cmdFlags, shared.NewAllFlag(opts, nil), shared.NewFailFastFlag(opts))
+
+	return &clihelper.Command{
+		Name:  CommandName,
+		Usage: "Check OpenTofu/Terraform backend infrastructure for drift from the remote_state configuration.",
+		Flags: cmdFlags,
+		Before: func(_ context.Context, _ *clihelper.Context) error {
+			if err := cmdOpts.Validate(); err != nil {
+				return clihelper.NewExitError(err, clihelper.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx context.Context, _ *clihelper.Context) error {
+			cmdOpts.TerragruntOptions = opts.OptionsFromContext(ctx)
+
+			return Run(ctx, l, cmdOpts)
+		},
+	}
+}
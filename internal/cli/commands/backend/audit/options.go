@@ -0,0 +1,38 @@
+package audit
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	// FormatText outputs the audit results in text format.
+	FormatText = "text"
+
+	// FormatJSON outputs the audit results in JSON format.
+	FormatJSON = "json"
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// Format determines the format of the output.
+	Format string
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+		Format:            FormatText,
+	}
+}
+
+func (o *Options) Validate() error {
+	switch o.Format {
+	case FormatText, FormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid format: %s, valid values: %s, %s", o.Format, FormatText, FormatJSON)
+	}
+}
@@ -2,8 +2,10 @@
 package backend
 
 import (
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend/audit"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend/bootstrap"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend/delete"
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend/locks"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend/migrate"
 	"github.com/gruntwork-io/terragrunt/internal/clihelper"
 	"github.com/gruntwork-io/terragrunt/internal/venv"
@@ -19,8 +21,10 @@ func NewCommand(l log.Logger, opts *options.TerragruntOptions, v venv.Venv) *cli
 		Usage: "Interact with OpenTofu/Terraform backend infrastructure.",
 		Subcommands: clihelper.Commands{
 			bootstrap.NewCommand(l, opts),
+			audit.NewCommand(l, opts),
 			delete.NewCommand(l, opts),
 			migrate.NewCommand(l, opts, v),
+			locks.NewCommand(l, opts),
 		},
 		Action: clihelper.ShowCommandHelp,
 	}
@@ -0,0 +1,76 @@
+package locks
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags/shared"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	CommandName = "locks"
+
+	FormatFlagName    = "format"
+	OlderThanFlagName = "older-than"
+	UnlockFlagName    = "unlock"
+)
+
+func NewFlags(l log.Logger, opts *Options, prefix flags.Prefix) clihelper.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	sharedFlags := clihelper.Flags{
+		shared.NewConfigFlag(opts.TerragruntOptions, prefix),
+		shared.NewDownloadDirFlag(opts.TerragruntOptions, prefix),
+		shared.NewFailFastFlag(opts.TerragruntOptions),
+	}
+	sharedFlags = append(sharedFlags, shared.NewBackendFlags(opts.TerragruntOptions, prefix)...)
+	sharedFlags = append(sharedFlags, shared.NewFeatureFlags(opts.TerragruntOptions, prefix)...)
+	sharedFlags = append(sharedFlags, shared.NewFilterFlags(l, opts.TerragruntOptions)...)
+
+	return append(sharedFlags,
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        FormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(FormatFlagName),
+			Destination: &opts.Format,
+			Usage:       "Output format for the locks. Valid values: text, json.",
+			DefaultText: FormatText,
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        OlderThanFlagName,
+			EnvVars:     tgPrefix.EnvVars(OlderThanFlagName),
+			Destination: &opts.OlderThan,
+			Usage:       "Only include locks held for longer than the given duration, e.g. 30m or 2h.",
+		}),
+		flags.NewFlag(&clihelper.BoolFlag{
+			Name:        UnlockFlagName,
+			EnvVars:     tgPrefix.EnvVars(UnlockFlagName),
+			Destination: &opts.Unlock,
+			Usage:       "Force-unlock the listed locks, after confirmation.",
+		}),
+	)
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *clihelper.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &clihelper.Command{
+		Name:  CommandName,
+		Usage: "List and force-unlock the state locks held by the units of the stack.",
+		Flags: NewFlags(l, cmdOpts, nil),
+		Before: func(_ context.Context, _ *clihelper.Context) error {
+			if err := cmdOpts.Validate(); err != nil {
+				return clihelper.NewExitError(err, clihelper.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx context.Context, _ *clihelper.Context) error {
+			cmdOpts.TerragruntOptions = opts.OptionsFromContext(ctx)
+
+			return Run(ctx, l, cmdOpts)
+		},
+	}
+}
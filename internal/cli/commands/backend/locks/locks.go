@@ -0,0 +1,297 @@
+// Package locks provides the ability to list and force-unlock the state locks held across a stack.
+package locks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/configbridge"
+	"github.com/gruntwork-io/terragrunt/internal/discovery"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/shell"
+	"github.com/gruntwork-io/terragrunt/internal/telemetry"
+	"github.com/gruntwork-io/terragrunt/internal/worktrees"
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+// HeldLock is a state lock held by a unit.
+type HeldLock struct {
+	*backend.Lock
+
+	remoteState *remotestate.RemoteState
+	unitOpts    *options.TerragruntOptions
+
+	Unit    string
+	Backend string
+	Age     time.Duration
+}
+
+// MarshalJSON implements `json.Marshaler` interface. The lock is written with snake case keys, like the output of
+// `backend audit`, rather than the keys of the lock info OpenTofu/Terraform stores.
+func (lock *HeldLock) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Created    *time.Time `json:"created,omitempty"`
+		Unit       string     `json:"unit"`
+		Backend    string     `json:"backend"`
+		ID         string     `json:"id"`
+		Operation  string     `json:"operation"`
+		Who        string     `json:"who"`
+		Version    string     `json:"version,omitempty"`
+		Path       string     `json:"path,omitempty"`
+		Info       string     `json:"info,omitempty"`
+		AgeSeconds int64      `json:"age_seconds"`
+	}{
+		Created:    lock.created(),
+		Unit:       lock.Unit,
+		Backend:    lock.Backend,
+		ID:         lock.ID,
+		Operation:  lock.Operation,
+		Who:        lock.Who,
+		Version:    lock.Version,
+		Path:       lock.Path,
+		Info:       lock.Info,
+		AgeSeconds: int64(lock.Age / time.Second),
+	})
+}
+
+// created returns the time the lock was acquired, or nil when the backend doesn't record it.
+func (lock *HeldLock) created() *time.Time {
+	if lock.Created.IsZero() {
+		return nil
+	}
+
+	return &lock.Created
+}
+
+// UnsupportedUnit is a unit whose backend can't list its state locks.
+type UnsupportedUnit struct {
+	Unit    string
+	Backend string
+}
+
+// HeldLocks is the list of locks held across the stack.
+type HeldLocks []*HeldLock
+
+// OlderThan returns the locks held for longer than the given duration.
+func (locks HeldLocks) OlderThan(age time.Duration) HeldLocks {
+	var filtered HeldLocks
+
+	for _, lock := range locks {
+		if lock.Age >= age {
+			filtered = append(filtered, lock)
+		}
+	}
+
+	return filtered
+}
+
+func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	// Locks of the units that could be inspected are still listed, and unlocked, when other units fail.
+	locks, unsupported, listErr := listLocks(ctx, l, opts.TerragruntOptions)
+	if listErr != nil && opts.FailFast {
+		return listErr
+	}
+
+	if opts.olderThan > 0 {
+		locks = locks.OlderThan(opts.olderThan)
+	}
+
+	if err := writeLocks(opts.Writers.Writer, opts.Format, locks, unsupported); err != nil {
+		return errors.Join(listErr, err)
+	}
+
+	if !opts.Unlock || len(locks) == 0 {
+		return listErr
+	}
+
+	prompt := fmt.Sprintf("%d state lock(s) will be force-unlocked. Make sure no OpenTofu/Terraform process is still holding them. Do you want to continue?", len(locks))
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.NonInteractive, opts.Writers.ErrWriter); err != nil || !yes {
+		return errors.Join(listErr, err)
+	}
+
+	return errors.Join(listErr, unlockAll(ctx, l, locks, opts.FailFast))
+}
+
+func listLocks(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) (HeldLocks, []*UnsupportedUnit, error) {
+	d := discovery.NewDiscovery(opts.WorkingDir)
+
+	if len(opts.Filters) > 0 {
+		d = d.WithFilters(opts.Filters)
+	}
+
+	// We do worktree generation here instead of in the discovery constructor
+	// so that we can defer cleanup in the same context.
+	worktrees, err := worktrees.NewWorktrees(ctx, l, worktrees.WorktreeOpts{
+		WorkingDir:     opts.WorkingDir,
+		GitExpressions: opts.Filters.UniqueGitFilters(),
+		Experiments:    opts.Experiments,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create worktrees: %w", err)
+	}
+
+	defer func() {
+		if cleanupErr := worktrees.Cleanup(ctx, l); cleanupErr != nil {
+			l.Errorf("failed to cleanup worktrees: %v", cleanupErr)
+		}
+	}()
+
+	components, err := d.WithWorktrees(worktrees).Discover(ctx, l, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	units := components.Filter(component.UnitKind).Sort()
+	now := time.Now()
+
+	var (
+		locks       HeldLocks
+		unsupported []*UnsupportedUnit
+	)
+
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "backend_locks", map[string]any{
+		"working_dir": opts.WorkingDir,
+		"unit_count":  len(units),
+		"fail_fast":   opts.FailFast,
+	}, func(ctx context.Context) error {
+		var errs []error
+
+		for _, unit := range units {
+			unitOpts := opts.Clone()
+			unitOpts.WorkingDir = unit.Path()
+
+			configFilename := config.DefaultTerragruntConfigPath
+			if len(opts.TerragruntConfigPath) > 0 {
+				configFilename = filepath.Base(opts.TerragruntConfigPath)
+			}
+
+			unitOpts.TerragruntConfigPath = filepath.Join(unit.Path(), configFilename)
+			unitOpts.OriginalTerragruntConfigPath = unitOpts.TerragruntConfigPath
+
+			unitLocks, err := listUnitLocks(ctx, l, unitOpts, now)
+
+			var notSupportedErr backend.LocksNotSupportedError
+			if errors.As(err, &notSupportedErr) {
+				l.Warnf("Unable to list the state locks of unit %s: %v", unit.Path(), err)
+
+				unsupported = append(unsupported, &UnsupportedUnit{Unit: unit.Path(), Backend: notSupportedErr.BackendName})
+
+				continue
+			}
+
+			if err != nil {
+				if opts.FailFast {
+					return err
+				}
+
+				errs = append(errs, fmt.Errorf("listing state locks for unit %s failed: %w", unit.Path(), err))
+
+				continue
+			}
+
+			locks = append(locks, unitLocks...)
+		}
+
+		return errors.Join(errs...)
+	})
+
+	return locks, unsupported, err
+}
+
+func listUnitLocks(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, now time.Time) (HeldLocks, error) {
+	_, pctx := configbridge.NewParsingContext(ctx, l, opts)
+
+	remoteState, err := config.ParseRemoteState(ctx, l, pctx)
+	if err != nil || remoteState == nil {
+		return nil, err
+	}
+
+	backendLocks, err := remoteState.Locks(ctx, l, configbridge.RemoteStateOptsFromOpts(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	locks := make(HeldLocks, 0, len(backendLocks))
+
+	for _, lock := range backendLocks {
+		locks = append(locks, &HeldLock{
+			Lock:        lock,
+			Unit:        opts.WorkingDir,
+			Backend:     remoteState.BackendName,
+			Age:         lock.Age(now),
+			remoteState: remoteState,
+			unitOpts:    opts,
+		})
+	}
+
+	return locks, nil
+}
+
+func unlockAll(ctx context.Context, l log.Logger, locks HeldLocks, failFast bool) error {
+	var errs []error
+
+	for _, lock := range locks {
+		l.Infof("Force-unlocking state lock %s of unit %s", lock.ID, lock.Unit)
+
+		if err := lock.remoteState.ForceUnlock(ctx, l, lock.ID, configbridge.RemoteStateOptsFromOpts(lock.unitOpts)); err != nil {
+			if failFast {
+				return err
+			}
+
+			errs = append(errs, fmt.Errorf("force-unlocking state lock %s of unit %s failed: %w", lock.ID, lock.Unit, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func writeLocks(w io.Writer, format string, locks HeldLocks, unsupported []*UnsupportedUnit) error {
+	if format == FormatJSON {
+		if locks == nil {
+			locks = HeldLocks{}
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(locks)
+	}
+
+	// Units whose backend can't list locks may still be locked, so they are listed instead of being counted as unlocked.
+	for _, unit := range unsupported {
+		if _, err := fmt.Fprintf(w, "%s (%s): listing state locks not supported\n", unit.Unit, unit.Backend); err != nil {
+			return err
+		}
+	}
+
+	if len(locks) == 0 {
+		msg := "No state locks held."
+		if len(unsupported) > 0 {
+			msg = "No state locks held in the units that support listing them."
+		}
+
+		_, err := fmt.Fprintln(w, msg)
+
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintln(tw, "UNIT\tBACKEND\tLOCK ID\tOPERATION\tWHO\tAGE")
+
+	for _, lock := range locks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", lock.Unit, lock.Backend, lock.ID, lock.Operation, lock.Who, lock.Age.Round(time.Second))
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,133 @@
+package locks_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend/locks"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeldLocks_OlderThan(t *testing.T) {
+	t.Parallel()
+
+	heldLocks := locks.HeldLocks{
+		{Lock: &backend.Lock{ID: "fresh"}, Age: 5 * time.Minute},
+		{Lock: &backend.Lock{ID: "stale"}, Age: 3 * time.Hour},
+	}
+
+	filtered := heldLocks.OlderThan(time.Hour)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "stale", filtered[0].ID)
+}
+
+func TestOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		format    string
+		olderThan string
+		wantErr   bool
+	}{
+		{name: "defaults", format: locks.FormatText},
+		{name: "json-with-age", format: locks.FormatJSON, olderThan: "90m"},
+		{name: "invalid-format", format: "yaml", wantErr: true},
+		{name: "invalid-age", format: locks.FormatText, olderThan: "yesterday", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := locks.NewOptions(options.NewTerragruntOptions())
+			opts.Format = tc.format
+			opts.OlderThan = tc.olderThan
+
+			err := opts.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHeldLock_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	heldLock := &locks.HeldLock{
+		Lock: &backend.Lock{
+			Created:   created,
+			ID:        "5a9d8c3e",
+			Operation: "OperationTypeApply",
+			Who:       "ci@runner",
+		},
+		Unit:    "live/prod/app",
+		Backend: "s3",
+		Age:     90 * time.Minute,
+	}
+
+	data, err := json.Marshal(heldLock)
+	require.NoError(t, err)
+
+	var decoded map[string]any
+
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, map[string]any{
+		"unit":        "live/prod/app",
+		"backend":     "s3",
+		"id":          "5a9d8c3e",
+		"operation":   "OperationTypeApply",
+		"who":         "ci@runner",
+		"created":     created.Format(time.RFC3339),
+		"age_seconds": float64(5400),
+	}, decoded)
+}
+
+func TestRun_NotSupported(t *testing.T) {
+	t.Parallel()
+
+	rootDir := helpers.TmpDirWOSymlinks(t)
+
+	cfg := `
+remote_state {
+  backend = "http"
+  config = {
+    address = "https://state.example.com/app"
+  }
+}
+`
+
+	require.NoError(t, os.MkdirAll(filepath.Join(rootDir, "app"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "app", "terragrunt.hcl"), []byte(cfg), 0o644))
+
+	tgOpts, err := options.NewTerragruntOptionsForTest(filepath.Join(rootDir, "terragrunt.hcl"))
+	require.NoError(t, err)
+
+	tgOpts.WorkingDir = rootDir
+
+	var stdout bytes.Buffer
+
+	tgOpts.Writers.Writer = &stdout
+
+	opts := locks.NewOptions(tgOpts)
+	require.NoError(t, opts.Validate())
+	require.NoError(t, locks.Run(t.Context(), logger.CreateLogger(), opts))
+
+	assert.Contains(t, stdout.String(), filepath.Join(rootDir, "app")+" (http): listing state locks not supported")
+	assert.NotContains(t, stdout.String(), "No state locks held.")
+}
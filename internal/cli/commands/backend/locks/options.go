@@ -0,0 +1,59 @@
+package locks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	// FormatText outputs the locks in text format.
+	FormatText = "text"
+
+	// FormatJSON outputs the locks in JSON format.
+	FormatJSON = "json"
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// Format determines the format of the output.
+	Format string
+
+	// OlderThan is the minimum age, as a duration string, of the locks to list and unlock.
+	OlderThan string
+
+	// Unlock force-unlocks the listed locks, after confirmation.
+	Unlock bool
+
+	olderThan time.Duration
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+		Format:            FormatText,
+	}
+}
+
+func (o *Options) Validate() error {
+	switch o.Format {
+	case FormatText, FormatJSON:
+	default:
+		return fmt.Errorf("invalid format: %s, valid values: %s, %s", o.Format, FormatText, FormatJSON)
+	}
+
+	if o.OlderThan == "" {
+		return nil
+	}
+
+	olderThan, err := time.ParseDuration(o.OlderThan)
+	if err != nil {
+		return fmt.Errorf("invalid --%s value %q: %w", OlderThanFlagName, o.OlderThan, err)
+	}
+
+	o.olderThan = olderThan
+
+	return nil
+}
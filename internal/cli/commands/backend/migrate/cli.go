@@ -16,13 +16,17 @@ import (
 const (
 	CommandName = "migrate"
 
-	ForceBackendMigrateFlagName = "force"
+	ForceBackendMigrateFlagName  = "force"
+	DryRunBackendMigrateFlagName = "dry-run"
 
 	usageText = "terragrunt backend migrate [options] <src-unit> <dst-unit>"
 )
 
 func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix) clihelper.Flags {
 	tgPrefix := prefix.Prepend(flags.TgPrefix)
+	// The dry run is prefixed with the command, TG_BACKEND_MIGRATE_DRY_RUN, so it doesn't turn on the dry runs of
+	// other commands.
+	migratePrefix := prefix.Append("backend").Append(CommandName).Prepend(flags.TgPrefix)
 
 	sharedFlags := clihelper.Flags{
 		shared.NewConfigFlag(opts, prefix),
@@ -35,9 +39,15 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 		flags.NewFlag(&clihelper.BoolFlag{
 			Name:        ForceBackendMigrateFlagName,
 			EnvVars:     tgPrefix.EnvVars(ForceBackendMigrateFlagName),
-			Usage:       "Force the backend to be migrated, even if the bucket is not versioned.",
+			Usage:       "Force the backend to be migrated, even if the bucket is not versioned or the destination state has a different lineage or a newer serial.",
 			Destination: &opts.ForceBackendMigrate,
 		}),
+		flags.NewFlag(&clihelper.BoolFlag{
+			Name:        DryRunBackendMigrateFlagName,
+			EnvVars:     migratePrefix.EnvVars(DryRunBackendMigrateFlagName),
+			Usage:       "Print the state that would be migrated, without migrating it.",
+			Destination: &opts.DryRunBackendMigrate,
+		}),
 	)
 }
 
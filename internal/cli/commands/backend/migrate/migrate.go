@@ -12,6 +12,7 @@ import (
 
 	"errors"
 
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
 	"github.com/gruntwork-io/terragrunt/internal/util"
 	"github.com/gruntwork-io/terragrunt/pkg/config"
@@ -102,8 +103,47 @@ func Run(
 	// Same for the destination: pushState needs the cache directory.
 	dstOpts.WorkingDir = dstPctx.WorkingDir
 
-	if !opts.ForceBackendMigrate {
-		enabled, err := srcRemoteState.IsVersionControlEnabled(ctx, l, configbridge.RemoteStateOptsFromOpts(srcOpts))
+	srcRemoteStateOpts := configbridge.RemoteStateOptsFromOpts(srcOpts)
+	dstRemoteStateOpts := configbridge.RemoteStateOptsFromOpts(dstOpts)
+
+	plan, err := srcRemoteState.PlanMigration(ctx, l, v.Exec, srcRemoteStateOpts, dstRemoteStateOpts, dstRemoteState)
+	if err != nil {
+		return err
+	}
+
+	preflightErr := preflight(ctx, l, srcRemoteState, srcRemoteStateOpts, plan, opts.ForceBackendMigrate)
+
+	if opts.DryRunBackendMigrate {
+		if preflightErr != nil {
+			l.Warnf("Migration would fail: %v", preflightErr)
+		}
+
+		_, err = fmt.Fprint(opts.Writers.Writer, plan.String())
+
+		return err
+	}
+
+	if preflightErr != nil {
+		return preflightErr
+	}
+
+	return srcRemoteState.Migrate(
+		ctx, l,
+		v.Exec,
+		srcRemoteStateOpts,
+		dstRemoteStateOpts,
+		dstRemoteState,
+		plan,
+		opts.ForceBackendMigrate,
+	)
+}
+
+// preflight runs the checks that both a real and a dry run of `backend migrate` perform before moving any state,
+// unless `force` is set: the source backend must version the state, and the destination must not hold an unrelated
+// or newer state.
+func preflight(ctx context.Context, l log.Logger, srcRemoteState *remotestate.RemoteState, srcOpts *remotestate.Options, plan *remotestate.MigrationPlan, force bool) error {
+	if !force {
+		enabled, err := srcRemoteState.IsVersionControlEnabled(ctx, l, srcOpts)
 		if err != nil && !errors.As(err, new(backend.BucketDoesNotExistError)) {
 			return err
 		}
@@ -116,11 +156,5 @@ func Run(
 		}
 	}
 
-	return srcRemoteState.Migrate(
-		ctx, l,
-		v.Exec,
-		configbridge.RemoteStateOptsFromOpts(srcOpts),
-		configbridge.RemoteStateOptsFromOpts(dstOpts),
-		dstRemoteState,
-	)
+	return plan.Check(force)
 }
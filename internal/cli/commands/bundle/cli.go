@@ -0,0 +1,25 @@
+// Package bundle provides commands for running Terragrunt without network access.
+package bundle
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/bundle/create"
+	bundleimport "github.com/gruntwork-io/terragrunt/internal/cli/commands/bundle/import"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/internal/venv"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const CommandName = "bundle"
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions, v venv.Venv) *clihelper.Command {
+	return &clihelper.Command{
+		Name:  CommandName,
+		Usage: "Bundle sources, providers and engines for running units without network access.",
+		Subcommands: clihelper.Commands{
+			create.NewCommand(l, opts, v),
+			bundleimport.NewCommand(l, opts, v),
+		},
+		Action: clihelper.ShowCommandHelp,
+	}
+}
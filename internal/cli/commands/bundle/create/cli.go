@@ -0,0 +1,62 @@
+package create
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run"
+	"github.com/gruntwork-io/terragrunt/internal/venv"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	CommandName = "create"
+
+	OutFlagName      = "out"
+	PlatformFlagName = "platform"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) clihelper.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return clihelper.Flags{
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        OutFlagName,
+			EnvVars:     tgPrefix.EnvVars(OutFlagName),
+			Destination: &opts.Out,
+			Usage:       "The path the bundle archive is written to.",
+			DefaultText: DefaultOut,
+		}),
+		flags.NewFlag(&clihelper.SliceFlag[string]{
+			Name:        PlatformFlagName,
+			EnvVars:     tgPrefix.EnvVars(PlatformFlagName),
+			Destination: &opts.Platforms,
+			Usage:       "The platforms to bundle providers for, e.g. linux_amd64. Can be specified multiple times. By default, the current platform.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions, v venv.Venv) *clihelper.Command {
+	prefix := flags.Prefix{"bundle", CommandName}
+	cmdOpts := NewOptions(opts)
+
+	return &clihelper.Command{
+		Name:  CommandName,
+		Usage: "Bundle the sources, providers and engines of the discovered units into a portable archive.",
+		Flags: NewFlags(cmdOpts, prefix),
+		Before: func(_ context.Context, _ *clihelper.Context) error {
+			if err := cmdOpts.Validate(); err != nil {
+				return clihelper.NewExitError(err, clihelper.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx context.Context, _ *clihelper.Context) error {
+			cmdOpts.TerragruntOptions = opts.OptionsFromContext(ctx)
+
+			return Run(ctx, l, run.FromRoot(v), cmdOpts)
+		},
+	}
+}
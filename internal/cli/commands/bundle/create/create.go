@@ -0,0 +1,19 @@
+// Package create provides the command to bundle the dependencies of units for air-gapped environments.
+package create
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/bundle"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+func Run(ctx context.Context, l log.Logger, v run.Venv, opts *Options) error {
+	_, err := bundle.Create(ctx, l, v, opts.TerragruntOptions, &bundle.CreateOptions{
+		Out:       opts.Out,
+		Platforms: opts.Platforms,
+	})
+
+	return err
+}
@@ -0,0 +1,43 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+// DefaultOut is the path the bundle is written to, unless another one is specified.
+const DefaultOut = "terragrunt-bundle.zip"
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// Out is the path of the bundle archive to write.
+	Out string
+
+	// Platforms are the provider platforms to bundle, in the `os_arch` form.
+	Platforms []string
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+		Out:               DefaultOut,
+	}
+}
+
+func (o *Options) Validate() error {
+	if o.Out == "" {
+		return fmt.Errorf("--%s must not be empty", OutFlagName)
+	}
+
+	for _, platform := range o.Platforms {
+		osName, arch, ok := strings.Cut(platform, "_")
+		if !ok || osName == "" || arch == "" {
+			return fmt.Errorf("invalid --%s value %q: expected os_arch, e.g. linux_amd64", PlatformFlagName, platform)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package bundleimport
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run"
+	"github.com/gruntwork-io/terragrunt/internal/venv"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	CommandName = "import"
+
+	BundleDirFlagName = "bundle-dir"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) clihelper.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return clihelper.Flags{
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        BundleDirFlagName,
+			EnvVars:     tgPrefix.EnvVars(BundleDirFlagName),
+			Destination: &opts.BundleDir,
+			Usage:       "The directory the bundle providers and engines are extracted to. By default, 'terragrunt/bundle' folder in the user cache directory.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions, v venv.Venv) *clihelper.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &clihelper.Command{
+		Name:      CommandName,
+		Usage:     "Import a bundle archive so units run without network access.",
+		UsageText: "terragrunt bundle import [options] FILE",
+		Flags:     NewFlags(cmdOpts, nil),
+		Before: func(_ context.Context, cliCtx *clihelper.Context) error {
+			cmdOpts.File = cliCtx.Args().Get(0)
+
+			if err := cmdOpts.Validate(); err != nil {
+				return clihelper.NewExitError(err, clihelper.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx context.Context, _ *clihelper.Context) error {
+			cmdOpts.TerragruntOptions = opts.OptionsFromContext(ctx)
+
+			return Run(l, run.FromRoot(v), cmdOpts)
+		},
+	}
+}
@@ -0,0 +1,17 @@
+// Package bundleimport provides the command to import a bundle on an air-gapped host.
+package bundleimport
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/bundle"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+func Run(l log.Logger, v run.Venv, opts *Options) error {
+	_, err := bundle.Import(l, v, &bundle.ImportOptions{
+		File: opts.File,
+		Dir:  opts.BundleDir,
+	})
+
+	return err
+}
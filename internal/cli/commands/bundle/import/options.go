@@ -0,0 +1,42 @@
+package bundleimport
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// File is the path of the bundle archive to import.
+	File string
+
+	// BundleDir is the directory the bundle providers and engines are extracted to.
+	BundleDir string
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+	}
+}
+
+func (o *Options) Validate() error {
+	if o.File == "" {
+		return errors.New("the path of the bundle archive to import must be specified")
+	}
+
+	if o.BundleDir == "" {
+		cacheDir, err := util.EnsureCacheDir()
+		if err != nil {
+			return err
+		}
+
+		o.BundleDir = filepath.Join(cacheDir, "bundle")
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+// Package cas provides commands for managing the CAS (Content Addressable Storage) store.
+package cas
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/cas/gc"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const CommandName = "cas"
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *clihelper.Command {
+	return &clihelper.Command{
+		Name:  CommandName,
+		Usage: "Manage the CAS (Content Addressable Storage) store.",
+		Subcommands: clihelper.Commands{
+			gc.NewCommand(l, opts),
+		},
+		Action: clihelper.ShowCommandHelp,
+	}
+}
@@ -0,0 +1,66 @@
+package gc
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	CommandName = "gc"
+
+	MaxAgeFlagName  = "max-age"
+	MaxSizeFlagName = "max-size"
+	DryRunFlagName  = "dry-run"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) clihelper.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return clihelper.Flags{
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        MaxAgeFlagName,
+			EnvVars:     tgPrefix.EnvVars(MaxAgeFlagName),
+			Destination: &opts.MaxAge,
+			Usage:       "Remove content not used within this age, e.g. 720h or 30d. By default, unlimited.",
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        MaxSizeFlagName,
+			EnvVars:     tgPrefix.EnvVars(MaxSizeFlagName),
+			Destination: &opts.MaxSize,
+			Usage:       "The maximum total size of the store, e.g. 20GiB. Once exceeded, the least recently used content is removed. By default, unlimited.",
+		}),
+		flags.NewFlag(&clihelper.BoolFlag{
+			Name:        DryRunFlagName,
+			EnvVars:     tgPrefix.EnvVars(DryRunFlagName),
+			Destination: &opts.DryRun,
+			Usage:       "Report what would be removed without removing anything.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *clihelper.Command {
+	prefix := flags.Prefix{"cas", CommandName}
+	cmdOpts := NewOptions(opts)
+
+	return &clihelper.Command{
+		Name:  CommandName,
+		Usage: "Remove the blobs and trees of the CAS store no longer used by recent runs.",
+		Flags: NewFlags(cmdOpts, prefix),
+		Before: func(_ context.Context, _ *clihelper.Context) error {
+			if err := cmdOpts.Validate(); err != nil {
+				return clihelper.NewExitError(err, clihelper.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx context.Context, _ *clihelper.Context) error {
+			cmdOpts.TerragruntOptions = opts.OptionsFromContext(ctx)
+
+			return Run(ctx, l, cmdOpts)
+		},
+	}
+}
@@ -0,0 +1,41 @@
+// Package gc provides the command to garbage collect the CAS store.
+package gc
+
+import (
+	"context"
+
+	"github.com/docker/go-units"
+
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	c, err := cas.New()
+	if err != nil {
+		return err
+	}
+
+	v, err := cas.OSVenv()
+	if err != nil {
+		return err
+	}
+
+	stats, err := c.GC(ctx, l, v, opts.GCOptions)
+	if err != nil {
+		return err
+	}
+
+	action := "Removed"
+	if opts.DryRun {
+		action = "Would remove"
+	}
+
+	l.Infof(
+		"%s %d file(s), %s, from the CAS store %s. Kept %d of %d tree(s), %s.",
+		action, stats.RemovedFiles, units.BytesSize(float64(stats.RemovedBytes)), c.StorePath(),
+		stats.LiveTrees, stats.Trees, units.BytesSize(float64(stats.KeptBytes)),
+	)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package gc
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// MaxAge is the retention age, in a human-readable format, e.g. 720h or 30d.
+	MaxAge string
+
+	// MaxSize is the maximum total size of the store, in a human-readable format, e.g. 20GiB.
+	MaxSize string
+
+	// GCOptions are the parsed garbage collection options.
+	GCOptions cas.GCOptions
+
+	// DryRun reports what would be removed without removing anything.
+	DryRun bool
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+	}
+}
+
+func (o *Options) Validate() (err error) {
+	o.GCOptions.DryRun = o.DryRun
+
+	if o.MaxAge != "" {
+		if o.GCOptions.MaxAge, err = cas.ParseGCMaxAge(o.MaxAge); err != nil {
+			return err
+		}
+	}
+
+	if o.MaxSize != "" {
+		if o.GCOptions.MaxSize, err = cas.ParseGCMaxSize(o.MaxSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -60,6 +60,7 @@ func LoadURL(
 		WalkWithSymlinks: walkWithSymlinks,
 		AllowCAS:         allowCAS,
 		CASCloneDepth:    opts.CASCloneDepth,
+		CASRemote:        opts.CASRemote,
 		SlowReporting:    slowReporting,
 		RootWorkingDir:   opts.RootWorkingDir,
 	})
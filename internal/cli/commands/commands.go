@@ -29,6 +29,8 @@ import (
 
 	awsproviderpatch "github.com/gruntwork-io/terragrunt/internal/cli/commands/aws-provider-patch"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/backend"
+	bundlecmd "github.com/gruntwork-io/terragrunt/internal/cli/commands/bundle"
+	cascmd "github.com/gruntwork-io/terragrunt/internal/cli/commands/cas"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/catalog"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/dag"
 	execcmd "github.com/gruntwork-io/terragrunt/internal/cli/commands/exec"
@@ -37,6 +39,7 @@ import (
 	helpcmd "github.com/gruntwork-io/terragrunt/internal/cli/commands/help"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/info"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/list"
+	providercachecmd "github.com/gruntwork-io/terragrunt/internal/cli/commands/provider-cache"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/render"
 	runcmd "github.com/gruntwork-io/terragrunt/internal/cli/commands/run"
 	"github.com/gruntwork-io/terragrunt/internal/cli/commands/scaffold"
@@ -71,10 +74,13 @@ const (
 // Categories are ordered in increments of 10 for easy insertion of new categories.
 func New(l log.Logger, opts *options.TerragruntOptions, v venv.Venv) clihelper.Commands {
 	mainCommands := clihelper.Commands{
-		runcmd.NewCommand(l, opts, v),  // run
-		stack.NewCommand(l, opts, v),   // stack
-		execcmd.NewCommand(l, opts, v), // exec
-		backend.NewCommand(l, opts, v), // backend
+		runcmd.NewCommand(l, opts, v),        // run
+		stack.NewCommand(l, opts, v),         // stack
+		execcmd.NewCommand(l, opts, v),       // exec
+		backend.NewCommand(l, opts, v),       // backend
+		providercachecmd.NewCommand(l, opts), // provider-cache
+		bundlecmd.NewCommand(l, opts, v),     // bundle
+		cascmd.NewCommand(l, opts),           // cas
 	}.SetCategory(
 		&clihelper.Category{
 			Name:  MainCommandsCategoryName,
@@ -154,7 +160,7 @@ func WrapWithTelemetry(
 			"args":             opts.TerraformCliArgs,
 			"dir":              opts.WorkingDir,
 		}, func(childCtx context.Context) error {
-			if err := initialSetup(cliCtx, l, opts); err != nil {
+			if err := initialSetup(childCtx, cliCtx, l, opts); err != nil {
 				return err
 			}
 
@@ -293,17 +299,22 @@ func RunAction(
 			return err
 		}
 
-		ln, err := server.Listen(actionCtx)
-		if err != nil {
-			return err
+		// A shared cache server is already running, there is no need to start our own.
+		if !server.IsRemote() {
+			ln, err := server.Listen(actionCtx)
+			if err != nil {
+				return err
+			}
+			defer ln.Close() //nolint:errcheck
+
+			runCtx := actionCtx
+
+			errGroup.Go(func() error {
+				return server.Run(runCtx, ln)
+			})
 		}
-		defer ln.Close() //nolint:errcheck
 
 		actionCtx = tf.ContextWithTerraformCommandHook(actionCtx, server.TerraformCommandHook)
-
-		errGroup.Go(func() error {
-			return server.Run(actionCtx, ln)
-		})
 	}
 
 	// Run command action
@@ -410,7 +421,7 @@ func setupAutoProviderCacheDir(ctx context.Context, l log.Logger, opts *options.
 }
 
 // mostly preparing terragrunt options
-func initialSetup(cliCtx *clihelper.Context, l log.Logger, opts *options.TerragruntOptions) error {
+func initialSetup(ctx context.Context, cliCtx *clihelper.Context, l log.Logger, opts *options.TerragruntOptions) error {
 	// convert the rest flags (intended for terraform) to one dash, e.g. `--input=true` to `-input=true`
 	args := cliCtx.Args().WithoutBuiltinCmdSep().Normalize(clihelper.SingleDashFlag)
 	cmdName := cliCtx.Command.Name
@@ -515,6 +526,13 @@ func initialSetup(cliCtx *clihelper.Context, l log.Logger, opts *options.Terragr
 		fileFilterStrings = append(fileFilterStrings, filtersFromFile...)
 	}
 
+	fileFilterStrings = append(fileFilterStrings, opts.FilterAliases...)
+
+	fileFilterStrings, err = expandFilterAliases(ctx, l, opts, fileFilterStrings)
+	if err != nil {
+		return err
+	}
+
 	if len(fileFilterStrings) > 0 {
 		parsed, parseErr := filter.ParseFilterQueries(l, fileFilterStrings)
 		if parseErr != nil {
@@ -572,3 +590,43 @@ func initialSetup(cliCtx *clihelper.Context, l log.Logger, opts *options.Terragr
 
 	return nil
 }
+
+// expandFilterAliases replaces filter queries referencing named filters (e.g. @prod-network)
+// with the queries of the `filter` blocks declared in the root configuration.
+func expandFilterAliases(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, queries []string) ([]string, error) {
+	if !slices.ContainsFunc(queries, filter.IsAlias) {
+		return queries, nil
+	}
+
+	_, pctx := configbridge.NewParsingContext(ctx, l, opts)
+	if pctx.ScaffoldRootFileName == "" {
+		pctx.ScaffoldRootFileName = scaffold.GetDefaultRootFileName(ctx, opts)
+	}
+
+	filters, err := config.ReadFilterConfigs(ctx, l, pctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expanded := make([]string, 0, len(queries))
+
+	for _, query := range queries {
+		name, ok := filter.AliasName(query)
+		if !ok {
+			expanded = append(expanded, query)
+
+			continue
+		}
+
+		namedFilter := filters.Find(name)
+		if namedFilter == nil {
+			return nil, config.FilterNotFoundError{Name: name, Available: filters.Names()}
+		}
+
+		l.Debugf("Expanded filter @%s to %q", name, namedFilter.Query)
+
+		expanded = append(expanded, namedFilter.Query)
+	}
+
+	return expanded, nil
+}
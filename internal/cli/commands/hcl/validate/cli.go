@@ -77,6 +77,7 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions) clihelper.Flags {
 
 	flagSet = flagSet.Add(shared.NewQueueFlags(opts, nil)...)
 	flagSet = flagSet.Add(shared.NewFilterFlags(l, opts)...)
+	flagSet = flagSet.Add(shared.NewReportFlags(opts)...)
 
 	return flagSet
 }
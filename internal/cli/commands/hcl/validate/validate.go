@@ -27,6 +27,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/configbridge"
 	"github.com/gruntwork-io/terragrunt/internal/prepare"
 	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/internal/runner/common"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run"
 	"github.com/gruntwork-io/terragrunt/internal/tf"
 	"github.com/gruntwork-io/terragrunt/internal/util"
@@ -61,7 +62,11 @@ func Run(ctx context.Context, l log.Logger, v run.Venv, opts *options.Terragrunt
 }
 
 func RunValidate(ctx context.Context, l log.Logger, v run.Venv, opts *options.TerragruntOptions) error {
-	var diags diagnostic.Diagnostics
+	var (
+		diags diagnostic.Diagnostics
+		// hclDiags holds the HCL diagnostics of diags, in the same order, to record them in the report.
+		hclDiags hcl.Diagnostics
+	)
 
 	// Diagnostics handler to collect validation errors
 	diagnosticsHandler := hclparse.WithDiagnosticsHandler(func(file *hcl.File, hclDiags hcl.Diagnostics) (hcl.Diagnostics, error) {
@@ -80,6 +85,7 @@ func RunValidate(ctx context.Context, l log.Logger, v run.Venv, opts *options.Te
 			newDiag := diagnostic.NewDiagnostic(file, hclDiag)
 			if !diags.Contains(newDiag) {
 				diags = append(diags, newDiag)
+				hclDiags = append(hclDiags, hclDiag)
 			}
 		}
 
@@ -128,73 +134,142 @@ func RunValidate(ctx context.Context, l log.Logger, v run.Venv, opts *options.Te
 
 	parseOptions := []hclparse.Option{diagnosticsHandler}
 
+	var r *report.Report
+
+	if opts.ReportFile != "" {
+		r = report.NewReport().WithWorkingDir(opts.WorkingDir).WithFormat(opts.ReportFormat)
+
+		defer func() {
+			if err := r.WriteToFile(v.FS, opts.ReportFile); err != nil {
+				l.Warnf("Failed to write report to %s: %v", opts.ReportFile, err)
+			}
+		}()
+	}
+
 	parseErrs := []error{}
 
 	for _, c := range components {
-		parseOpts := opts.Clone()
-		parseOpts.WorkingDir = c.Path()
+		diagsBefore := len(diags)
 
-		if _, ok := c.(*component.Stack); ok {
-			stackFilePath := filepath.Join(c.Path(), config.DefaultStackFile)
-			parseOpts.TerragruntConfigPath = stackFilePath
+		errs := validateComponent(ctx, l, v, opts, c, parseOptions)
+		parseErrs = append(parseErrs, errs...)
 
-			ctx, parser := configbridge.NewParsingContext(ctx, l, parseOpts)
-			parser.Venv = v.ToRoot()
+		if r != nil {
+			reportValidation(l, r, c, hclDiags[diagsBefore:], errs)
+		}
+	}
 
-			values, err := config.ReadValues(ctx, parser, l, c.Path())
-			if err != nil {
-				parseErrs = append(parseErrs, err)
-			}
+	var combinedErr error
+	if len(parseErrs) > 0 {
+		combinedErr = errors.Join(parseErrs...)
+	}
 
-			parser = parser.WithParseOption(parseOptions)
-			if values != nil {
-				parser = parser.WithValues(values)
-			}
+	return processDiagnostics(l, opts, diags, combinedErr)
+}
 
-			file, err := hclparse.NewParser(parser.ParserOptions...).ParseFromFile(stackFilePath)
-			if err != nil {
-				parseErrs = append(parseErrs, err)
-				continue
-			}
+// validateComponent parses the configuration of the component, returning the errors encountered.
+func validateComponent(
+	ctx context.Context,
+	l log.Logger,
+	v run.Venv,
+	opts *options.TerragruntOptions,
+	c component.Component,
+	parseOptions []hclparse.Option,
+) []error {
+	var errs []error
 
-			stackCfg, err := config.ParseStackConfig(ctx, l, parser, file, values)
-			if err != nil {
-				parseErrs = append(parseErrs, err)
-				continue
-			}
+	parseOpts := opts.Clone()
+	parseOpts.WorkingDir = c.Path()
 
-			// The lenient stack decode above leaves autoinclude blocks unvalidated, so run the
-			// strict autoinclude parse `stack generate` uses. It no-ops unless the
-			// stack-dependencies experiment is enabled and the config declares autoinclude.
-			if err := config.ValidateStackAutoIncludes(ctx, l, parser, stackFilePath, stackCfg, values); err != nil {
-				parseErrs = append(parseErrs, err)
-			}
+	if _, ok := c.(*component.Stack); ok {
+		stackFilePath := filepath.Join(c.Path(), config.DefaultStackFile)
+		parseOpts.TerragruntConfigPath = stackFilePath
 
-			continue
+		ctx, parser := configbridge.NewParsingContext(ctx, l, parseOpts)
+		parser.Venv = v.ToRoot()
+
+		values, err := config.ReadValues(ctx, parser, l, c.Path())
+		if err != nil {
+			errs = append(errs, err)
 		}
 
-		// Determine which config filename to use for a full parse
-		configFilename := config.DefaultTerragruntConfigPath
-		if len(opts.TerragruntConfigPath) > 0 {
-			configFilename = filepath.Base(opts.TerragruntConfigPath)
+		parser = parser.WithParseOption(parseOptions)
+		if values != nil {
+			parser = parser.WithValues(values)
 		}
 
-		parseOpts.TerragruntConfigPath = filepath.Join(c.Path(), configFilename)
+		file, err := hclparse.NewParser(parser.ParserOptions...).ParseFromFile(stackFilePath)
+		if err != nil {
+			return append(errs, err)
+		}
 
-		_, pctx := configbridge.NewParsingContext(ctx, l, parseOpts)
-		pctx.Venv = v.ToRoot()
+		stackCfg, err := config.ParseStackConfig(ctx, l, parser, file, values)
+		if err != nil {
+			return append(errs, err)
+		}
 
-		if _, err := config.ReadTerragruntConfig(ctx, l, pctx, parseOptions); err != nil {
-			parseErrs = append(parseErrs, err)
+		// The lenient stack decode above leaves autoinclude blocks unvalidated, so run the
+		// strict autoinclude parse `stack generate` uses. It no-ops unless the
+		// stack-dependencies experiment is enabled and the config declares autoinclude.
+		if err := config.ValidateStackAutoIncludes(ctx, l, parser, stackFilePath, stackCfg, values); err != nil {
+			errs = append(errs, err)
 		}
+
+		return errs
 	}
 
-	var combinedErr error
-	if len(parseErrs) > 0 {
-		combinedErr = errors.Join(parseErrs...)
+	// Determine which config filename to use for a full parse
+	configFilename := config.DefaultTerragruntConfigPath
+	if len(opts.TerragruntConfigPath) > 0 {
+		configFilename = filepath.Base(opts.TerragruntConfigPath)
 	}
 
-	return processDiagnostics(l, opts, diags, combinedErr)
+	parseOpts.TerragruntConfigPath = filepath.Join(c.Path(), configFilename)
+
+	_, pctx := configbridge.NewParsingContext(ctx, l, parseOpts)
+	pctx.Venv = v.ToRoot()
+
+	if _, err := config.ReadTerragruntConfig(ctx, l, pctx, parseOptions); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// reportValidation records the validation of the component in the report, failed if any errors were found in it.
+// Warnings are recorded as diagnostics of a successful run.
+func reportValidation(l log.Logger, r *report.Report, c component.Component, diags hcl.Diagnostics, errs []error) {
+	path := filepath.Clean(c.Path())
+
+	run, err := report.NewRun(path)
+	if err != nil {
+		l.Errorf("Error creating report run for %s: %v", path, err)
+		return
+	}
+
+	if err := r.AddRun(l, run); err != nil {
+		l.Errorf("Error adding report run for %s: %v", path, err)
+		return
+	}
+
+	endOpts := []report.EndOption{report.WithDiagnostics(common.HCLReportDiagnostics(diags)...)}
+
+	if diags.HasErrors() || len(errs) > 0 {
+		cause := fmt.Sprintf("%d HCL validation error(s) found", len(diags.Errs()))
+		if len(errs) > 0 {
+			cause = errors.Join(errs...).Error()
+		}
+
+		endOpts = append(endOpts,
+			report.WithResult(report.ResultFailed),
+			report.WithReason(report.ReasonRunError),
+			report.WithCauseRunError(cause),
+		)
+	}
+
+	if err := r.EndRun(l, path, endOpts...); err != nil {
+		l.Errorf("Error ending report run for %s: %v", path, err)
+	}
 }
 
 func processDiagnostics(l log.Logger, opts *options.TerragruntOptions, diags diagnostic.Diagnostics, callErr error) error {
@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportValidation(t *testing.T) {
+	t.Parallel()
+
+	subject := &hcl.Range{
+		Filename: "terragrunt.hcl",
+		Start:    hcl.Pos{Line: 1, Column: 1},
+		End:      hcl.Pos{Line: 1, Column: 5},
+	}
+
+	testCases := []struct {
+		name     string
+		diags    hcl.Diagnostics
+		expected report.Result
+	}{
+		{
+			name:     "no-diagnostics",
+			expected: report.ResultSucceeded,
+		},
+		{
+			name:     "warnings-only",
+			diags:    hcl.Diagnostics{{Severity: hcl.DiagWarning, Summary: "Deprecated attribute", Subject: subject}},
+			expected: report.ResultSucceeded,
+		},
+		{
+			name: "errors",
+			diags: hcl.Diagnostics{
+				{Severity: hcl.DiagWarning, Summary: "Deprecated attribute", Subject: subject},
+				{Severity: hcl.DiagError, Summary: "Unsupported argument", Subject: subject},
+			},
+			expected: report.ResultFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			unitPath := filepath.Join(t.TempDir(), "unit")
+			r := report.NewReport()
+
+			reportValidation(logger.CreateLogger(), r, component.NewUnit(unitPath), tc.diags, nil)
+
+			runs := r.CopyRuns()
+			require.Len(t, runs, 1)
+			assert.Equal(t, tc.expected, runs[0].Result)
+			require.Len(t, runs[0].Diagnostics, len(tc.diags))
+
+			for i, diag := range runs[0].Diagnostics {
+				assert.Equal(t, "hcl", diag.Source)
+				assert.Equal(t, tc.diags[i].Summary, diag.Message)
+				assert.Equal(t, "terragrunt.hcl", diag.File)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Package providercache provides commands for managing the Terragrunt Provider Cache.
+package providercache
+
+import (
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/provider-cache/serve"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const CommandName = "provider-cache"
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *clihelper.Command {
+	return &clihelper.Command{
+		Name:  CommandName,
+		Usage: "Manage the Terragrunt Provider Cache.",
+		Subcommands: clihelper.Commands{
+			serve.NewCommand(l, opts),
+		},
+		Action: clihelper.ShowCommandHelp,
+	}
+}
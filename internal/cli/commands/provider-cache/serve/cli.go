@@ -0,0 +1,82 @@
+package serve
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/run"
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	CommandName = "serve"
+
+	MaxSizeFlagName = "provider-cache-max-size"
+)
+
+func NewFlags(opts *Options, prefix flags.Prefix) clihelper.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+	pcOpts := &opts.ProviderCacheOptions
+
+	return clihelper.Flags{
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        run.ProviderCacheDirFlagName,
+			EnvVars:     tgPrefix.EnvVars(run.ProviderCacheDirFlagName),
+			Destination: &pcOpts.Dir,
+			Usage:       "The path to the Terragrunt provider cache directory. By default, 'terragrunt/providers' folder in the user cache directory.",
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        run.ProviderCacheTokenFlagName,
+			EnvVars:     tgPrefix.EnvVars(run.ProviderCacheTokenFlagName),
+			Destination: &pcOpts.Token,
+			Usage:       "The token the clients authenticate to the server with. By default, generated and printed to stdout on startup.",
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        run.ProviderCacheHostnameFlagName,
+			EnvVars:     tgPrefix.EnvVars(run.ProviderCacheHostnameFlagName),
+			Destination: &pcOpts.Hostname,
+			Usage:       "The hostname the server listens on. By default, 'localhost'.",
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[int]{
+			Name:        run.ProviderCachePortFlagName,
+			EnvVars:     tgPrefix.EnvVars(run.ProviderCachePortFlagName),
+			Destination: &pcOpts.Port,
+			Usage:       "The port the server listens on.",
+			DefaultText: "5758",
+		}),
+		flags.NewFlag(&clihelper.SliceFlag[string]{
+			Name:        run.ProviderCacheRegistryNamesFlagName,
+			EnvVars:     tgPrefix.EnvVars(run.ProviderCacheRegistryNamesFlagName),
+			Destination: &pcOpts.RegistryNames,
+			Usage:       "The list of remote registries to cached by the server. By default, 'registry.terraform.io', 'registry.opentofu.org'.",
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        MaxSizeFlagName,
+			EnvVars:     tgPrefix.EnvVars(MaxSizeFlagName),
+			Destination: &opts.MaxSize,
+			Usage:       "The maximum total size of the cached providers, e.g. 20GiB. Once exceeded, the least recently used providers are evicted. By default, unlimited.",
+		}),
+	}
+}
+
+func NewCommand(l log.Logger, opts *options.TerragruntOptions) *clihelper.Command {
+	cmdOpts := NewOptions(opts)
+
+	return &clihelper.Command{
+		Name:  CommandName,
+		Usage: "Run the Terragrunt Provider Cache server as a long-running service shared by Terragrunt runs.",
+		Flags: NewFlags(cmdOpts, nil),
+		Before: func(_ context.Context, _ *clihelper.Context) error {
+			if err := cmdOpts.Validate(); err != nil {
+				return clihelper.NewExitError(err, clihelper.ExitCodeGeneralError)
+			}
+
+			return nil
+		},
+		Action: func(ctx context.Context, _ *clihelper.Context) error {
+			return Run(ctx, l, cmdOpts)
+		},
+	}
+}
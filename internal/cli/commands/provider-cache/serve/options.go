@@ -0,0 +1,44 @@
+package serve
+
+import (
+	"fmt"
+
+	"github.com/docker/go-units"
+
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+// DefaultPort is the port the server listens on, unless another one is specified.
+const DefaultPort = 5758
+
+type Options struct {
+	*options.TerragruntOptions
+
+	// MaxSize is the maximum total size of the cached providers, in a human-readable format, e.g. 20GiB.
+	MaxSize string
+}
+
+func NewOptions(opts *options.TerragruntOptions) *Options {
+	return &Options{
+		TerragruntOptions: opts,
+	}
+}
+
+func (o *Options) Validate() error {
+	if o.ProviderCacheOptions.Port == 0 {
+		o.ProviderCacheOptions.Port = DefaultPort
+	}
+
+	if o.MaxSize == "" {
+		return nil
+	}
+
+	maxSize, err := units.RAMInBytes(o.MaxSize)
+	if err != nil || maxSize <= 0 {
+		return fmt.Errorf("invalid --%s value %q: expected a size such as 500MiB or 20GiB", MaxSizeFlagName, o.MaxSize)
+	}
+
+	o.ProviderCacheOptions.MaxSize = maxSize
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Package serve provides the command running the Terragrunt Provider Cache server as a long-running service.
+package serve
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	"github.com/gruntwork-io/terragrunt/internal/providercache"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// Run starts the provider cache server and serves requests until the context is canceled.
+func Run(ctx context.Context, l log.Logger, opts *Options) error {
+	pcOpts := opts.ProviderCacheOptions
+	pcOpts.Metrics = true
+	// The server itself never connects to a shared server.
+	pcOpts.ServerURL = ""
+
+	generatedToken := pcOpts.Token == ""
+	if generatedToken {
+		pcOpts.Token = uuid.New().String()
+	}
+
+	server, err := providercache.InitServer(l, &pcOpts, opts.RootWorkingDir)
+	if err != nil {
+		return err
+	}
+
+	ln, err := server.Listen(ctx)
+	if err != nil {
+		return err
+	}
+	defer ln.Close() //nolint:errcheck
+
+	serverURL := url.URL{Scheme: "http", Host: ln.Addr().String()}
+
+	l.Infof("Provider cache directory: %s", pcOpts.Dir)
+	l.Infof("Metrics are exposed on %s", serverURL.JoinPath("metrics"))
+	l.Infof("Connect Terragrunt runs to this server with --provider-cache-server-url %s", serverURL.String())
+
+	if generatedToken {
+		// The token is a secret, so it is printed once to stdout rather than written to the logs.
+		l.Infof("Generated a token, printed to stdout; pass it to the runs with --provider-cache-token")
+
+		if _, err := fmt.Fprintln(opts.Writers.Writer, pcOpts.Token); err != nil {
+			return err
+		}
+	}
+
+	return server.Run(ctx, ln)
+}
@@ -4,15 +4,12 @@ package run
 import (
 	"context"
 	"errors"
-	"fmt"
-	"path/filepath"
 
 	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/cli/flags/shared"
 	"github.com/gruntwork-io/terragrunt/internal/clihelper"
 	"github.com/gruntwork-io/terragrunt/internal/experiment"
 	"github.com/gruntwork-io/terragrunt/internal/filter"
-	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/internal/strict/controls"
 	"github.com/gruntwork-io/terragrunt/internal/util"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -52,6 +49,7 @@ const (
 	ProviderCachePortFlagName          = "provider-cache-port"
 	ProviderCacheTokenFlagName         = "provider-cache-token"
 	ProviderCacheRegistryNamesFlagName = "provider-cache-registry-names"
+	ProviderCacheServerURLFlagName     = "provider-cache-server-url"
 
 	// Engine related environment variables.
 
@@ -63,14 +61,18 @@ const (
 	// Report related flags.
 
 	SummaryDisableFlagName = "summary-disable"
-	ReportFileFlagName     = "report-file"
-	ReportFormatFlagName   = "report-format"
 	ReportSchemaFlagName   = "report-schema-file"
 
+	// Checkpoint related flags.
+
+	ResumeFlagName         = "resume"
+	CheckpointFileFlagName = "checkpoint-file"
+
 	// `--all` related flags.
 
 	OutDirFlagName     = "out-dir"
 	JSONOutDirFlagName = "json-out-dir"
+	DashboardFlagName  = "dashboard"
 
 	// `--graph` related flags.
 	GraphRootFlagName = "graph-root"
@@ -113,6 +115,13 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 		},
 			flags.WithDeprecatedEnvVars(terragruntPrefix.EnvVars("json-out-dir"), opts.StrictControls)),
 
+		flags.NewFlag(&clihelper.BoolFlag{
+			Name:        DashboardFlagName,
+			EnvVars:     tgPrefix.EnvVars(DashboardFlagName),
+			Destination: &opts.Dashboard,
+			Usage:       "Show an interactive dashboard of the run queue while running with --all.",
+		}),
+
 		// `graph/-graph` related flags.
 
 		flags.NewFlag(&clihelper.GenericFlag[string]{
@@ -387,6 +396,17 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 		},
 			flags.WithDeprecatedEnvVars(terragruntPrefix.EnvVars("provider-cache-registry-names"), opts.StrictControls)),
 
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        ProviderCacheServerURLFlagName,
+			EnvVars:     tgPrefix.EnvVars(ProviderCacheServerURLFlagName),
+			Destination: &opts.ProviderCacheOptions.ServerURL,
+			Usage:       "The URL of a shared Terragrunt Provider Cache server started with 'terragrunt provider-cache serve'. Enables provider caching through that server instead of starting one.",
+			Action: func(_ context.Context, _ *clihelper.Context, _ string) error {
+				opts.ProviderCacheOptions.Enabled = true
+				return nil
+			},
+		}),
+
 		shared.NewAuthProviderCmdFlag(opts, prefix),
 
 		shared.NewNoDiscoveryAuthProviderCmdFlag(opts, prefix),
@@ -458,66 +478,29 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 		}),
 
 		flags.NewFlag(&clihelper.GenericFlag[string]{
-			Name:    ReportFileFlagName,
-			EnvVars: tgPrefix.EnvVars(ReportFileFlagName),
-			Usage:   `Path to generate report file in.`,
-			Setter: func(value string) error {
-				if value == "" {
-					return nil
-				}
-
-				opts.ReportFile = value
-
-				ext := filepath.Ext(value)
-				if ext == "" {
-					ext = ".csv"
-				}
-
-				if ext != ".csv" && ext != ".json" {
-					return nil
-				}
-
-				if opts.ReportFormat == "" {
-					opts.ReportFormat = report.Format(ext[1:])
-				}
-
-				return nil
-			},
+			Name:        ReportSchemaFlagName,
+			EnvVars:     tgPrefix.EnvVars(ReportSchemaFlagName),
+			Usage:       `Path to generate report schema file in.`,
+			Destination: &opts.ReportSchemaFile,
 		}),
 
-		flags.NewFlag(&clihelper.GenericFlag[string]{
-			Name:    ReportFormatFlagName,
-			EnvVars: tgPrefix.EnvVars(ReportFormatFlagName),
-			Usage:   `Format of the report file.`,
-			Setter: func(value string) error {
-				if value == "" && opts.ReportFormat == "" {
-					opts.ReportFormat = report.FormatCSV
-
-					return nil
-				}
-
-				opts.ReportFormat = report.Format(value)
-
-				switch opts.ReportFormat {
-				case report.FormatCSV:
-				case report.FormatJSON:
-				default:
-					return fmt.Errorf("unsupported report format: %s", value)
-				}
-
-				return nil
-			},
+		flags.NewFlag(&clihelper.BoolFlag{
+			Name:        ResumeFlagName,
+			EnvVars:     tgPrefix.EnvVars(ResumeFlagName),
+			Destination: &opts.Resume,
+			Usage:       `Resume a run --all from its checkpoint, if there is one, only running the units that failed, exited early or did not run. Enables checkpoints.`,
 		}),
 
 		flags.NewFlag(&clihelper.GenericFlag[string]{
-			Name:        ReportSchemaFlagName,
-			EnvVars:     tgPrefix.EnvVars(ReportSchemaFlagName),
-			Usage:       `Path to generate report schema file in.`,
-			Destination: &opts.ReportSchemaFile,
+			Name:        CheckpointFileFlagName,
+			EnvVars:     tgPrefix.EnvVars(CheckpointFileFlagName),
+			Usage:       `Path to the checkpoint file of a run --all. Setting it enables checkpoints.`,
+			Destination: &opts.CheckpointFile,
 		}),
 	}
 
 	// Add shared flags
+	cmdFlags = cmdFlags.Add(shared.NewReportFlags(opts)...)
 	cmdFlags = cmdFlags.Add(shared.NewBackendFlags(opts, prefix)...)
 	cmdFlags = cmdFlags.Add(shared.NewFeatureFlags(opts, prefix)...)
 	cmdFlags = cmdFlags.Add(shared.NewFailFastFlag(opts))
@@ -526,6 +509,7 @@ func NewFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix
 	cmdFlags = cmdFlags.Add(shared.NewFilterFlags(l, opts)...)
 	cmdFlags = cmdFlags.Add(shared.NewParallelismFlag(opts))
 	cmdFlags = cmdFlags.Add(shared.NewCASFlags(opts, prefix)...)
+	cmdFlags = cmdFlags.Add(shared.NewCASAutoGCFlags(opts, prefix)...)
 
 	return cmdFlags.Sort()
 }
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/gruntwork-io/terragrunt/internal/cas"
 	"github.com/gruntwork-io/terragrunt/internal/configbridge"
 	"github.com/gruntwork-io/terragrunt/internal/os/stdout"
 	"github.com/gruntwork-io/terragrunt/internal/report"
@@ -50,6 +51,8 @@ func Run(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, v v
 	tgOpts := opts.OptionsFromContext(ctx)
 	rv := run.FromRoot(v)
 
+	defer AutoGCCAS(ctx, l, tgOpts)
+
 	if tgOpts.RunAll {
 		return runall.Run(ctx, l, rv, tgOpts)
 	}
@@ -155,6 +158,36 @@ func Run(ctx context.Context, l log.Logger, opts *options.TerragruntOptions, v v
 	return runErr
 }
 
+// AutoGCCAS garbage collects the CAS store once a run completes, at most once per [cas.AutoGCInterval],
+// when --cas-auto-gc-max-age or --cas-auto-gc-max-size is set. Failures are logged, they never fail the run.
+func AutoGCCAS(ctx context.Context, l log.Logger, opts *options.TerragruntOptions) {
+	if opts.NoCAS || (opts.CASAutoGCMaxAge == 0 && opts.CASAutoGCMaxSize == 0) {
+		return
+	}
+
+	c, err := cas.New()
+	if err != nil {
+		l.Warnf("Failed to initialize CAS for garbage collection: %v", err)
+		return
+	}
+
+	v, err := cas.OSVenv()
+	if err != nil {
+		l.Warnf("Failed to initialize CAS environment for garbage collection: %v", err)
+		return
+	}
+
+	stats, err := c.AutoGC(ctx, l, v, cas.GCOptions{MaxAge: opts.CASAutoGCMaxAge, MaxSize: opts.CASAutoGCMaxSize})
+	if err != nil {
+		l.Warnf("Failed to garbage collect the CAS store: %v", err)
+		return
+	}
+
+	if stats != nil {
+		l.Debugf("Garbage collected the CAS store: removed %d file(s), %d bytes", stats.RemovedFiles, stats.RemovedBytes)
+	}
+}
+
 // isTerraformPath returns true if the TFPath ends with the default Terraform path.
 // This is used by help.go to determine whether to show "Terraform" or "OpenTofu" in help text.
 func isTerraformPath(opts *options.TerragruntOptions) bool {
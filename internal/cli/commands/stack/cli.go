@@ -3,6 +3,7 @@ package stack
 
 import (
 	"context"
+	"errors"
 
 	runcmd "github.com/gruntwork-io/terragrunt/internal/cli/commands/run"
 	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
@@ -15,19 +16,25 @@ import (
 
 const (
 	// CommandName stack command name.
-	CommandName          = "stack"
-	OutputFormatFlagName = "format"
-	JSONFormatFlagName   = "json"
-	RawFormatFlagName    = "raw"
-	NoStackValidate      = "no-stack-validate"
+	CommandName           = "stack"
+	OutputFormatFlagName  = "format"
+	JSONFormatFlagName    = "json"
+	RawFormatFlagName     = "raw"
+	NoStackValidate       = "no-stack-validate"
+	DryRunFlagName        = "dry-run"
+	ShowSensitiveFlagName = "show-sensitive"
 
 	generateCommandName = "generate"
 	runCommandName      = "run"
 	outputCommandName   = "output"
 	cleanCommandName    = "clean"
 
-	rawOutputFormat  = "raw"
-	jsonOutputFormat = "json"
+	rawOutputFormat    = "raw"
+	jsonOutputFormat   = "json"
+	textOutputFormat   = "text"
+	dotenvOutputFormat = "dotenv"
+	yamlOutputFormat   = "yaml"
+	tfvarsOutputFormat = "tfvars"
 )
 
 // NewCommand builds the command for stack.
@@ -39,10 +46,23 @@ func NewCommand(l log.Logger, opts *options.TerragruntOptions, v venv.Venv) *cli
 			&clihelper.Command{
 				Name:  generateCommandName,
 				Usage: "Generate a stack from a terragrunt.stack.hcl file",
+				Before: func(_ context.Context, _ *clihelper.Context) error {
+					// The format only applies to the diff printed by a dry run.
+					if !opts.StackGenerateDryRun {
+						return nil
+					}
+
+					switch opts.StackGenerateFormat {
+					case "", textOutputFormat, jsonOutputFormat:
+						return nil
+					default:
+						return errors.New("invalid format: " + opts.StackGenerateFormat)
+					}
+				},
 				Action: func(ctx context.Context, _ *clihelper.Context) error {
 					return RunGenerate(ctx, l, opts.OptionsFromContext(ctx))
 				},
-				Flags: defaultFlags(l, opts, nil),
+				Flags: generateFlags(l, opts, nil),
 			},
 			&clihelper.Command{
 				Name:  runCommandName,
@@ -93,6 +113,30 @@ func defaultFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Pr
 	return append(runcmd.NewFlags(l, opts, nil), flags...)
 }
 
+func generateFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix) clihelper.Flags {
+	// The environment variables of the dry run flags are prefixed with the command, e.g. TG_STACK_GENERATE_DRY_RUN,
+	// so they don't turn on the dry runs of other commands.
+	tgPrefix := prefix.Append(CommandName).Append(generateCommandName).Prepend(flags.TgPrefix)
+
+	flags := clihelper.Flags{
+		flags.NewFlag(&clihelper.BoolFlag{
+			Name:        DryRunFlagName,
+			EnvVars:     tgPrefix.EnvVars(DryRunFlagName),
+			Destination: &opts.StackGenerateDryRun,
+			Usage:       "Render the stack into a temporary directory and show how it differs from the generated stack on disk, without changing it.",
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        OutputFormatFlagName,
+			EnvVars:     tgPrefix.EnvVars(OutputFormatFlagName),
+			Destination: &opts.StackGenerateFormat,
+			Usage:       "Format of the dry run diff. Valid values are: text, json",
+			DefaultText: textOutputFormat,
+		}),
+	}
+
+	return append(defaultFlags(l, opts, prefix), flags...)
+}
+
 func outputFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Prefix) clihelper.Flags {
 	tgPrefix := prefix.Prepend(flags.TgPrefix)
 
@@ -101,7 +145,7 @@ func outputFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Pre
 			Name:        OutputFormatFlagName,
 			EnvVars:     tgPrefix.EnvVars(OutputFormatFlagName),
 			Destination: &opts.StackOutputFormat,
-			Usage:       "Stack output format. Valid values are: json, raw",
+			Usage:       "Stack output format. Valid values are: json, raw, dotenv, yaml, tfvars",
 		}),
 		flags.NewFlag(&clihelper.BoolFlag{
 			Name:  RawFormatFlagName,
@@ -119,6 +163,12 @@ func outputFlags(l log.Logger, opts *options.TerragruntOptions, prefix flags.Pre
 				return nil
 			},
 		}),
+		flags.NewFlag(&clihelper.BoolFlag{
+			Name:        ShowSensitiveFlagName,
+			EnvVars:     tgPrefix.EnvVars(ShowSensitiveFlagName),
+			Destination: &opts.StackOutputShowSensitive,
+			Usage:       "Show the values of sensitive outputs instead of masking them.",
+		}),
 	}
 
 	return append(defaultFlags(l, opts, prefix), flags...)
@@ -0,0 +1,83 @@
+package stack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/stacks/generate"
+)
+
+// diffSymbols prefixes each change in the text rendering of a dry run diff.
+var diffSymbols = map[generate.Change]string{
+	generate.ChangeAdded:   "+",
+	generate.ChangeRemoved: "-",
+	generate.ChangeChanged: "~",
+}
+
+// PrintDiff renders the diff of a stack generate dry run as text: one line per added, stale or changed component,
+// followed by the differing files and values of changed components, and a summary.
+func PrintDiff(writer io.Writer, diff *generate.Diff) error {
+	if diff.Empty() {
+		_, err := fmt.Fprintln(writer, "No changes. The generated stacks are up to date with their stack files.")
+		return err
+	}
+
+	var sb strings.Builder
+
+	for _, cmp := range diff.Components {
+		fmt.Fprintf(&sb, "%s %-5s %s\n", diffSymbols[cmp.Change], cmp.Kind, cmp.Path)
+
+		for _, file := range cmp.Files {
+			fmt.Fprintf(&sb, "    %s %s\n", diffSymbols[file.Change], file.Path)
+		}
+
+		for _, value := range cmp.Values {
+			switch value.Change {
+			case generate.ChangeAdded:
+				fmt.Fprintf(&sb, "        + %s = %s\n", value.Name, indentValue(value.After))
+			case generate.ChangeRemoved:
+				fmt.Fprintf(&sb, "        - %s = %s\n", value.Name, indentValue(value.Before))
+			default:
+				fmt.Fprintf(&sb, "        ~ %s = %s -> %s\n", value.Name, indentValue(value.Before), indentValue(value.After))
+			}
+		}
+	}
+
+	fmt.Fprintf(
+		&sb,
+		"\nStack generation would add %d and change %d components.\n",
+		diff.Count(generate.ChangeAdded),
+		diff.Count(generate.ChangeChanged),
+	)
+
+	// Generation leaves the components it no longer produces on disk, so they are reported as stale rather than
+	// as removed.
+	if stale := diff.Count(generate.ChangeRemoved); stale > 0 {
+		fmt.Fprintf(&sb, "Stale components on disk: %d. Generation no longer produces them, and only removes them with --source-update.\n", stale)
+	}
+
+	_, err := io.WriteString(writer, sb.String())
+
+	return err
+}
+
+// indentValue aligns the continuation lines of a multi-line value with the value lines of the text diff.
+func indentValue(value string) string {
+	return strings.ReplaceAll(value, "\n", "\n          ")
+}
+
+// PrintJSONDiff renders the diff of a stack generate dry run as pretty-printed JSON with 2-space indentation.
+func PrintJSONDiff(writer io.Writer, diff *generate.Diff) error {
+	data, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if _, err := writer.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package stack_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/commands/stack"
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/stacks/generate"
+)
+
+func TestPrintDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := &generate.Diff{Components: []*generate.ComponentDiff{
+		{
+			Path:   ".terragrunt-stack/app",
+			Kind:   component.UnitKind,
+			Change: generate.ChangeChanged,
+			Files: []*generate.FileDiff{
+				{Path: "main.tf", Change: generate.ChangeAdded},
+				{Path: "terragrunt.values.hcl", Change: generate.ChangeChanged},
+			},
+			Values: []*generate.ValueDiff{
+				{Name: "region", Change: generate.ChangeRemoved, Before: `"eu"`},
+				{Name: "ver", Change: generate.ChangeChanged, Before: `"1.0"`, After: `"1.1"`},
+			},
+		},
+		{Path: ".terragrunt-stack/dev", Kind: component.StackKind, Change: generate.ChangeAdded},
+		{Path: ".terragrunt-stack/old", Kind: component.UnitKind, Change: generate.ChangeRemoved},
+	}}
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, stack.PrintDiff(&buffer, diff))
+	assert.Equal(t, `~ unit  .terragrunt-stack/app
+    + main.tf
+    ~ terragrunt.values.hcl
+        - region = "eu"
+        ~ ver = "1.0" -> "1.1"
++ stack .terragrunt-stack/dev
+- unit  .terragrunt-stack/old
+
+Stack generation would add 1 and change 1 components.
+Stale components on disk: 1. Generation no longer produces them, and only removes them with --source-update.
+`, buffer.String())
+}
+
+func TestPrintDiffWithoutChanges(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, stack.PrintDiff(&buffer, &generate.Diff{}))
+	assert.Contains(t, buffer.String(), "No changes.")
+}
+
+func TestPrintJSONDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := &generate.Diff{Components: []*generate.ComponentDiff{
+		{Path: ".terragrunt-stack/old", Kind: component.UnitKind, Change: generate.ChangeRemoved},
+	}}
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, stack.PrintJSONDiff(&buffer, diff))
+	assert.JSONEq(t, `{"components": [{"path": ".terragrunt-stack/old", "kind": "unit", "change": "removed"}]}`, buffer.String())
+}
@@ -4,9 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
+	"slices"
+	"strings"
+	"unicode"
 
 	"github.com/hashicorp/hcl/v2/hclwrite"
 
+	ctyyaml "github.com/zclconf/go-cty-yaml"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 
 	"github.com/zclconf/go-cty/cty"
@@ -193,3 +197,129 @@ func PrintJSONOutput(writer io.Writer, outputs cty.Value) error {
 
 	return nil
 }
+
+// PrintDotenvOutputs formats outputs as dotenv `KEY="value"` lines for tools configured through the environment.
+// Nested stacks, units and object outputs are flattened into upper-cased keys joined by underscores, such as
+// NETWORK_VPC_ID, while lists and sets are written as JSON.
+func PrintDotenvOutputs(writer io.Writer, outputs cty.Value) error {
+	if outputs == cty.NilVal {
+		return nil
+	}
+
+	lines, err := appendDotenvLines(nil, nil, outputs)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		if _, err := io.WriteString(writer, line+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appendDotenvLines appends the dotenv lines of the value at path to lines, in key order.
+func appendDotenvLines(lines []string, path []string, value cty.Value) ([]string, error) {
+	valueType := value.Type()
+
+	if !value.IsNull() && (valueType.IsObjectType() || valueType.IsMapType()) {
+		var err error
+
+		for it := value.ElementIterator(); it.Next(); {
+			key, element := it.Element()
+
+			lines, err = appendDotenvLines(lines, append(slices.Clone(path), key.AsString()), element)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return lines, nil
+	}
+
+	var str string
+
+	switch {
+	case value.IsNull():
+	case config.IsComplexType(value):
+		rawJSON, err := ctyjson.Marshal(value, valueType)
+		if err != nil {
+			return nil, err
+		}
+
+		str = string(rawJSON)
+	default:
+		var err error
+
+		str, err = config.FormatValue(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return append(lines, dotenvKey(path)+"="+dotenvQuote(str)), nil
+}
+
+// dotenvKey joins the path into an environment variable name, replacing characters not allowed in names.
+func dotenvKey(path []string) string {
+	key := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+
+		return '_'
+	}, strings.Join(path, "_"))
+
+	if key == "" || unicode.IsDigit(rune(key[0])) {
+		key = "_" + key
+	}
+
+	return key
+}
+
+// dotenvQuote double-quotes a dotenv value, escaping backslashes, quotes and newlines.
+func dotenvQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+// PrintYAMLOutputs formats outputs as YAML, preserving the nesting of stacks, units and outputs.
+func PrintYAMLOutputs(writer io.Writer, outputs cty.Value) error {
+	if outputs == cty.NilVal {
+		return nil
+	}
+
+	data, err := ctyyaml.Standard.Marshal(outputs)
+	if err != nil {
+		return err
+	}
+
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PrintTFVarsOutputs formats outputs as a tfvars file, with one variable per top-level unit or stack, in name order,
+// so the outputs can be passed to another OpenTofu/Terraform configuration with -var-file.
+func PrintTFVarsOutputs(writer io.Writer, outputs cty.Value) error {
+	if outputs == cty.NilVal {
+		return nil
+	}
+
+	f := hclwrite.NewEmptyFile()
+	rootBody := f.Body()
+
+	for it := outputs.ElementIterator(); it.Next(); {
+		key, val := it.Element()
+		rootBody.SetAttributeRaw(key.AsString(), hclwrite.TokensForValue(val))
+	}
+
+	if _, err := writer.Write(f.Bytes()); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package stack_test
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -549,3 +550,136 @@ func TestPrintRawOutputsSpecialCases(t *testing.T) {
 		})
 	}
 }
+
+func exportTestOutputs() cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"network": cty.ObjectVal(map[string]cty.Value{
+			"vpc": cty.ObjectVal(map[string]cty.Value{
+				"vpc_id":     cty.StringVal("vpc-123"),
+				"subnet_ids": cty.ListVal([]cty.Value{cty.StringVal("subnet-a"), cty.StringVal("subnet-b")}),
+			}),
+		}),
+		"app-1": cty.ObjectVal(map[string]cty.Value{
+			"port":    cty.NumberIntVal(8080),
+			"enabled": cty.True,
+			"motd":    cty.StringVal("say \"hi\"\nbye"),
+			"unset":   cty.NullVal(cty.String),
+		}),
+	})
+}
+
+func TestPrintDotenvOutputs(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, stack.PrintDotenvOutputs(&buffer, exportTestOutputs()))
+	assert.Equal(t, `APP_1_ENABLED="true"
+APP_1_MOTD="say \"hi\"\nbye"
+APP_1_PORT="8080"
+APP_1_UNSET=""
+NETWORK_VPC_SUBNET_IDS="[\"subnet-a\",\"subnet-b\"]"
+NETWORK_VPC_VPC_ID="vpc-123"
+`, buffer.String())
+}
+
+func TestPrintYAMLOutputs(t *testing.T) {
+	t.Parallel()
+
+	outputs := cty.ObjectVal(map[string]cty.Value{
+		"network": exportTestOutputs().GetAttr("network"),
+	})
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, stack.PrintYAMLOutputs(&buffer, outputs))
+	assert.Equal(t, `"network":
+  "vpc":
+    "subnet_ids":
+    - "subnet-a"
+    - "subnet-b"
+    "vpc_id": "vpc-123"
+`, buffer.String())
+}
+
+func TestPrintTFVarsOutputs(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, stack.PrintTFVarsOutputs(&buffer, exportTestOutputs()))
+
+	output := buffer.String()
+	assert.Less(t, strings.Index(output, "app-1 = {"), strings.Index(output, "network = {"))
+	assert.Contains(t, output, `vpc_id     = "vpc-123"`)
+	assert.Contains(t, output, `subnet_ids = ["subnet-a", "subnet-b"]`)
+}
+
+func TestFilterOutputs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		index    string
+		expected cty.Value
+		errorMsg string
+	}{
+		{
+			name:     "No Index",
+			index:    "",
+			expected: exportTestOutputs(),
+		},
+		{
+			name:  "Nested Stack Output",
+			index: "network.vpc.vpc_id",
+			expected: cty.ObjectVal(map[string]cty.Value{
+				"network": cty.ObjectVal(map[string]cty.Value{
+					"vpc": cty.ObjectVal(map[string]cty.Value{
+						"vpc_id": cty.StringVal("vpc-123"),
+					}),
+				}),
+			}),
+		},
+		{
+			name:  "List Index",
+			index: "network.vpc.subnet_ids.1",
+			expected: cty.ObjectVal(map[string]cty.Value{
+				"network": cty.ObjectVal(map[string]cty.Value{
+					"vpc": cty.ObjectVal(map[string]cty.Value{
+						"subnet_ids": cty.ObjectVal(map[string]cty.Value{
+							"1": cty.StringVal("subnet-b"),
+						}),
+					}),
+				}),
+			}),
+		},
+		{
+			name:     "Missing Key",
+			index:    "network.db.vpc_id",
+			errorMsg: `"network" has no element "db"`,
+		},
+		{
+			name:     "List Index Out Of Range",
+			index:    "network.vpc.subnet_ids.2",
+			errorMsg: `"network.vpc.subnet_ids" has no element "2"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filtered, err := stack.FilterOutputs(exportTestOutputs(), tt.index)
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tt.expected.RawEquals(filtered), "got %#v", filtered)
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	runcmd "github.com/gruntwork-io/terragrunt/internal/cli/commands/run"
 	"github.com/gruntwork-io/terragrunt/internal/configbridge"
 	"github.com/gruntwork-io/terragrunt/internal/telemetry"
 	"github.com/zclconf/go-cty/cty"
@@ -37,8 +39,9 @@ func RunGenerate(ctx context.Context, l log.Logger, opts *options.TerragruntOpti
 
 	opts.StackAction = "generate"
 
-	// Clean stack folders before calling `generate` when the `--source-update` flag is passed
-	if opts.SourceUpdate {
+	// Clean stack folders before calling `generate` when the `--source-update` flag is passed.
+	// A dry run leaves the generated stacks untouched, as they are what the rendered stacks are compared to.
+	if opts.SourceUpdate && !opts.StackGenerateDryRun {
 		err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "stack_clean", map[string]any{
 			"stack_config_path": opts.TerragruntStackConfigPath,
 			"working_dir":       opts.WorkingDir,
@@ -80,6 +83,10 @@ func RunGenerate(ctx context.Context, l log.Logger, opts *options.TerragruntOpti
 
 	gen := generate.NewGenerator()
 
+	if opts.StackGenerateDryRun {
+		return runGenerateDryRun(ctx, l, opts, gen, wts)
+	}
+
 	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "stack_generate", map[string]any{
 		"stack_config_path": opts.TerragruntStackConfigPath,
 		"working_dir":       opts.WorkingDir,
@@ -97,6 +104,35 @@ func RunGenerate(ctx context.Context, l log.Logger, opts *options.TerragruntOpti
 	return nil
 }
 
+// runGenerateDryRun renders the stacks without generating them and prints how they differ from the generated stacks
+// on disk.
+func runGenerateDryRun(
+	ctx context.Context,
+	l log.Logger,
+	opts *options.TerragruntOptions,
+	gen *generate.Generator,
+	wts *worktrees.Worktrees,
+) error {
+	var diff *generate.Diff
+
+	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "stack_generate_dry_run", map[string]any{
+		"stack_config_path": opts.TerragruntStackConfigPath,
+		"working_dir":       opts.WorkingDir,
+	}, func(ctx context.Context) (err error) {
+		diff, err = gen.DryRun(ctx, l, opts, wts)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.StackGenerateFormat == jsonOutputFormat {
+		return PrintJSONDiff(opts.Writers.Writer, diff)
+	}
+
+	return PrintDiff(opts.Writers.Writer, diff)
+}
+
 // Run executes the stack command.
 func Run(ctx context.Context, l log.Logger, v run.Venv, opts *options.TerragruntOptions) error {
 	opts.StackAction = "run"
@@ -111,6 +147,8 @@ func Run(ctx context.Context, l log.Logger, v run.Venv, opts *options.Terragrunt
 		return err
 	}
 
+	defer runcmd.AutoGCCAS(ctx, l, opts)
+
 	return runall.Run(ctx, l, v, opts)
 }
 
@@ -136,7 +174,10 @@ func RunOutput(ctx context.Context, l log.Logger, opts *options.TerragruntOption
 	}
 
 	// Filter outputs based on index key
-	filteredOutputs := FilterOutputs(outputs, index)
+	filteredOutputs, err := FilterOutputs(outputs, index)
+	if err != nil {
+		return err
+	}
 
 	// render outputs
 
@@ -155,35 +196,46 @@ func RunOutput(ctx context.Context, l log.Logger, opts *options.TerragruntOption
 		if err := PrintJSONOutput(opts.Writers.Writer, filteredOutputs); err != nil {
 			return err
 		}
+
+	case dotenvOutputFormat:
+		if err := PrintDotenvOutputs(opts.Writers.Writer, filteredOutputs); err != nil {
+			return err
+		}
+
+	case yamlOutputFormat:
+		if err := PrintYAMLOutputs(opts.Writers.Writer, filteredOutputs); err != nil {
+			return err
+		}
+
+	case tfvarsOutputFormat:
+		if err := PrintTFVarsOutputs(opts.Writers.Writer, filteredOutputs); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// FilterOutputs filters the outputs based on the provided index key.
-func FilterOutputs(outputs cty.Value, index string) cty.Value {
-	if !outputs.IsKnown() || outputs.IsNull() || len(index) == 0 {
-		return outputs
+// FilterOutputs filters the outputs based on the provided index key, a dot-separated path through nested stacks,
+// units, output attributes and list indexes, such as `network.vpc.subnet_ids.0`. The selected value is returned nested
+// under its path, so formats keyed by name keep the full path of the value.
+func FilterOutputs(outputs cty.Value, index string) (cty.Value, error) {
+	if outputs == cty.NilVal || !outputs.IsKnown() || outputs.IsNull() || len(index) == 0 {
+		return outputs, nil
 	}
 
 	// Split the index into parts
 	indexParts := strings.Split(index, ".")
-	// Traverse the map using the index parts
+	// Traverse the outputs using the index parts
 	currentValue := outputs
-	for _, part := range indexParts {
-		// Check if the current value is a map or object
-		if currentValue.Type().IsObjectType() || currentValue.Type().IsMapType() {
-			valueMap := currentValue.AsValueMap()
-			if nextValue, exists := valueMap[part]; exists {
-				currentValue = nextValue
-			} else {
-				// If any part of the index path is not found, return NilVal
-				return cty.NilVal
-			}
-		} else {
-			// If the current value is not a map or object, return NilVal
-			return cty.NilVal
+	for i, part := range indexParts {
+		nextValue, ok := outputElement(currentValue, part)
+		if !ok {
+			return cty.NilVal, fmt.Errorf("output %q not found in the stack outputs: %q has no element %q",
+				index, strings.Join(indexParts[:i], "."), part)
 		}
+
+		currentValue = nextValue
 	}
 
 	// Reconstruct the nested map structure
@@ -194,7 +246,31 @@ func FilterOutputs(outputs cty.Value, index string) cty.Value {
 		})
 	}
 
-	return nested
+	return nested, nil
+}
+
+// outputElement returns the attribute or map element of value named key, or the list or tuple element at index key.
+func outputElement(value cty.Value, key string) (cty.Value, bool) {
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NilVal, false
+	}
+
+	valueType := value.Type()
+
+	switch {
+	case valueType.IsObjectType() || valueType.IsMapType():
+		next, ok := value.AsValueMap()[key]
+		return next, ok
+	case valueType.IsListType() || valueType.IsTupleType():
+		idx, err := strconv.Atoi(key)
+		if err != nil || idx < 0 || idx >= value.LengthInt() {
+			return cty.NilVal, false
+		}
+
+		return value.Index(cty.NumberIntVal(int64(idx))), true
+	default:
+		return cty.NilVal, false
+	}
 }
 
 // RunClean recursively removes all stack directories under the specified WorkingDir.
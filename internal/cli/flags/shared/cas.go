@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"github.com/gruntwork-io/terragrunt/internal/cas"
 	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
 	"github.com/gruntwork-io/terragrunt/internal/clihelper"
 	"github.com/gruntwork-io/terragrunt/pkg/options"
@@ -11,11 +12,22 @@ const (
 	NoCASFlagName = "no-cas"
 	// CASCloneDepthFlagName is the name of the flag that controls the git clone depth CAS uses.
 	CASCloneDepthFlagName = "cas-clone-depth"
+	// CASAutoGCMaxAgeFlagName is the name of the flag that enables automatic CAS garbage collection by age.
+	CASAutoGCMaxAgeFlagName = "cas-auto-gc-max-age"
+	// CASAutoGCMaxSizeFlagName is the name of the flag that enables automatic CAS garbage collection by size.
+	CASAutoGCMaxSizeFlagName = "cas-auto-gc-max-size"
+	// CASRemoteFlagName is the name of the flag that sets the remote tier of the CAS store.
+	CASRemoteFlagName = "cas-remote"
+	// CASRemoteTokenFlagName is the name of the flag that sets the bearer token sent to HTTP CAS remotes.
+	CASRemoteTokenFlagName = "cas-remote-token"
+	// CASRemoteReadOnlyFlagName is the name of the flag that prevents pushing to the CAS remote.
+	CASRemoteReadOnlyFlagName = "cas-remote-read-only"
 )
 
 // NewCASFlags creates the flags controlling CAS (Content Addressable Storage)
-// behavior: --no-cas to disable CAS even when the experiment is enabled, and
-// --cas-clone-depth to control the git clone depth CAS uses.
+// behavior: --no-cas to disable CAS even when the experiment is enabled,
+// --cas-clone-depth to control the git clone depth CAS uses, and the
+// --cas-remote flags configuring the remote tier of the store.
 func NewCASFlags(opts *options.TerragruntOptions, prefix flags.Prefix) clihelper.Flags {
 	tgPrefix := prefix.Prepend(flags.TgPrefix)
 
@@ -32,5 +44,58 @@ func NewCASFlags(opts *options.TerragruntOptions, prefix flags.Prefix) clihelper
 			Destination: &opts.CASCloneDepth,
 			Usage:       "When using CAS, pass this value to git clone --depth (default 1; -1 clones full history). For negative values use --cas-clone-depth=-1 so the dash doesn't result in the value being parsed as a flag.",
 		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:    CASRemoteFlagName,
+			EnvVars: tgPrefix.EnvVars(CASRemoteFlagName),
+			Usage:   "When using CAS, pull content missing from the local CAS store from this remote and push fetched content to it: s3://<bucket>/<prefix> or an http(s):// content server URL.",
+			Setter: func(value string) error {
+				if _, err := cas.NewRemote(cas.RemoteOptions{URL: value}); err != nil {
+					return err
+				}
+
+				opts.CASRemote.URL = value
+
+				return nil
+			},
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:        CASRemoteTokenFlagName,
+			EnvVars:     tgPrefix.EnvVars(CASRemoteTokenFlagName),
+			Destination: &opts.CASRemote.Token,
+			Usage:       "Bearer token sent to an HTTP CAS remote.",
+		}),
+		flags.NewFlag(&clihelper.BoolFlag{
+			Name:        CASRemoteReadOnlyFlagName,
+			EnvVars:     tgPrefix.EnvVars(CASRemoteReadOnlyFlagName),
+			Destination: &opts.CASRemote.ReadOnly,
+			Usage:       "Pull from the CAS remote without pushing fetched content to it.",
+		}),
+	}
+}
+
+// NewCASAutoGCFlags creates the flags enabling the automatic garbage collection
+// of the CAS store after runs: --cas-auto-gc-max-age and --cas-auto-gc-max-size.
+func NewCASAutoGCFlags(opts *options.TerragruntOptions, prefix flags.Prefix) clihelper.Flags {
+	tgPrefix := prefix.Prepend(flags.TgPrefix)
+
+	return clihelper.Flags{
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:    CASAutoGCMaxAgeFlagName,
+			EnvVars: tgPrefix.EnvVars(CASAutoGCMaxAgeFlagName),
+			Usage:   "When using CAS, garbage collect the CAS store at most once a day after runs, removing content not used within this age, e.g. 720h or 30d.",
+			Setter: func(value string) (err error) {
+				opts.CASAutoGCMaxAge, err = cas.ParseGCMaxAge(value)
+				return err
+			},
+		}),
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:    CASAutoGCMaxSizeFlagName,
+			EnvVars: tgPrefix.EnvVars(CASAutoGCMaxSizeFlagName),
+			Usage:   "When using CAS, garbage collect the CAS store at most once a day after runs, evicting the least recently used content beyond this size, e.g. 20GiB.",
+			Setter: func(value string) (err error) {
+				opts.CASAutoGCMaxSize, err = cas.ParseGCMaxSize(value)
+				return err
+			},
+		}),
 	}
 }
@@ -29,13 +29,18 @@ func NewFilterFlags(l log.Logger, opts *options.TerragruntOptions) clihelper.Fla
 			&clihelper.SliceFlag[string]{
 				Name:    FilterFlagName,
 				EnvVars: tgPrefix.EnvVars(FilterFlagName),
-				Usage:   "Filter components using filter syntax. Can be specified multiple times for union (OR) semantics.",
+				Usage: "Filter components using filter syntax, or @name to use a filter block from the root configuration. " +
+					"Can be specified multiple times for union (OR) semantics.",
 				Action: func(_ context.Context, _ *clihelper.Context, val []string) error {
 					if len(val) == 0 {
 						return nil
 					}
 
-					parsed, err := filter.ParseFilterQueries(l, val)
+					// Named filters are expanded during setup, once the root configuration can be found.
+					aliases, queries := partitionFilterAliases(val)
+					opts.FilterAliases = append(opts.FilterAliases, aliases...)
+
+					parsed, err := filter.ParseFilterQueries(l, queries)
 					if err != nil {
 						return err
 					}
@@ -115,3 +120,18 @@ func NewFilterFlags(l log.Logger, opts *options.TerragruntOptions) clihelper.Fla
 		),
 	}
 }
+
+// partitionFilterAliases splits filter queries into those referencing named filters and the rest.
+func partitionFilterAliases(queries []string) (aliases, rest []string) {
+	for _, query := range queries {
+		if filter.IsAlias(query) {
+			aliases = append(aliases, query)
+
+			continue
+		}
+
+		rest = append(rest, query)
+	}
+
+	return aliases, rest
+}
@@ -0,0 +1,86 @@
+package shared
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/cli/flags"
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+const (
+	ReportFileFlagName   = "report-file"
+	ReportFormatFlagName = "report-format"
+)
+
+// reportFormatsByExt are the report formats inferred from the extension of the report file.
+var reportFormatsByExt = map[string]report.Format{
+	".csv":   report.FormatCSV,
+	".json":  report.FormatJSON,
+	".xml":   report.FormatJUnit,
+	".sarif": report.FormatSARIF,
+}
+
+// NewReportFlags creates the flags for generating a report file of the runs.
+func NewReportFlags(opts *options.TerragruntOptions) clihelper.Flags {
+	tgPrefix := flags.Prefix{flags.TgPrefix}
+
+	return clihelper.Flags{
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:    ReportFileFlagName,
+			EnvVars: tgPrefix.EnvVars(ReportFileFlagName),
+			Usage:   `Path to generate report file in.`,
+			Setter: func(value string) error {
+				if value == "" {
+					return nil
+				}
+
+				opts.ReportFile = value
+
+				ext := filepath.Ext(value)
+				if ext == "" {
+					ext = ".csv"
+				}
+
+				format, ok := reportFormatsByExt[ext]
+				if !ok {
+					return nil
+				}
+
+				if opts.ReportFormat == "" {
+					opts.ReportFormat = format
+				}
+
+				return nil
+			},
+		}),
+
+		flags.NewFlag(&clihelper.GenericFlag[string]{
+			Name:    ReportFormatFlagName,
+			EnvVars: tgPrefix.EnvVars(ReportFormatFlagName),
+			Usage:   `Format of the report file. Supported formats: csv, json, junit, sarif.`,
+			Setter: func(value string) error {
+				if value == "" && opts.ReportFormat == "" {
+					opts.ReportFormat = report.FormatCSV
+
+					return nil
+				}
+
+				opts.ReportFormat = report.Format(value)
+
+				switch opts.ReportFormat {
+				case report.FormatCSV:
+				case report.FormatJSON:
+				case report.FormatJUnit:
+				case report.FormatSARIF:
+				default:
+					return fmt.Errorf("unsupported report format: %s", value)
+				}
+
+				return nil
+			},
+		}),
+	}
+}
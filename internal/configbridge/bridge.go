@@ -5,6 +5,7 @@ package configbridge
 
 import (
 	"context"
+	"path/filepath"
 
 	inthclparse "github.com/gruntwork-io/terragrunt/internal/hclparse"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate"
@@ -83,6 +84,7 @@ func populateFromOpts(pctx *config.ParsingContext, opts *options.TerragruntOptio
 	pctx.NoStackValidate = opts.NoStackValidate
 	pctx.NoCAS = opts.NoCAS
 	pctx.CASCloneDepth = opts.CASCloneDepth
+	pctx.CASRemote = opts.CASRemote
 	pctx.ScaffoldRootFileName = opts.ScaffoldRootFileName
 	pctx.TerragruntStackConfigPath = opts.TerragruntStackConfigPath
 	pctx.ProviderCacheOptions = opts.ProviderCacheOptions
@@ -112,12 +114,24 @@ func BackendOptsFromOpts(opts *options.TerragruntOptions) *backend.Options {
 	return &backend.Options{
 		Writers:                      opts.Writers,
 		Env:                          opts.Env,
+		WorkingDir:                   opts.WorkingDir,
+		UnitDir:                      unitDir(opts),
 		IAMRoleOptions:               opts.IAMRoleOptions,
 		NonInteractive:               opts.NonInteractive,
 		FailIfBucketCreationRequired: opts.FailIfBucketCreationRequired,
 	}
 }
 
+// unitDir returns the directory of the unit configuration. Unlike opts.WorkingDir, it does not move to the
+// .terragrunt-cache directory when the unit has a terraform source.
+func unitDir(opts *options.TerragruntOptions) string {
+	if opts.TerragruntConfigPath != "" {
+		return filepath.Dir(opts.TerragruntConfigPath)
+	}
+
+	return opts.WorkingDir
+}
+
 // RemoteStateOptsFromOpts constructs remotestate.Options from TerragruntOptions.
 func RemoteStateOptsFromOpts(opts *options.TerragruntOptions) *remotestate.Options {
 	return &remotestate.Options{
@@ -183,6 +197,7 @@ func NewRunOptions(opts *options.TerragruntOptions) *run.Options {
 	runOpts.DisableBucketUpdate = opts.DisableBucketUpdate
 	runOpts.SourceUpdate = opts.SourceUpdate
 	runOpts.CASCloneDepth = opts.CASCloneDepth
+	runOpts.CASRemote = opts.CASRemote
 	runOpts.NoCAS = opts.NoCAS
 	runOpts.NoHooks = opts.NoRunHooks
 
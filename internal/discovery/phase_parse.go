@@ -370,6 +370,7 @@ func parseComponent(
 			config.FeatureFlagsBlock,
 			config.ExcludeBlock,
 			config.ErrorsBlock,
+			config.ConcurrencyBlock,
 			config.RemoteStateBlock,
 			config.TerragruntVersionConstraints,
 		).WithSkipOutputsResolution()
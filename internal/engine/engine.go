@@ -271,6 +271,12 @@ func WithEngineValues(ctx context.Context) context.Context {
 	return ctx
 }
 
+// Download fetches the engine configured in execOptions into its cache directory
+// without starting it. The context must carry the values set by WithEngineValues.
+func Download(ctx context.Context, l log.Logger, execOptions *ExecutionOptions) error {
+	return downloadEngine(ctx, l, execOptions)
+}
+
 // downloadEngine downloads the engine for the given options.
 func downloadEngine(ctx context.Context, l log.Logger, execOptions *ExecutionOptions) error {
 	e := execOptions.EngineConfig
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"maps"
 	"regexp"
+	"slices"
 	"strings"
 
 	"errors"
@@ -18,8 +19,9 @@ var ErrorCleanPattern = regexp.MustCompile(`[^a-zA-Z0-9./'"():=\- ]+`)
 
 // Config is the extracted errors handling configuration.
 type Config struct {
-	Retry  map[string]*RetryConfig
-	Ignore map[string]*IgnoreConfig
+	Retry   map[string]*RetryConfig
+	Ignore  map[string]*IgnoreConfig
+	Explain map[string]*ExplainConfig
 }
 
 // RetryConfig represents the configuration for retrying specific errors.
@@ -38,6 +40,14 @@ type IgnoreConfig struct {
 	IgnorableErrors []*Pattern
 }
 
+// ExplainConfig represents the configuration for explaining specific errors to the user.
+type ExplainConfig struct {
+	Name              string
+	Message           string
+	Command           string
+	ExplainableErrors []*Pattern
+}
+
 // Pattern represents a regex pattern for matching errors, with optional negation.
 type Pattern struct {
 	Pattern  *regexp.Regexp `clone:"shadowcopy"`
@@ -66,6 +76,50 @@ func (e *MaxAttemptsReachedError) Error() string {
 	return fmt.Sprintf("max retry attempts (%d) reached for error: %v", e.MaxRetries, e.Err)
 }
 
+// ExplainedError wraps an error with the explanations of the explain rules it matched.
+type ExplainedError struct {
+	Err          error
+	Explanations []*ExplainConfig
+}
+
+func (e *ExplainedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExplainedError) Unwrap() error {
+	return e.Err
+}
+
+// HasRecoveryRules returns true if the configuration has retry or ignore rules.
+func (c *Config) HasRecoveryRules() bool {
+	return len(c.Retry) > 0 || len(c.Ignore) > 0
+}
+
+// ExplainError wraps the error with the explain rules it matches, so that the explanations can be shown to the user
+// once the error reaches the top level. The error is returned as is if it matches no rule.
+func (c *Config) ExplainError(err error) error {
+	if err == nil || len(c.Explain) == 0 {
+		return err
+	}
+
+	errStr := ExtractErrorMessage(err)
+
+	var explanations []*ExplainConfig
+
+	for _, name := range slices.Sorted(maps.Keys(c.Explain)) {
+		explainBlock := c.Explain[name]
+		if MatchesAnyRegexpPattern(errStr, explainBlock.ExplainableErrors) {
+			explanations = append(explanations, explainBlock)
+		}
+	}
+
+	if len(explanations) == 0 {
+		return err
+	}
+
+	return &ExplainedError{Err: err, Explanations: explanations}
+}
+
 // AttemptErrorRecovery attempts to recover from an error by checking the ignore and retry rules.
 func (c *Config) AttemptErrorRecovery(l log.Logger, err error, currentAttempt int) (*Action, error) {
 	if err == nil {
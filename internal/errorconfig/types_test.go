@@ -201,3 +201,37 @@ func TestMatchesAnyRegexpPattern_NegativePattern(t *testing.T) {
 	matched := errorconfig.MatchesAnyRegexpPattern("timeout occurred", patterns)
 	assert.False(t, matched, "negative pattern should invert the match")
 }
+
+func TestExplainError(t *testing.T) {
+	t.Parallel()
+
+	lockExplanation := &errorconfig.ExplainConfig{
+		Name:              "state-lock",
+		Message:           "The state is locked",
+		Command:           "terragrunt run -- force-unlock <LOCK_ID>",
+		ExplainableErrors: []*errorconfig.Pattern{{Pattern: regexp.MustCompile(`(?s).*Error acquiring the state lock.*`)}},
+	}
+
+	cfg := &errorconfig.Config{
+		Explain: map[string]*errorconfig.ExplainConfig{lockExplanation.Name: lockExplanation},
+	}
+
+	var stderr bytes.Buffer
+	stderr.WriteString("Error: Error acquiring the state lock")
+
+	processErr := util.ProcessExecutionError{Err: errors.New("exit status 1"), Output: util.CmdOutput{Stderr: stderr}}
+
+	var explainedErr *errorconfig.ExplainedError
+
+	err := cfg.ExplainError(processErr)
+	require.ErrorAs(t, err, &explainedErr)
+	assert.Equal(t, []*errorconfig.ExplainConfig{lockExplanation}, explainedErr.Explanations)
+
+	var wrappedErr util.ProcessExecutionError
+
+	require.ErrorAs(t, err, &wrappedErr)
+	assert.Equal(t, processErr.Output.Stderr.String(), wrappedErr.Output.Stderr.String())
+
+	otherErr := errors.New("exit status 1")
+	assert.Equal(t, otherErr, cfg.ExplainError(otherErr))
+}
@@ -4,9 +4,11 @@ import (
 	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/gruntwork-io/terragrunt/internal/component"
 	"github.com/gruntwork-io/terragrunt/internal/glob"
+	"github.com/hashicorp/go-version"
 )
 
 // Expression is the interface that all AST nodes must implement.
@@ -61,19 +63,37 @@ func (p *PathExpression) RequiresParse() (Expression, bool)     { return p, fals
 func (p *PathExpression) IsRestrictedToStacks() bool            { return false }
 func (p *PathExpression) Negated() Expression                   { return NewPrefixExpression("!", p) }
 
-// AttributeExpression represents a key-value attribute filter (e.g., "name=my-app").
+// AttributeExpression represents a key-value attribute filter (e.g., "name=my-app"),
+// or a comparison for attributes with ordered values (e.g., "dependency-count>2").
 type AttributeExpression struct {
 	compiledGlob glob.Matcher
 	Key          string
 	Value        string
+	// Operator is one of "=", "<", ">", "<=" or ">=".
+	Operator string
 }
 
 // NewAttributeExpression creates a new AttributeExpression with eager glob compilation
-// for attributes that support glob matching (name, reading, source).
+// for attributes that support glob matching (name, reading, source, backend, feature, source-version).
 func NewAttributeExpression(key string, value string) (*AttributeExpression, error) {
-	expr := &AttributeExpression{Key: key, Value: value}
+	return NewAttributeComparison(key, OperatorEqual, value)
+}
+
+// NewAttributeComparison creates a new AttributeExpression with the given operator.
+// Comparison operators are only supported by attributes with ordered values (dependency-count, source-version),
+// whose values are validated eagerly.
+func NewAttributeComparison(key, operator, value string) (*AttributeExpression, error) {
+	expr := &AttributeExpression{Key: key, Operator: operator, Value: value}
 
-	if expr.supportsGlob() {
+	if operator != OperatorEqual && !expr.supportsComparison() {
+		return nil, InvalidAttributeValueError{Key: key, Value: value, Reason: "the " + operator + " operator is not supported, only ="}
+	}
+
+	if err := expr.validateValue(); err != nil {
+		return nil, err
+	}
+
+	if operator == OperatorEqual && expr.supportsGlob() {
 		pattern := value
 
 		if key == AttributeReading {
@@ -95,7 +115,7 @@ func NewAttributeExpression(key string, value string) (*AttributeExpression, err
 // NewTypeExpression creates a new AttributeExpression for the "type" attribute.
 // Type filters do not support glob matching, so this constructor cannot fail.
 func NewTypeExpression(kind component.Kind) *AttributeExpression {
-	return &AttributeExpression{Key: AttributeType, Value: string(kind)}
+	return &AttributeExpression{Key: AttributeType, Operator: OperatorEqual, Value: string(kind)}
 }
 
 // Glob returns the pre-compiled glob pattern.
@@ -103,21 +123,69 @@ func (a *AttributeExpression) Glob() glob.Matcher {
 	return a.compiledGlob
 }
 
+// FeatureName returns the feature flag name of a "feature.<name>" attribute, and whether the attribute is one.
+func (a *AttributeExpression) FeatureName() (string, bool) {
+	return strings.CutPrefix(a.Key, AttributeFeature+".")
+}
+
 // supportsGlob returns true if the attribute filter supports glob patterns.
 func (a *AttributeExpression) supportsGlob() bool {
-	return a.Key == AttributeReading || a.Key == AttributeName || a.Key == AttributeSource
+	if _, ok := a.FeatureName(); ok {
+		return true
+	}
+
+	switch a.Key {
+	case AttributeReading, AttributeName, AttributeSource, AttributeBackend, AttributeFeature, AttributeSourceVersion:
+		return true
+	default:
+		return false
+	}
+}
+
+// supportsComparison returns true if the attribute filter supports the <, >, <= and >= operators.
+func (a *AttributeExpression) supportsComparison() bool {
+	return a.Key == AttributeDependencyCount || a.Key == AttributeSourceVersion
+}
+
+// validateValue checks values that can be validated without evaluating the expression.
+func (a *AttributeExpression) validateValue() error {
+	switch a.Key {
+	case AttributeDependencyCount:
+		if count, err := strconv.Atoi(a.Value); err != nil || count < 0 {
+			return InvalidAttributeValueError{Key: a.Key, Value: a.Value, Reason: "expected a non-negative integer"}
+		}
+	case AttributePreventDestroy:
+		if a.Value != AttributeValueTrue && a.Value != AttributeValueFalse {
+			return InvalidAttributeValueError{Key: a.Key, Value: a.Value, Reason: "expected 'true' or 'false'"}
+		}
+	case AttributeSourceVersion:
+		if a.Operator == OperatorEqual {
+			return nil
+		}
+
+		if _, err := version.NewVersion(a.Value); err != nil {
+			return InvalidAttributeValueError{Key: a.Key, Value: a.Value, Reason: "expected a version, e.g. v1.2.0"}
+		}
+	}
+
+	return nil
 }
 
 func (a *AttributeExpression) expressionNode()                       {}
-func (a *AttributeExpression) String() string                        { return a.Key + "=" + a.Value }
+func (a *AttributeExpression) String() string                        { return a.Key + a.operator() + a.Value }
 func (a *AttributeExpression) RequiresDiscovery() (Expression, bool) { return a, true }
 func (a *AttributeExpression) RequiresParse() (Expression, bool) {
+	if _, ok := a.FeatureName(); ok {
+		return a, true
+	}
+
 	switch a.Key {
 	// All of these attributes can be determined based on the component + configuration filepath.
 	case AttributeName, AttributeType, AttributeExternal:
 		return nil, false
-	// We only know what a component reads if we parse it.
-	case AttributeReading:
+	// We only know what a component reads, or what is in its configuration, if we parse it.
+	case AttributeReading, AttributeBackend, AttributePreventDestroy, AttributeDependencyCount,
+		AttributeSourceVersion, AttributeFeature:
 		return a, true
 	// We default to true to be conservative in-case we forget to register
 	// a new attribute here that does require parsing.
@@ -132,6 +200,15 @@ func (a *AttributeExpression) Negated() Expression {
 	return NewPrefixExpression("!", a)
 }
 
+// operator returns the operator of the expression, expressions built without a constructor default to "=".
+func (a *AttributeExpression) operator() string {
+	if a.Operator == "" {
+		return OperatorEqual
+	}
+
+	return a.Operator
+}
+
 // PrefixExpression represents a prefix operator expression (e.g., "!name=foo").
 type PrefixExpression struct {
 	Right    Expression
@@ -144,7 +221,7 @@ func NewPrefixExpression(operator string, right Expression) *PrefixExpression {
 }
 
 func (p *PrefixExpression) expressionNode() {}
-func (p *PrefixExpression) String() string  { return p.Operator + p.Right.String() }
+func (p *PrefixExpression) String() string  { return p.Operator + groupedString(p.Right) }
 func (p *PrefixExpression) RequiresDiscovery() (Expression, bool) {
 	return p.Right.RequiresDiscovery()
 }
@@ -178,7 +255,8 @@ func (p *PrefixExpression) Negated() Expression {
 	}
 }
 
-// InfixExpression represents an infix operator expression (e.g., "./apps/* | name=bar").
+// InfixExpression represents an infix operator expression, either an intersection (e.g., "./apps/* | name=bar")
+// or a union (e.g., "./apps/* || ./libs/*").
 type InfixExpression struct {
 	Left     Expression
 	Right    Expression
@@ -192,7 +270,13 @@ func NewInfixExpression(left Expression, operator string, right Expression) *Inf
 
 func (i *InfixExpression) expressionNode() {}
 func (i *InfixExpression) String() string {
-	return i.Left.String() + " " + i.Operator + " " + i.Right.String()
+	left := i.Left.String()
+	if infix, ok := i.Left.(*InfixExpression); ok && infix.Operator != i.Operator {
+		left = groupedString(infix)
+	}
+
+	// Infix operators are left-associative, so an infix right operand always needs grouping.
+	return left + " " + i.Operator + " " + groupedString(i.Right)
 }
 func (i *InfixExpression) RequiresDiscovery() (Expression, bool) {
 	if _, ok := i.Left.RequiresDiscovery(); ok {
@@ -220,6 +304,8 @@ func (i *InfixExpression) IsRestrictedToStacks() bool {
 	switch i.Operator {
 	case "|":
 		return i.Left.IsRestrictedToStacks() || i.Right.IsRestrictedToStacks()
+	case "||":
+		return i.Left.IsRestrictedToStacks() && i.Right.IsRestrictedToStacks()
 	default:
 		return false
 	}
@@ -228,11 +314,24 @@ func (i *InfixExpression) Negated() Expression {
 	switch i.Operator {
 	case "|":
 		return NewInfixExpression(i.Left.Negated(), i.Operator, i.Right)
+	case "||":
+		// The union of two negated expressions excludes what both of them negate.
+		return NewInfixExpression(i.Left.Negated(), "|", i.Right.Negated())
 	default:
 		return NewInfixExpression(i.Left.Negated(), i.Operator, i.Right)
 	}
 }
 
+// groupedString returns the string representation of an expression,
+// parenthesized if it is an infix expression used as an operand.
+func groupedString(expr Expression) string {
+	if _, ok := expr.(*InfixExpression); ok {
+		return "(" + expr.String() + ")"
+	}
+
+	return expr.String()
+}
+
 // GraphExpression represents a graph traversal expression (e.g., "...foo", "foo...", "..1foo", "foo..2").
 // Depth fields control how many levels of dependencies/dependents to traverse.
 type GraphExpression struct {
@@ -287,7 +386,7 @@ func (g *GraphExpression) String() string {
 		result += "^"
 	}
 
-	result += g.Target.String()
+	result += groupedString(g.Target)
 
 	if g.IncludeDependencies {
 		result += "..."
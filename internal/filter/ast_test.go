@@ -117,3 +117,12 @@ func mustAttr(t *testing.T, key, value string) *filter.AttributeExpression {
 
 	return expr
 }
+
+func mustComparison(t *testing.T, key, operator, value string) *filter.AttributeExpression {
+	t.Helper()
+
+	expr, err := filter.NewAttributeComparison(key, operator, value)
+	require.NoError(t, err)
+
+	return expr
+}
@@ -36,6 +36,11 @@ func IsNegated(expr Expression) bool {
 	case *PrefixExpression:
 		return node.Operator == "!"
 	case *InfixExpression:
+		// A union is only negated if both of its operands are, as it otherwise includes components.
+		if node.Operator == "||" {
+			return IsNegated(node.Left) && IsNegated(node.Right)
+		}
+
 		return IsNegated(node.Left)
 	default:
 		return false
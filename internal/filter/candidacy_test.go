@@ -52,6 +52,32 @@ func TestIsNegated(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "union with one negated operand",
+			exprFn: func(t *testing.T) filter.Expression {
+				t.Helper()
+
+				return filter.NewInfixExpression(
+					filter.NewPrefixExpression("!", mustPath(t, "./foo")),
+					"||",
+					mustPath(t, "./bar"),
+				)
+			},
+			expected: false,
+		},
+		{
+			name: "union with negated operands",
+			exprFn: func(t *testing.T) filter.Expression {
+				t.Helper()
+
+				return filter.NewInfixExpression(
+					filter.NewPrefixExpression("!", mustPath(t, "./foo")),
+					"||",
+					filter.NewPrefixExpression("!", mustPath(t, "./bar")),
+				)
+			},
+			expected: true,
+		},
 		{
 			name: "infix with non-negated left",
 			exprFn: func(t *testing.T) filter.Expression {
@@ -118,6 +118,9 @@ type Classifier struct {
 	// hasPositiveFilters tracks whether any non-negated filter exists, used to
 	// determine exclude-by-default behavior.
 	hasPositiveFilters bool
+	// hasNegatedUnionOperand tracks whether a negation is an operand of a union
+	// (e.g. "!legacy || ./apps/*"), which matches every component it doesn't negate.
+	hasNegatedUnionOperand bool
 }
 
 // NewClassifier creates a new Classifier that categorizes all filter expressions
@@ -136,7 +139,7 @@ func NewClassifier(filters Filters) *Classifier {
 			c.hasPositiveFilters = true
 		}
 
-		c.analyzeExpression(expr, i)
+		c.analyzeExpression(expr, i, false)
 	}
 
 	return c
@@ -155,7 +158,8 @@ func NewClassifier(filters Filters) *Classifier {
 //  4. Check if component matches any positive filesystem filter -> DISCOVERED
 //  5. Check if component matches any git expression -> DISCOVERED
 //  6. Check if dependent filters exist and parse data unavailable -> CANDIDATE (PotentialDependent)
-//  7. If positive filters exist but no match -> EXCLUDED (exclude-by-default)
+//  7. If positive filters exist but no match -> EXCLUDED (exclude-by-default),
+//     unless a negation is an operand of a union, as it can match any component
 //  8. If no positive filters exist -> DISCOVERED (include-by-default)
 func (c *Classifier) Classify(
 	comp component.Component,
@@ -195,7 +199,7 @@ func (c *Classifier) Classify(
 		return StatusCandidate, CandidacyReasonPotentialDependent, -1
 	}
 
-	if c.hasPositiveFilters {
+	if c.hasPositiveFilters && !c.hasNegatedUnionOperand {
 		return StatusExcluded, CandidacyReasonNone, -1
 	}
 
@@ -203,7 +207,9 @@ func (c *Classifier) Classify(
 }
 
 // analyzeExpression recursively analyzes an expression and categorizes it.
-func (c *Classifier) analyzeExpression(expr Expression, filterIndex int) {
+// inUnion is true when the expression is nested in an operand of a union, where a match
+// doesn't have to match the whole filter.
+func (c *Classifier) analyzeExpression(expr Expression, filterIndex int, inUnion bool) {
 	switch node := expr.(type) {
 	case *PathExpression:
 		c.pathExprs = append(c.pathExprs, node)
@@ -235,11 +241,18 @@ func (c *Classifier) analyzeExpression(expr Expression, filterIndex int) {
 		// Right now, the only prefix operator is "!".
 		// If we encounter an unknown operator, just analyze the inner expression.
 		if node.Operator != "!" {
-			c.analyzeExpression(node.Right, filterIndex)
+			c.analyzeExpression(node.Right, filterIndex, inUnion)
 			break
 		}
 
-		c.negatedExprs = append(c.negatedExprs, node.Right)
+		// A negation in a union, or one wrapping another negation, doesn't exclude every component
+		// matching its inner expression, so it can't be used to exclude components early.
+		if inUnion {
+			c.hasNegatedUnionOperand = true
+		} else if !containsNegation(node.Right) {
+			c.negatedExprs = append(c.negatedExprs, node.Right)
+		}
+
 		if _, requiresParse := node.Right.RequiresParse(); requiresParse {
 			c.parseExprs = append(c.parseExprs, node.Right)
 		}
@@ -247,11 +260,29 @@ func (c *Classifier) analyzeExpression(expr Expression, filterIndex int) {
 		c.extractNegatedGraphExpressions(node.Right, filterIndex)
 
 	case *InfixExpression:
-		c.analyzeExpression(node.Left, filterIndex)
-		c.analyzeExpression(node.Right, filterIndex)
+		inUnion = inUnion || node.Operator == "||"
+
+		c.analyzeExpression(node.Left, filterIndex, inUnion)
+		c.analyzeExpression(node.Right, filterIndex, inUnion)
 	}
 }
 
+// containsNegation returns true if the expression contains a negation.
+func containsNegation(expr Expression) bool {
+	found := false
+
+	WalkExpressions(expr, func(e Expression) bool {
+		if prefix, ok := e.(*PrefixExpression); ok && prefix.Operator == "!" {
+			found = true
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
 // extractNegatedGraphExpressions walks through a negated expression and extracts
 // any graph expressions found within it. This ensures that filters like "!...db"
 // or "!db..." trigger the graph discovery phase.
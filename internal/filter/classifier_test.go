@@ -280,6 +280,46 @@ func TestClassifier_Classify(t *testing.T) {
 			expectedReason:     filter.CandidacyReasonPotentialDependent,
 			expectedIdx:        -1,
 		},
+		{
+			name:           "matches_negation_in_union_returns_ready_for_filter",
+			filterStrs:     []string{"!./apps/app1 || ./apps/*"},
+			componentPath:  "./apps/app1",
+			expectedStatus: filter.StatusReadyForFilter,
+			expectedReason: filter.CandidacyReasonNone,
+			expectedIdx:    -1,
+		},
+		{
+			name:           "matches_no_union_operand_with_negation_returns_ready_for_filter",
+			filterStrs:     []string{"!./libs/* || ./apps/*"},
+			componentPath:  "./other/app1",
+			expectedStatus: filter.StatusReadyForFilter,
+			expectedReason: filter.CandidacyReasonNone,
+			expectedIdx:    -1,
+		},
+		{
+			name:           "matches_no_union_operand_returns_excluded",
+			filterStrs:     []string{"(./libs/* || ./apps/*) | !legacy"},
+			componentPath:  "./other/app1",
+			expectedStatus: filter.StatusExcluded,
+			expectedReason: filter.CandidacyReasonNone,
+			expectedIdx:    -1,
+		},
+		{
+			name:           "matches_negated_group_returns_excluded",
+			filterStrs:     []string{"!(./libs/* || ./apps/*)"},
+			componentPath:  "./apps/app1",
+			expectedStatus: filter.StatusExcluded,
+			expectedReason: filter.CandidacyReasonNone,
+			expectedIdx:    -1,
+		},
+		{
+			name:           "matches_nested_negation_returns_ready_for_filter",
+			filterStrs:     []string{"!(./apps/* | !app1)"},
+			componentPath:  "./apps/app1",
+			expectedStatus: filter.StatusReadyForFilter,
+			expectedReason: filter.CandidacyReasonNone,
+			expectedIdx:    -1,
+		},
 		{
 			name:           "negation_exists_component_not_matching_returns_ready_for_filter",
 			filterStrs:     []string{"!./libs/db"},
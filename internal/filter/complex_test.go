@@ -79,9 +79,9 @@ func TestParser_ComplexDepthExpressions(t *testing.T) {
 			expected: "1...^name=foo | name=bar...",
 		},
 		{
-			name:     "parentheses treated as part of identifier",
+			name:     "depth with grouped intersection",
 			input:    "1...(foo | bar)",
-			expected: "1...name=(foo | name=bar)",
+			expected: "1...(name=foo | name=bar)",
 		},
 	}
 
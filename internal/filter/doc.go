@@ -34,6 +34,17 @@
 //	external=false          # Match internal dependencies (not external)
 //	foo                     # Shorthand for name=foo
 //
+// Some attributes are read from the parsed configuration of units, and never match stacks:
+//
+//	backend=gcs                  # Match units with a "gcs" remote_state backend
+//	prevent_destroy=true         # Match units with prevent_destroy = true
+//	dependency-count>2           # Match units with more than two dependencies
+//	source-version<v2.0.0        # Match units whose terraform.source ref is a version below v2.0.0
+//	feature=enable_*             # Match units with a feature flag named enable_*
+//	feature.enable_logs=true     # Match units whose enable_logs feature flag defaults to true
+//
+// The comparison operators (<, >, <=, >=) are only supported by dependency-count and source-version.
+//
 // ## Negation Operator (!)
 //
 // The negation operator excludes matching components:
@@ -47,7 +58,7 @@
 //
 // The intersection operator refines/narrows results by applying filters from left to right.
 // Each filter in the chain further restricts the results from the previous filter.
-// The pipe character (|) is the only delimiter between filter expressions.
+// The pipe character (|) and the union operator (||) are the only delimiters between filter expressions.
 // Whitespace is optional around operators but is NOT a delimiter itself.
 //
 //	./apps/* | name=web         # Components in ./apps/* AND named "web"
@@ -55,6 +66,24 @@
 //	./foo* | !./foobar*         # Components in ./foo* AND NOT in ./foobar*
 //	type=unit | !external=true  # Internal components only
 //
+// ## Union Operator (||)
+//
+// The union operator combines the results of filter expressions, like passing multiple filters.
+// It binds more loosely than intersection, so "a || b | c" means "a || (b | c)":
+//
+//	./apps/* || ./libs/*           # Components in ./apps/* OR in ./libs/*
+//	./apps/* || ./libs/* | !legacy # Components in ./apps/*, OR in ./libs/* AND NOT named "legacy"
+//
+// ## Grouping (())
+//
+// Parentheses group expressions to override precedence. Groups can be negated,
+// used as graph traversal targets, and contain graph and Git expressions:
+//
+//	(./apps/* || ./libs/*) | !legacy  # Components in ./apps/* or ./libs/*, AND NOT named "legacy"
+//	!(./apps/* || ./libs/*)           # Components in neither ./apps/* nor ./libs/*
+//	...(vpc || dns)                   # vpc, dns and all their dependents
+//	([main...HEAD] || db...) | !./legacy/**
+//
 // Spaces within component names and paths are preserved:
 //
 //	my app                  # Component named "my app" (with space)
@@ -116,6 +145,13 @@
 //	1...1foo                # dependent depth 1, target "1foo"
 //	foo1...1                # target "foo1", dependency depth 1
 //
+// ## Named Filters (@)
+//
+// Queries starting with @ reference a named filter declared with a `filter` block in the root configuration,
+// and are expanded to the query of that block before parsing (see AliasName):
+//
+//	@prod-network           # The query of filter "prod-network"
+//
 // # Operator Precedence
 //
 // Operators are evaluated with the following precedence (highest to lowest):
@@ -193,9 +229,12 @@
 //   - PATH: Paths (./apps/*, /absolute, etc.)
 //   - BANG: Negation operator (!)
 //   - PIPE: Intersection operator (|)
+//   - OR: Union operator (||)
 //   - EQUAL: Assignment operator (=)
 //   - LBRACE: Left brace ({)
 //   - RBRACE: Right brace (})
+//   - LPAREN: Left parenthesis (()
+//   - RPAREN: Right parenthesis ())
 //   - EOF: End of input
 //
 // ## Parser
@@ -205,7 +244,9 @@
 //   - PathFilter: Path/glob filter
 //   - AttributeFilter: Key-value attribute filter
 //   - PrefixExpression: Negation operator
-//   - InfixExpression: Union operator
+//   - InfixExpression: Intersection and union operators
+//
+// Groups don't have a node of their own, they only shape the tree.
 //
 // ## Evaluator
 //
@@ -217,7 +258,8 @@
 //   - type: Matches component.Kind (unit or stack)
 //   - external: Matches component.External (true or false)
 //   - PrefixExpression: Returns the complement of the right side
-//   - InfixExpression: Returns the intersection by applying right filter to left results
+//   - InfixExpression: Returns the intersection by applying right filter to left results (|),
+//     or the union of both sides deduplicated by path (||)
 //
 // Path filters compile their glob pattern once on first evaluation and cache
 // the compiled result for reuse in subsequent evaluations, providing significant
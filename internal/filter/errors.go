@@ -19,6 +19,8 @@ const (
 	ErrorCodeEmptyGitFilter
 	ErrorCodeMissingGitRef
 	ErrorCodeInvalidGlob
+	ErrorCodeInvalidAttributeValue
+	ErrorCodeMissingClosingParen
 )
 
 // ParseError represents an error that occurred during parsing.
@@ -101,6 +103,18 @@ func NewEvaluationErrorWithCause(message string, cause error) error {
 	return EvaluationError{Message: message, Cause: cause}
 }
 
+// InvalidAttributeValueError is returned when an attribute expression has a value, or an operator,
+// the attribute doesn't support.
+type InvalidAttributeValueError struct {
+	Key    string
+	Value  string
+	Reason string
+}
+
+func (e InvalidAttributeValueError) Error() string {
+	return fmt.Sprintf("invalid %s value '%s': %s", e.Key, e.Value, e.Reason)
+}
+
 // FilterQueryRequiresDiscoveryError is an error that is returned when a filter query
 // requires discovery of Terragrunt configurations.
 type FilterQueryRequiresDiscoveryError struct {
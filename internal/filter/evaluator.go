@@ -16,12 +16,28 @@ const (
 	AttributeReading  = "reading"
 	AttributeSource   = "source"
 
+	// Attributes evaluated against the parsed Terragrunt configuration of a unit.
+	AttributeBackend         = "backend"
+	AttributePreventDestroy  = "prevent_destroy"
+	AttributeDependencyCount = "dependency-count"
+	AttributeSourceVersion   = "source-version"
+	AttributeFeature         = "feature"
+
 	AttributeTypeValueUnit  = string(component.UnitKind)
 	AttributeTypeValueStack = string(component.StackKind)
 
 	AttributeExternalValueTrue  = "true"
 	AttributeExternalValueFalse = "false"
 
+	AttributeValueTrue  = "true"
+	AttributeValueFalse = "false"
+
+	OperatorEqual        = "="
+	OperatorLess         = "<"
+	OperatorGreater      = ">"
+	OperatorLessEqual    = "<="
+	OperatorGreaterEqual = ">="
+
 	// MaxTraversalDepth is the maximum depth to traverse the graph for both dependencies and dependents.
 	MaxTraversalDepth = 1000000
 )
@@ -216,9 +232,33 @@ func evaluateAttributeFilter(
 				continue
 			}
 
+			traceFilterMiss(l, filter, c)
+		}
+	case AttributeBackend, AttributePreventDestroy, AttributeDependencyCount, AttributeSourceVersion, AttributeFeature:
+		for _, c := range components {
+			if matchConfigAttribute(c, filter) {
+				result = append(result, c)
+
+				continue
+			}
+
 			traceFilterMiss(l, filter, c)
 		}
 	default:
+		if _, ok := filter.FeatureName(); ok {
+			for _, c := range components {
+				if matchConfigAttribute(c, filter) {
+					result = append(result, c)
+
+					continue
+				}
+
+				traceFilterMiss(l, filter, c)
+			}
+
+			break
+		}
+
 		return nil, NewEvaluationError("unknown attribute key: " + filter.Key)
 	}
 
@@ -273,27 +313,49 @@ func evaluatePrefixExpression(
 	return results, nil
 }
 
-// evaluateInfixExpression evaluates an infix expression (intersection).
+// evaluateInfixExpression evaluates an infix expression (intersection or union).
 func evaluateInfixExpression(
 	l log.Logger,
 	expr *InfixExpression,
 	components component.Components,
 ) (component.Components, error) {
-	if expr.Operator != "|" {
-		return nil, NewEvaluationError("unknown infix operator: " + expr.Operator)
-	}
+	switch expr.Operator {
+	case "|":
+		leftResult, err := Evaluate(l, expr.Left, components)
+		if err != nil {
+			return nil, err
+		}
 
-	leftResult, err := Evaluate(l, expr.Left, components)
-	if err != nil {
-		return nil, err
-	}
+		return Evaluate(l, expr.Right, leftResult)
+	case "||":
+		leftResult, err := Evaluate(l, expr.Left, components)
+		if err != nil {
+			return nil, err
+		}
 
-	rightResult, err := Evaluate(l, expr.Right, leftResult)
-	if err != nil {
-		return nil, err
-	}
+		rightResult, err := Evaluate(l, expr.Right, components)
+		if err != nil {
+			return nil, err
+		}
 
-	return rightResult, nil
+		// Deduplicate by path, as graph traversal may return different instances of the same component.
+		seen := make(map[string]struct{}, len(leftResult)+len(rightResult))
+		results := make(component.Components, 0, len(leftResult)+len(rightResult))
+
+		for _, c := range slices.Concat(leftResult, rightResult) {
+			if _, ok := seen[c.Path()]; ok {
+				continue
+			}
+
+			seen[c.Path()] = struct{}{}
+
+			results = append(results, c)
+		}
+
+		return results, nil
+	default:
+		return nil, NewEvaluationError("unknown infix operator: " + expr.Operator)
+	}
 }
 
 // evaluateGraphExpression evaluates a graph expression by traversing dependency/dependent graphs.
@@ -5,10 +5,12 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/internal/component"
 	"github.com/gruntwork-io/terragrunt/internal/filter"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
 	"github.com/gruntwork-io/terragrunt/pkg/config"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
 )
 
 func TestEvaluate_PathFilter(t *testing.T) {
@@ -351,6 +353,127 @@ func TestEvaluate_AttributeFilter_Source(t *testing.T) {
 	}
 }
 
+func TestEvaluate_AttributeFilter_Config(t *testing.T) {
+	t.Parallel()
+
+	enabled := cty.True
+
+	components := []component.Component{
+		component.NewUnit("./apps/app1").WithConfig(
+			&config.TerragruntConfig{
+				RemoteState:    remotestate.New(&remotestate.Config{BackendName: "gcs"}),
+				PreventDestroy: new(true),
+				Terraform: &config.TerraformConfig{
+					Source: new("git::git@github.com:acme/modules.git//vpc?ref=v1.2.0"),
+				},
+				Dependencies: &config.ModuleDependencies{Paths: []string{"../db", "../vpc", "../dns"}},
+				FeatureFlags: config.FeatureFlags{{Name: "enable_logs", Default: &enabled}},
+			},
+		),
+		component.NewUnit("./apps/app2").WithConfig(
+			&config.TerragruntConfig{
+				RemoteState: remotestate.New(&remotestate.Config{BackendName: "s3"}),
+				Terraform: &config.TerraformConfig{
+					Source: new("tfr:///terraform-aws-modules/vpc/aws?version=5.0.0"),
+				},
+				Dependencies: &config.ModuleDependencies{Paths: []string{"../db"}},
+			},
+		),
+		component.NewUnit("./apps/app3").WithConfig(
+			&config.TerragruntConfig{
+				Terraform: &config.TerraformConfig{
+					Source: new("git::git@github.com:acme/modules.git//vpc?ref=main"),
+				},
+			},
+		),
+		component.NewUnit("./apps/unparsed"),
+		component.NewStack("./stacks/stack1"),
+	}
+
+	tests := []struct {
+		name     string
+		filter   *filter.AttributeExpression
+		expected []component.Component
+	}{
+		{
+			name:     "backend",
+			filter:   mustAttr(t, "backend", "gcs"),
+			expected: []component.Component{components[0]},
+		},
+		{
+			name:     "backend glob",
+			filter:   mustAttr(t, "backend", "*s*"),
+			expected: []component.Component{components[0], components[1]},
+		},
+		{
+			name:     "prevent_destroy true",
+			filter:   mustAttr(t, "prevent_destroy", "true"),
+			expected: []component.Component{components[0]},
+		},
+		{
+			name:     "prevent_destroy false includes unset",
+			filter:   mustAttr(t, "prevent_destroy", "false"),
+			expected: []component.Component{components[1], components[2]},
+		},
+		{
+			name:     "dependency-count equal",
+			filter:   mustAttr(t, "dependency-count", "0"),
+			expected: []component.Component{components[2]},
+		},
+		{
+			name:     "dependency-count greater than",
+			filter:   mustComparison(t, "dependency-count", ">", "1"),
+			expected: []component.Component{components[0]},
+		},
+		{
+			name:     "dependency-count less than or equal",
+			filter:   mustComparison(t, "dependency-count", "<=", "1"),
+			expected: []component.Component{components[1], components[2]},
+		},
+		{
+			name:     "source-version glob",
+			filter:   mustAttr(t, "source-version", "v1.*"),
+			expected: []component.Component{components[0]},
+		},
+		{
+			name:     "source-version less than skips non-version refs",
+			filter:   mustComparison(t, "source-version", "<", "v2.0.0"),
+			expected: []component.Component{components[0]},
+		},
+		{
+			name:     "source-version greater than or equal uses registry version",
+			filter:   mustComparison(t, "source-version", ">=", "1.2.0"),
+			expected: []component.Component{components[0], components[1]},
+		},
+		{
+			name:     "feature name",
+			filter:   mustAttr(t, "feature", "enable_*"),
+			expected: []component.Component{components[0]},
+		},
+		{
+			name:     "feature value",
+			filter:   mustAttr(t, "feature.enable_logs", "true"),
+			expected: []component.Component{components[0]},
+		},
+		{
+			name:     "feature value mismatch",
+			filter:   mustAttr(t, "feature.enable_logs", "false"),
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := log.New()
+			result, err := filter.Evaluate(l, tt.filter, components)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, result)
+		})
+	}
+}
+
 func TestEvaluate_AttributeFilter_Reading_ComponentAddedOnlyOnce(t *testing.T) {
 	t.Parallel()
 
@@ -548,6 +671,78 @@ func TestEvaluate_InfixExpression(t *testing.T) {
 	}
 }
 
+func TestEvaluate_UnionAndGroupExpressions(t *testing.T) {
+	t.Parallel()
+
+	db := component.NewUnit("./libs/db")
+	api := component.NewUnit("./apps/api")
+	legacy := component.NewUnit("./apps/legacy")
+	worker := component.NewUnit("./libs/worker")
+	other := component.NewUnit("./other/tool")
+
+	api.AddDependency(db)
+
+	components := component.Components{api, legacy, db, worker, other}
+	for _, c := range components {
+		c.SetDiscoveryContext(&component.DiscoveryContext{WorkingDir: "."})
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected component.Components
+	}{
+		{
+			name:     "union",
+			query:    "./apps/* || ./libs/*",
+			expected: component.Components{api, legacy, db, worker},
+		},
+		{
+			name:     "union deduplicates",
+			query:    "./apps/* || name=api",
+			expected: component.Components{api, legacy},
+		},
+		{
+			name:     "intersection binds tighter than union",
+			query:    "./libs/* || ./apps/* | !legacy",
+			expected: component.Components{db, worker, api},
+		},
+		{
+			name:     "grouped union intersected",
+			query:    "(./apps/* || ./libs/*) | !legacy",
+			expected: component.Components{api, db, worker},
+		},
+		{
+			name:     "negation as union operand",
+			query:    "!./apps/* || legacy",
+			expected: component.Components{legacy, db, worker, other},
+		},
+		{
+			name:     "negated group",
+			query:    "!(./apps/* || ./libs/*)",
+			expected: component.Components{other},
+		},
+		{
+			name:     "graph expression in group",
+			query:    "(api... || tool) | !./apps/*",
+			expected: component.Components{db, other},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := filter.Parse(tt.query)
+			require.NoError(t, err)
+
+			result, err := f.Evaluate(log.New(), components)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, result)
+		})
+	}
+}
+
 func TestEvaluate_ComplexExpressions(t *testing.T) {
 	t.Parallel()
 
@@ -63,6 +63,10 @@ func (f *Filter) Negated() *Filter {
 	case *PrefixExpression:
 		return NewFilter(node.Right, f.originalQuery)
 	case *InfixExpression:
+		if node.Operator == "||" {
+			return NewFilter(node.Negated(), f.originalQuery)
+		}
+
 		return NewFilter(
 			NewInfixExpression(
 				node.Left.Negated(),
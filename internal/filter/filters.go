@@ -12,6 +12,27 @@ import (
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 )
 
+// AliasPrefix is the prefix of filter queries referencing a named filter defined in the root configuration,
+// e.g. "@prod-network".
+const AliasPrefix = "@"
+
+// AliasName returns the name of the named filter a filter query references, and whether it references one.
+func AliasName(query string) (string, bool) {
+	name, ok := strings.CutPrefix(strings.TrimSpace(query), AliasPrefix)
+	if !ok || name == "" {
+		return "", false
+	}
+
+	return name, true
+}
+
+// IsAlias returns true if the filter query references a named filter.
+func IsAlias(query string) bool {
+	_, ok := AliasName(query)
+
+	return ok
+}
+
 // Filters represents multiple filter queries that are evaluated with union (OR) semantics.
 // Multiple filters in Filters are always unioned (as opposed to multiple filters
 // within one filter string separated by |, which are intersected).
@@ -1048,3 +1048,30 @@ func TestFilters_GitExpressionAsGraphTarget(t *testing.T) {
 		assert.True(t, filters.HasPositiveFilter(), "Git-graph expression is a positive filter")
 	})
 }
+
+func TestAliasName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		query    string
+		expected string
+		ok       bool
+	}{
+		{query: "@prod-network", expected: "prod-network", ok: true},
+		{query: "  @prod  ", expected: "prod", ok: true},
+		{query: "@"},
+		{query: "prod-network"},
+		{query: "./apps/* | @prod"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			t.Parallel()
+
+			name, ok := filter.AliasName(tc.query)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, name)
+			assert.Equal(t, tc.ok, filter.IsAlias(tc.query))
+		})
+	}
+}
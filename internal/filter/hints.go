@@ -14,15 +14,19 @@ func GetHint(code ErrorCode, token, query string, position int) string {
 		return getMissingClosingBracketHint(query)
 	case ErrorCodeMissingClosingBrace:
 		return getMissingClosingBraceHint(query)
+	case ErrorCodeMissingClosingParen:
+		return "Parentheses group expressions and must be balanced. e.g. '(./apps/* || ./libs/*) | !legacy'"
 	case ErrorCodeMissingGitRef:
 		return "Git filters with '...' require a reference on each side. e.g. '[main...HEAD]'"
 	case ErrorCodeUnexpectedEOF:
 		return getUnexpectedEOFHint(query)
 	case ErrorCodeIllegalToken:
-		return "This character is not recognized. Valid operators: | (union), ! (negation), = (attribute)"
+		return "This character is not recognized. Valid operators: | (intersection), || (union), ! (negation), " +
+			"= (attribute), <, >, <=, >= (attribute comparison), () (grouping)"
 
 	// These have error messages that are pretty self-explanatory and don't need hints.
-	case ErrorCodeEmptyGitFilter, ErrorCodeEmptyExpression, ErrorCodeMissingOperand, ErrorCodeInvalidGlob:
+	case ErrorCodeEmptyGitFilter, ErrorCodeEmptyExpression, ErrorCodeMissingOperand, ErrorCodeInvalidGlob,
+		ErrorCodeInvalidAttributeValue:
 		return ""
 
 	// These are errors that don't have obvious hints that can be offered.
@@ -38,7 +42,7 @@ func getUnexpectedTokenHint(token, query string, position int) string {
 	switch token {
 	case "^":
 		return getCaretHint(query, position)
-	case "|":
+	case "|", "||":
 		return ""
 	case "=":
 		return "The equals sign is used for attribute filters. e.g. 'name=foo'"
@@ -46,6 +50,8 @@ func getUnexpectedTokenHint(token, query string, position int) string {
 		return "Unexpected ']' without matching '['. Git-based expressions use square brackets. e.g. '[main...HEAD]'"
 	case "}":
 		return "Unexpected '}' without matching '{'. Explicit path expressions use braces. e.g. '{./my path}'"
+	case ")":
+		return "Unexpected ')' without matching '('. Parentheses group expressions. e.g. '(foo || bar) | !baz'"
 	case "...":
 		return "The '...' operator must be used in either a graph-based or Git-based expression. " +
 			"e.g. '...foo...' or '[main...HEAD]'"
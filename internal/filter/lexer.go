@@ -11,7 +11,7 @@ type Lexer struct {
 	position     int    // Current position in input (points to current char)
 	readPosition int    // Current reading position in input (after current char)
 	ch           byte   // Current char under examination
-	afterEqual   bool   // True if the last token was EQUAL (for parsing attribute values)
+	afterEqual   bool   // True if the last token was EQUAL or a comparison (for parsing attribute values)
 }
 
 // NewLexer creates a new Lexer for the given input string.
@@ -35,11 +35,29 @@ func (l *Lexer) NextToken() Token {
 
 	startPosition := l.position
 
+	// A value may start with a grouping or comparison character, as in `name=(legacy)`.
+	if l.afterEqual && (l.ch == '(' || l.ch == '<' || l.ch == '>') {
+		literal := l.readAttributeValue()
+		l.afterEqual = false
+
+		return NewToken(IDENT, literal, startPosition)
+	}
+
 	switch l.ch {
 	case '!':
 		tok = NewToken(BANG, string(l.ch), startPosition)
 		l.readChar()
 	case '|':
+		if l.peekChar() == '|' {
+			l.readChar()
+
+			tok = NewToken(OR, "||", startPosition)
+
+			l.readChar()
+
+			break
+		}
+
 		tok = NewToken(PIPE, string(l.ch), startPosition)
 		l.readChar()
 	case '=':
@@ -47,6 +65,11 @@ func (l *Lexer) NextToken() Token {
 		l.readChar()
 		l.afterEqual = true
 
+		return tok
+	case '<', '>':
+		tok = l.readComparison(startPosition)
+		l.afterEqual = true
+
 		return tok
 	case '{':
 		tok = NewToken(LBRACE, string(l.ch), startPosition)
@@ -63,6 +86,12 @@ func (l *Lexer) NextToken() Token {
 	case '^':
 		tok = NewToken(CARET, string(l.ch), startPosition)
 		l.readChar()
+	case '(':
+		tok = NewToken(LPAREN, string(l.ch), startPosition)
+		l.readChar()
+	case ')':
+		tok = NewToken(RPAREN, string(l.ch), startPosition)
+		l.readChar()
 	case 0:
 		tok = NewToken(EOF, "", startPosition)
 	case '.':
@@ -140,6 +169,32 @@ func (l *Lexer) NextToken() Token {
 	return tok
 }
 
+// readComparison reads a comparison operator: <, >, <= or >=.
+func (l *Lexer) readComparison(startPosition int) Token {
+	tokenType := LT
+	if l.ch == '>' {
+		tokenType = GT
+	}
+
+	if l.peekChar() == '=' {
+		literal := string(l.ch) + "="
+
+		l.readChar()
+		l.readChar()
+
+		if tokenType == LT {
+			return NewToken(LTE, literal, startPosition)
+		}
+
+		return NewToken(GTE, literal, startPosition)
+	}
+
+	tok := NewToken(tokenType, string(l.ch), startPosition)
+	l.readChar()
+
+	return tok
+}
+
 // readChar advances the lexer's position and updates the current character.
 func (l *Lexer) readChar() {
 	if l.readPosition >= len(l.input) {
@@ -176,21 +231,7 @@ func (l *Lexer) skipWhitespace() {
 // This includes hidden files starting with a dot like .gitignore
 // Trailing whitespace is trimmed.
 func (l *Lexer) readIdentifier() string {
-	position := l.position
-	for isIdentifierChar(l.ch) {
-		// stop at ellipsis (...)
-		if l.ch == '.' && l.peekChar() == '.' {
-			if l.readPosition+1 < len(l.input) && l.input[l.readPosition+1] == '.' {
-				break
-			}
-		}
-
-		l.readChar()
-	}
-
-	literal := l.input[position:l.position]
-
-	return strings.TrimSpace(literal)
+	return l.readWhile(isIdentifierChar)
 }
 
 // readAttributeValue reads an attribute value from the input.
@@ -198,29 +239,25 @@ func (l *Lexer) readIdentifier() string {
 // They stop at special operators (|, !, {, }) or end of input.
 // Trailing whitespace is trimmed.
 func (l *Lexer) readAttributeValue() string {
-	position := l.position
-	for isAttributeValueChar(l.ch) {
-		// stop at ellipsis (...)
-		if l.ch == '.' && l.peekChar() == '.' {
-			if l.readPosition+1 < len(l.input) && l.input[l.readPosition+1] == '.' {
-				break
-			}
-		}
-
-		l.readChar()
-	}
-
-	literal := l.input[position:l.position]
-
-	return strings.TrimSpace(literal)
+	return l.readWhile(isAttributeValueChar)
 }
 
 // readPath reads a path from the input.
 // Paths can contain any characters except special operators.
 // Trailing whitespace is trimmed.
 func (l *Lexer) readPath(startPosition int) Token {
+	return NewToken(PATH, l.readWhile(isPathChar), startPosition)
+}
+
+// readWhile reads the characters accepted by isChar, stopping at an ellipsis (...).
+// Parentheses are only part of the literal when they are balanced within it, as in `./apps/foo(1)`,
+// so that a `)` closing a group still ends the literal.
+// Trailing whitespace is trimmed.
+func (l *Lexer) readWhile(isChar func(byte) bool) string {
 	position := l.position
-	for isPathChar(l.ch) {
+	depth := 0
+
+	for isChar(l.ch) {
 		// stop at ellipsis (...)
 		if l.ch == '.' && l.peekChar() == '.' {
 			if l.readPosition+1 < len(l.input) && l.input[l.readPosition+1] == '.' {
@@ -228,30 +265,50 @@ func (l *Lexer) readPath(startPosition int) Token {
 			}
 		}
 
+		if l.ch == '(' {
+			depth++
+		}
+
+		if l.ch == ')' {
+			if depth == 0 {
+				break
+			}
+
+			depth--
+		}
+
 		l.readChar()
 	}
 
 	literal := l.input[position:l.position]
 
-	literal = strings.TrimSpace(literal)
-
-	return NewToken(PATH, literal, startPosition)
+	return strings.TrimSpace(literal)
 }
 
 // containsSlashBeforeSpecialChar checks if there's a slash in the input before
 // we encounter a special character, starting from the current position.
 func (l *Lexer) containsSlashBeforeSpecialChar() bool {
 	pos := l.position
+	depth := 0
+
 	for pos < len(l.input) {
 		ch := l.input[pos]
 		if ch == '/' {
 			return true
 		}
 
-		if isSpecialChar(ch) {
+		if ch == ')' && depth == 0 || !isIdentifierChar(ch) {
 			return false
 		}
 
+		if ch == '(' {
+			depth++
+		}
+
+		if ch == ')' {
+			depth--
+		}
+
 		pos++
 	}
 
@@ -259,8 +316,10 @@ func (l *Lexer) containsSlashBeforeSpecialChar() bool {
 }
 
 // isSpecialChar returns true if the character is a special operator or delimiter.
+// Grouping and comparison characters are only operators between literals, see isOperatorOnlyChar.
 func isSpecialChar(ch byte) bool {
-	return ch == '!' || ch == '|' || ch == '=' || ch == '{' || ch == '}' || ch == '[' || ch == ']' || ch == '^' || ch == 0
+	return ch == '!' || ch == '|' || ch == '=' || ch == '<' || ch == '>' ||
+		ch == '{' || ch == '}' || ch == '[' || ch == ']' || ch == '(' || ch == ')' || ch == '^' || ch == 0
 }
 
 // isPathSeparator returns true if the character is a path separator.
@@ -268,18 +327,25 @@ func isPathSeparator(ch byte) bool {
 	return ch == '/'
 }
 
+// isOperatorOnlyChar returns true if the character is a grouping or comparison operator between literals,
+// but an ordinary character within paths and attribute values, as in `./apps/a<b` or `name=foo(bar)`.
+func isOperatorOnlyChar(ch byte) bool {
+	return ch == '(' || ch == ')' || ch == '<' || ch == '>'
+}
+
 // isIdentifierChar returns true if the character can be part of an identifier.
+// Identifiers stop at comparison operators, so that `dependency-count>2` is an attribute comparison.
 func isIdentifierChar(ch byte) bool {
-	return !isSpecialChar(ch) && !isPathSeparator(ch)
+	return (!isSpecialChar(ch) || ch == '(' || ch == ')') && !isPathSeparator(ch)
 }
 
 // isAttributeValueChar returns true if the character can be part of an attribute value.
 // Attribute values can contain slashes (unlike regular identifiers).
 func isAttributeValueChar(ch byte) bool {
-	return !isSpecialChar(ch)
+	return !isSpecialChar(ch) || isOperatorOnlyChar(ch)
 }
 
 // isPathChar returns true if the character can be part of a path.
 func isPathChar(ch byte) bool {
-	return !isSpecialChar(ch)
+	return !isSpecialChar(ch) || isOperatorOnlyChar(ch)
 }
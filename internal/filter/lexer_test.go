@@ -259,6 +259,41 @@ func TestLexer_ComplexQueries(t *testing.T) {
 				{Type: filter.EOF, Literal: "", Position: 8},
 			},
 		},
+		{
+			name:  "attribute comparison",
+			input: "dependency-count>=2",
+			expected: []filter.Token{
+				{Type: filter.IDENT, Literal: "dependency-count", Position: 0},
+				{Type: filter.GTE, Literal: ">=", Position: 16},
+				{Type: filter.IDENT, Literal: "2", Position: 18},
+				{Type: filter.EOF, Literal: "", Position: 19},
+			},
+		},
+		{
+			name:  "attribute comparison with version value",
+			input: "source-version<v1.2.0",
+			expected: []filter.Token{
+				{Type: filter.IDENT, Literal: "source-version", Position: 0},
+				{Type: filter.LT, Literal: "<", Position: 14},
+				{Type: filter.IDENT, Literal: "v1.2.0", Position: 15},
+				{Type: filter.EOF, Literal: "", Position: 21},
+			},
+		},
+		{
+			name:  "grouped union",
+			input: "(./apps/* || foo) | !bar",
+			expected: []filter.Token{
+				{Type: filter.LPAREN, Literal: "(", Position: 0},
+				{Type: filter.PATH, Literal: "./apps/*", Position: 1},
+				{Type: filter.OR, Literal: "||", Position: 10},
+				{Type: filter.IDENT, Literal: "foo", Position: 13},
+				{Type: filter.RPAREN, Literal: ")", Position: 16},
+				{Type: filter.PIPE, Literal: "|", Position: 18},
+				{Type: filter.BANG, Literal: "!", Position: 20},
+				{Type: filter.IDENT, Literal: "bar", Position: 21},
+				{Type: filter.EOF, Literal: "", Position: 24},
+			},
+		},
 		{
 			name:  "negated attribute filter",
 			input: "!name=bar",
@@ -471,6 +506,64 @@ func TestLexer_EdgeCases(t *testing.T) {
 				{Type: filter.EOF, Literal: "", Position: 7},
 			},
 		},
+		{
+			name:  "parentheses in path",
+			input: "./apps/foo(1)",
+			expected: []filter.Token{
+				{Type: filter.PATH, Literal: "./apps/foo(1)", Position: 0},
+				{Type: filter.EOF, Literal: "", Position: 13},
+			},
+		},
+		{
+			name:  "parentheses in attribute value",
+			input: "name=foo(bar)",
+			expected: []filter.Token{
+				{Type: filter.IDENT, Literal: "name", Position: 0},
+				{Type: filter.EQUAL, Literal: "=", Position: 4},
+				{Type: filter.IDENT, Literal: "foo(bar)", Position: 5},
+				{Type: filter.EOF, Literal: "", Position: 13},
+			},
+		},
+		{
+			name:  "less than in path",
+			input: "./apps/a<b",
+			expected: []filter.Token{
+				{Type: filter.PATH, Literal: "./apps/a<b", Position: 0},
+				{Type: filter.EOF, Literal: "", Position: 10},
+			},
+		},
+		{
+			name:  "greater than in attribute value",
+			input: "name=a>b",
+			expected: []filter.Token{
+				{Type: filter.IDENT, Literal: "name", Position: 0},
+				{Type: filter.EQUAL, Literal: "=", Position: 4},
+				{Type: filter.IDENT, Literal: "a>b", Position: 5},
+				{Type: filter.EOF, Literal: "", Position: 8},
+			},
+		},
+		{
+			name:  "grouped path with parentheses",
+			input: "(./apps/foo(1))",
+			expected: []filter.Token{
+				{Type: filter.LPAREN, Literal: "(", Position: 0},
+				{Type: filter.PATH, Literal: "./apps/foo(1)", Position: 1},
+				{Type: filter.RPAREN, Literal: ")", Position: 14},
+				{Type: filter.EOF, Literal: "", Position: 15},
+			},
+		},
+		{
+			name:  "grouped attribute value",
+			input: "(name=foo)",
+			expected: []filter.Token{
+				{Type: filter.LPAREN, Literal: "(", Position: 0},
+				{Type: filter.IDENT, Literal: "name", Position: 1},
+				{Type: filter.EQUAL, Literal: "=", Position: 5},
+				{Type: filter.IDENT, Literal: "foo", Position: 6},
+				{Type: filter.RPAREN, Literal: ")", Position: 9},
+				{Type: filter.EOF, Literal: "", Position: 10},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -502,6 +595,13 @@ func TestTokenType_String(t *testing.T) {
 		{"!", filter.BANG},
 		{"|", filter.PIPE},
 		{"=", filter.EQUAL},
+		{"||", filter.OR},
+		{"(", filter.LPAREN},
+		{")", filter.RPAREN},
+		{"<", filter.LT},
+		{">", filter.GT},
+		{"<=", filter.LTE},
+		{">=", filter.GTE},
 	}
 
 	for _, tt := range tests {
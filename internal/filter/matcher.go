@@ -1,9 +1,18 @@
 package filter
 
 import (
+	"cmp"
+	"net/url"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/hashicorp/go-version"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 )
 
 // MatchComponent checks if a single component matches an expression.
@@ -24,16 +33,15 @@ func MatchComponent(c component.Component, expr Expression) bool {
 		return MatchComponent(c, node.Right)
 
 	case *InfixExpression:
-		if node.Operator != "|" {
+		switch node.Operator {
+		case "|":
+			return MatchComponent(c, node.Left) && MatchComponent(c, node.Right)
+		case "||":
+			return MatchComponent(c, node.Left) || MatchComponent(c, node.Right)
+		default:
 			return false
 		}
 
-		if !MatchComponent(c, node.Left) {
-			return false
-		}
-
-		return MatchComponent(c, node.Right)
-
 	case *GraphExpression:
 		return MatchComponent(c, node.Target)
 
@@ -71,7 +79,7 @@ func matchPath(c component.Component, expr *PathExpression) bool {
 
 // matchAttribute checks if a component matches an attribute expression.
 // This handles attributes that can be evaluated without parsing (name, type, external).
-// For attributes requiring parsing (reading, source), this returns false.
+// For attributes requiring parsing (reading, source and the configuration attributes), this returns false.
 func matchAttribute(c component.Component, expr *AttributeExpression) bool {
 	switch expr.Key {
 	case AttributeName:
@@ -107,6 +115,10 @@ func matchAttribute(c component.Component, expr *AttributeExpression) bool {
 		// Source attribute requires parsing, can't evaluate without parsed data
 		return false
 
+	case AttributeBackend, AttributePreventDestroy, AttributeDependencyCount, AttributeSourceVersion, AttributeFeature:
+		// Configuration attributes require parsing, can't evaluate without parsed data
+		return false
+
 	default:
 		return false
 	}
@@ -122,3 +134,140 @@ func matchGit(c component.Component, expr *GitExpression) bool {
 
 	return discoveryCtx.Ref == expr.FromRef || discoveryCtx.Ref == expr.ToRef
 }
+
+// matchConfigAttribute checks if a unit matches an attribute expression over its parsed configuration
+// (backend, prevent_destroy, dependency-count, source-version, feature).
+// Stacks and units that haven't been parsed never match.
+func matchConfigAttribute(c component.Component, expr *AttributeExpression) bool {
+	unit, ok := c.(*component.Unit)
+	if !ok {
+		return false
+	}
+
+	cfg := unit.Config()
+	if cfg == nil {
+		return false
+	}
+
+	if name, ok := expr.FeatureName(); ok {
+		return matchFeatureValue(cfg.FeatureFlags, name, expr)
+	}
+
+	switch expr.Key {
+	case AttributeBackend:
+		if cfg.RemoteState == nil || cfg.RemoteState.Config == nil {
+			return false
+		}
+
+		return expr.Glob().Match(cfg.RemoteState.BackendName)
+
+	case AttributePreventDestroy:
+		preventDestroy := cfg.PreventDestroy != nil && *cfg.PreventDestroy
+
+		return strconv.FormatBool(preventDestroy) == expr.Value
+
+	case AttributeDependencyCount:
+		count := 0
+		if cfg.Dependencies != nil {
+			count = len(cfg.Dependencies.Paths)
+		}
+
+		want, err := strconv.Atoi(expr.Value)
+		if err != nil {
+			return false
+		}
+
+		return compareWith(expr.operator(), cmp.Compare(count, want))
+
+	case AttributeSourceVersion:
+		if cfg.Terraform == nil || cfg.Terraform.Source == nil {
+			return false
+		}
+
+		ref := sourceVersion(*cfg.Terraform.Source)
+		if ref == "" {
+			return false
+		}
+
+		if expr.operator() == OperatorEqual {
+			return expr.Glob().Match(ref)
+		}
+
+		// Refs that aren't versions, such as branches or commit SHAs, can't be compared.
+		have, err := version.NewVersion(ref)
+		if err != nil {
+			return false
+		}
+
+		want, err := version.NewVersion(expr.Value)
+		if err != nil {
+			return false
+		}
+
+		return compareWith(expr.operator(), have.Compare(want))
+
+	case AttributeFeature:
+		return slices.ContainsFunc(cfg.FeatureFlags, func(flag *config.FeatureFlag) bool {
+			return flag != nil && expr.Glob().Match(flag.Name)
+		})
+
+	default:
+		return false
+	}
+}
+
+// matchFeatureValue checks if the default value of the named feature flag matches the expression.
+func matchFeatureValue(flags config.FeatureFlags, name string, expr *AttributeExpression) bool {
+	for _, flag := range flags {
+		if flag == nil || flag.Name != name || flag.Default == nil || flag.Default.IsNull() {
+			continue
+		}
+
+		value, err := convert.Convert(*flag.Default, cty.String)
+		if err != nil || !value.IsKnown() {
+			return false
+		}
+
+		return expr.Glob().Match(value.AsString())
+	}
+
+	return false
+}
+
+// sourceVersion returns the version a Terraform source is pinned to,
+// taken from the `ref` (Git) or `version` (registry) query parameter.
+func sourceVersion(source string) string {
+	_, rawQuery, found := strings.Cut(source, "?")
+	if !found {
+		return ""
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return ""
+	}
+
+	if ref := query.Get("ref"); ref != "" {
+		return ref
+	}
+
+	return query.Get("version")
+}
+
+// compareWith reports whether the result of a three-way comparison satisfies the operator.
+func compareWith(operator string, result int) bool {
+	switch operator {
+	case OperatorEqual:
+		return result == 0
+	case OperatorLess:
+		return result < 0
+	case OperatorGreater:
+		return result > 0
+	case OperatorLessEqual:
+		return result <= 0
+	case OperatorGreaterEqual:
+		return result >= 0
+	default:
+		return false
+	}
+}
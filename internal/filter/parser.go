@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 )
@@ -18,12 +19,14 @@ type Parser struct {
 const (
 	_ int = iota
 	LOWEST
+	UNION        // ||
 	INTERSECTION // |
 	PREFIX       // !
 )
 
 // precedences maps token types to their precedence levels
 var precedences = map[TokenType]int{
+	OR:   UNION,
 	PIPE: INTERSECTION,
 }
 
@@ -132,8 +135,10 @@ func (p *Parser) parseExpression(precedence int) Expression {
 		leftExpr = p.parseBracedPath()
 	case LBRACKET:
 		leftExpr = p.parseGitFilter()
+	case LPAREN:
+		leftExpr = p.parseGroupedExpression()
 	case IDENT:
-		if p.peekToken.Type == EQUAL {
+		if isAttributeOperator(p.peekToken.Type) {
 			leftExpr = p.parseAttributeFilter()
 
 			break
@@ -159,9 +164,13 @@ func (p *Parser) parseExpression(precedence int) Expression {
 	case EOF:
 		p.addErrorWithCode(ErrorCodeUnexpectedEOF, "Unexpected end of input", "Expression is incomplete")
 		return nil
-	case PIPE:
-		p.addErrorWithCode(ErrorCodeUnexpectedToken, "Unexpected token", "Missing left-hand side of '|' operator")
-	case EQUAL, RBRACE, RBRACKET, ELLIPSIS, CARET:
+	case PIPE, OR:
+		p.addErrorWithCode(
+			ErrorCodeUnexpectedToken,
+			"Unexpected token",
+			"Missing left-hand side of '"+p.curToken.Literal+"' operator",
+		)
+	case EQUAL, LT, GT, LTE, GTE, RBRACE, RBRACKET, RPAREN, ELLIPSIS, CARET:
 		p.addErrorWithCode(ErrorCodeUnexpectedToken, "Unexpected token", "Unexpected '"+p.curToken.Literal+"'")
 		return nil
 	default:
@@ -205,9 +214,10 @@ func (p *Parser) parseExpression(precedence int) Expression {
 
 	for p.curToken.Type != EOF && precedence < p.curPrecedence() {
 		switch p.curToken.Type {
-		case PIPE:
+		case PIPE, OR:
 			leftExpr = p.parseInfixExpression(leftExpr)
-		case ILLEGAL, EOF, IDENT, PATH, BANG, EQUAL, LBRACE, RBRACE, LBRACKET, RBRACKET, ELLIPSIS, CARET:
+		case ILLEGAL, EOF, IDENT, PATH, BANG, EQUAL, LT, GT, LTE, GTE, LBRACE, RBRACE, LBRACKET, RBRACKET,
+			LPAREN, RPAREN, ELLIPSIS, CARET:
 			return leftExpr
 		default:
 			return leftExpr
@@ -281,7 +291,7 @@ func (p *Parser) parsePrefixExpression() Expression {
 	}
 }
 
-// parseInfixExpression parses an infix expression (e.g., "./apps/* | name=bar").
+// parseInfixExpression parses an infix expression (e.g., "./apps/* | name=bar" or "./apps/* || ./libs/*").
 func (p *Parser) parseInfixExpression(left Expression) Expression {
 	expression := &InfixExpression{
 		Operator: p.curToken.Literal,
@@ -296,7 +306,7 @@ func (p *Parser) parseInfixExpression(left Expression) Expression {
 		// Clear any errors from parseExpression (like generic EOF error)
 		// and add our specific error with the EOF title for consistency
 		p.errors = nil
-		p.addMissingOperandError("Unexpected end of input", "Missing right-hand side of '|' operator")
+		p.addMissingOperandError("Unexpected end of input", "Missing right-hand side of '"+expression.Operator+"' operator")
 
 		return nil
 	}
@@ -368,21 +378,27 @@ func (p *Parser) parseBracedPath() Expression {
 	return expr
 }
 
-// parseAttributeFilter parses an attribute filter (e.g., "name=foo").
+// parseAttributeFilter parses an attribute filter (e.g., "name=foo" or "dependency-count>2").
 func (p *Parser) parseAttributeFilter() Expression {
 	key := p.curToken.Literal
 
-	if !p.expectPeek(EQUAL) {
+	if !isAttributeOperator(p.peekToken.Type) {
+		p.addError("expected next token to be " + EQUAL.String() + ", got " + p.peekToken.Type.String())
+
 		return nil
 	}
 
 	p.nextToken()
 
+	operator := p.curToken.Literal
+
+	p.nextToken()
+
 	if p.curToken.Type != IDENT && p.curToken.Type != PATH {
 		p.addErrorWithCode(
 			ErrorCodeUnexpectedToken,
 			"Attribute expression missing value",
-			"Attribute expressions require a value after '='",
+			"Attribute expressions require a value after '"+operator+"'",
 		)
 
 		return nil
@@ -391,8 +407,19 @@ func (p *Parser) parseAttributeFilter() Expression {
 	value := p.curToken.Literal
 	p.nextToken()
 
-	expr, err := NewAttributeExpression(key, value)
+	expr, err := NewAttributeComparison(key, operator, value)
 	if err != nil {
+		var valueErr InvalidAttributeValueError
+		if errors.As(err, &valueErr) {
+			p.addErrorWithCode(
+				ErrorCodeInvalidAttributeValue,
+				"Invalid attribute value",
+				"Invalid "+key+" filter: "+err.Error(),
+			)
+
+			return nil
+		}
+
 		p.addErrorWithCode(
 			ErrorCodeInvalidGlob,
 			"Invalid glob pattern",
@@ -405,6 +432,46 @@ func (p *Parser) parseAttributeFilter() Expression {
 	return expr
 }
 
+// isAttributeOperator returns true if the token type can separate an attribute key from its value.
+func isAttributeOperator(t TokenType) bool {
+	return t == EQUAL || t == LT || t == GT || t == LTE || t == GTE
+}
+
+// parseGroupedExpression parses a parenthesized expression (e.g., "(./apps/* || ./libs/*)").
+func (p *Parser) parseGroupedExpression() Expression {
+	// Capture opening parenthesis position for error reporting
+	openParenPos := p.curToken.Position
+
+	// We're currently at LPAREN, move to the content
+	p.nextToken()
+
+	if p.curToken.Type == RPAREN {
+		p.addErrorWithCode(ErrorCodeEmptyExpression, "Empty group expression", "Parenthesized expression cannot be empty")
+		return nil
+	}
+
+	expr := p.parseExpression(LOWEST)
+	if expr == nil {
+		return nil
+	}
+
+	if p.curToken.Type != RPAREN {
+		p.addErrorAtPosition(
+			ErrorCodeMissingClosingParen,
+			"Unclosed group expression",
+			"This parenthesized expression is missing a closing ')'",
+			openParenPos,
+		)
+
+		return nil
+	}
+
+	// Move past RPAREN
+	p.nextToken()
+
+	return expr
+}
+
 // parseGitFilter parses a Git filter expression (e.g., "[main...HEAD]" or "[main]").
 func (p *Parser) parseGitFilter() Expression {
 	// Capture opening bracket position for error reporting
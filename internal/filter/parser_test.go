@@ -31,6 +31,31 @@ func TestParser_SimpleExpressions(t *testing.T) {
 			input:    "type=unit",
 			expected: mustAttr(t, "type", "unit"),
 		},
+		{
+			name:     "braced path with parentheses",
+			input:    "{./apps/(legacy)}",
+			expected: mustPath(t, "./apps/(legacy)"),
+		},
+		{
+			name:     "backend attribute filter",
+			input:    "backend=gcs",
+			expected: mustAttr(t, "backend", "gcs"),
+		},
+		{
+			name:     "feature value attribute filter",
+			input:    "feature.enable_logs=true",
+			expected: mustAttr(t, "feature.enable_logs", "true"),
+		},
+		{
+			name:     "dependency-count comparison",
+			input:    "dependency-count>2",
+			expected: mustComparison(t, "dependency-count", ">", "2"),
+		},
+		{
+			name:     "source-version comparison",
+			input:    "source-version<=v1.2.0",
+			expected: mustComparison(t, "source-version", "<=", "v1.2.0"),
+		},
 		{
 			name:     "path filter relative",
 			input:    "./apps/foo",
@@ -360,6 +385,56 @@ func TestParser_ErrorCases(t *testing.T) {
 			input:       "foo |",
 			expectError: true,
 		},
+		{
+			name:        "missing value after comparison",
+			input:       "dependency-count>",
+			expectError: true,
+		},
+		{
+			name:        "comparison on attribute without ordered values",
+			input:       "name>foo",
+			expectError: true,
+		},
+		{
+			name:        "non-numeric dependency count",
+			input:       "dependency-count>two",
+			expectError: true,
+		},
+		{
+			name:        "non-version source-version comparison",
+			input:       "source-version<main",
+			expectError: true,
+		},
+		{
+			name:        "missing right side of or",
+			input:       "foo ||",
+			expectError: true,
+		},
+		{
+			name:        "missing left side of or",
+			input:       "|| foo",
+			expectError: true,
+		},
+		{
+			name:        "unclosed group",
+			input:       "(foo || bar",
+			expectError: true,
+		},
+		{
+			name:        "unopened group",
+			input:       "foo || bar)",
+			expectError: true,
+		},
+		{
+			name:        "empty group",
+			input:       "()",
+			expectError: true,
+		},
+		{
+			name:        "non-boolean prevent_destroy",
+			input:       "prevent_destroy=yes",
+			expectError: true,
+		},
 		{
 			name:        "invalid token",
 			input:       "foo|",
@@ -391,6 +466,153 @@ func TestParser_ErrorCases(t *testing.T) {
 	}
 }
 
+func TestParser_UnionAndGroupExpressions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expected filter.Expression
+		name     string
+		input    string
+	}{
+		{
+			name:  "union of two paths",
+			input: "./apps/* || ./libs/*",
+			expected: &filter.InfixExpression{
+				Left:     mustPath(t, "./apps/*"),
+				Operator: "||",
+				Right:    mustPath(t, "./libs/*"),
+			},
+		},
+		{
+			name:  "intersection binds tighter than union",
+			input: "./apps/* || ./libs/* | !legacy",
+			expected: &filter.InfixExpression{
+				Left:     mustPath(t, "./apps/*"),
+				Operator: "||",
+				Right: &filter.InfixExpression{
+					Left:     mustPath(t, "./libs/*"),
+					Operator: "|",
+					Right:    filter.NewPrefixExpression("!", mustAttr(t, "name", "legacy")),
+				},
+			},
+		},
+		{
+			name:  "grouped union intersected",
+			input: "(./apps/* || ./libs/*) | !legacy",
+			expected: &filter.InfixExpression{
+				Left: &filter.InfixExpression{
+					Left:     mustPath(t, "./apps/*"),
+					Operator: "||",
+					Right:    mustPath(t, "./libs/*"),
+				},
+				Operator: "|",
+				Right:    filter.NewPrefixExpression("!", mustAttr(t, "name", "legacy")),
+			},
+		},
+		{
+			name:  "negated group",
+			input: "!(foo || bar)",
+			expected: filter.NewPrefixExpression("!", &filter.InfixExpression{
+				Left:     mustAttr(t, "name", "foo"),
+				Operator: "||",
+				Right:    mustAttr(t, "name", "bar"),
+			}),
+		},
+		{
+			name:  "graph expression on group",
+			input: "...(foo || bar)...",
+			expected: filter.NewGraphExpression(&filter.InfixExpression{
+				Left:     mustAttr(t, "name", "foo"),
+				Operator: "||",
+				Right:    mustAttr(t, "name", "bar"),
+			}).WithDependents().WithDependencies(),
+		},
+		{
+			name:  "graph and git expressions in group",
+			input: "(foo... || [main...HEAD])",
+			expected: &filter.InfixExpression{
+				Left:     filter.NewGraphExpression(mustAttr(t, "name", "foo")).WithDependencies(),
+				Operator: "||",
+				Right:    filter.NewGitExpression("main", "HEAD"),
+			},
+		},
+		{
+			name:     "redundant group",
+			input:    "((foo))",
+			expected: mustAttr(t, "name", "foo"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lexer := filter.NewLexer(tt.input)
+			parser := filter.NewParser(lexer)
+			expr, err := parser.ParseExpression()
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, expr)
+
+			// The string representation must parse back to the same expression.
+			reparsed, err := filter.NewParser(filter.NewLexer(expr.String())).ParseExpression()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, reparsed)
+		})
+	}
+}
+
+func TestParser_OperatorCharactersInLiterals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expected filter.Expression
+		name     string
+		input    string
+	}{
+		{
+			name:     "parentheses in path",
+			input:    "./apps/foo(1)",
+			expected: mustPath(t, "./apps/foo(1)"),
+		},
+		{
+			name:     "parentheses in attribute value",
+			input:    "name=foo(bar)",
+			expected: mustAttr(t, "name", "foo(bar)"),
+		},
+		{
+			name:     "less than in path",
+			input:    "./apps/a<b",
+			expected: mustPath(t, "./apps/a<b"),
+		},
+		{
+			name:     "greater than in attribute value",
+			input:    "name=a>b",
+			expected: mustAttr(t, "name", "a>b"),
+		},
+		{
+			name:  "grouped path with parentheses",
+			input: "(./apps/foo(1) || name=foo(bar))",
+			expected: &filter.InfixExpression{
+				Left:     mustPath(t, "./apps/foo(1)"),
+				Operator: "||",
+				Right:    mustAttr(t, "name", "foo(bar)"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := filter.NewParser(filter.NewLexer(tt.input)).ParseExpression()
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, expr)
+		})
+	}
+}
+
 func TestParser_StringRepresentation(t *testing.T) {
 	t.Parallel()
 
@@ -19,13 +19,20 @@ const (
 	// Operators
 	BANG  // negation operator (!)
 	PIPE  // intersection operator (|)
+	OR    // union operator (||)
 	EQUAL // attribute assignment (=)
+	LT    // less than comparison (<)
+	GT    // greater than comparison (>)
+	LTE   // less than or equal comparison (<=)
+	GTE   // greater than or equal comparison (>=)
 
 	// Delimiters
 	LBRACE   // left brace ({)
 	RBRACE   // right brace (})
 	LBRACKET // left bracket ([)
 	RBRACKET // right bracket (])
+	LPAREN   // left parenthesis (()
+	RPAREN   // right parenthesis ())
 
 	// Graph operators
 	ELLIPSIS // ellipsis operator (...)
@@ -47,8 +54,18 @@ func (t TokenType) String() string {
 		return "!"
 	case PIPE:
 		return "|"
+	case OR:
+		return "||"
 	case EQUAL:
 		return "="
+	case LT:
+		return "<"
+	case GT:
+		return ">"
+	case LTE:
+		return "<="
+	case GTE:
+		return ">="
 	case LBRACE:
 		return "{"
 	case RBRACE:
@@ -57,6 +74,10 @@ func (t TokenType) String() string {
 		return "["
 	case RBRACKET:
 		return "]"
+	case LPAREN:
+		return "("
+	case RPAREN:
+		return ")"
 	case ELLIPSIS:
 		return "..."
 	case CARET:
@@ -44,6 +44,14 @@ type CASGetter struct {
 	// (runner/run/download_source.go), so the value is never read across
 	// concurrent requests. Do not share one CASGetter across goroutines.
 	userDisabledArchive bool
+
+	// checksum is the checksum parameter lifted out of the source for the
+	// current request. Detect sets it and Get reads it, under the same
+	// per-request contract as userDisabledArchive. The outer client
+	// rejects a checksum for the directory downloads CASGetter performs,
+	// so it is carried past the outer client here, keys the cache entry,
+	// and is re-applied to the inner fetch that verifies the download.
+	checksum string
 }
 
 // InnerClientBuilder builds the per-fetch [getter.Client] used to invoke
@@ -165,7 +173,8 @@ func (g *CASGetter) Mode(_ context.Context, _ *url.URL) (getter.Mode, error) {
 // path. Non-git schemes covered by Fetchers get archive=false appended
 // to the URL so the outer client does not pre-decompress before
 // invoking Get; a source that already disabled archiving is recorded in
-// userDisabledArchive so Get can keep the inner fetch from extracting.
+// userDisabledArchive so Get can keep the inner fetch from extracting,
+// and a checksum parameter is moved into checksum for the same reason.
 func (g *CASGetter) Detect(req *getter.Request) (bool, error) {
 	if req.Forced == SchemeGit {
 		return true, nil
@@ -181,6 +190,7 @@ func (g *CASGetter) Detect(req *getter.Request) (bool, error) {
 	if scheme, src, ok := g.matchGenericScheme(req); ok {
 		req.Forced = scheme
 		req.Src, g.userDisabledArchive = disableOuterArchive(src)
+		req.Src, g.checksum = liftChecksum(req.Src)
 
 		return true, nil
 	}
@@ -369,14 +379,37 @@ func disableOuterArchive(rawURL string) (string, bool) {
 	return u.String(), false
 }
 
+// liftChecksum removes the checksum parameter from rawURL and returns it
+// separately. Unparsable inputs are returned unchanged so the outer
+// client surfaces the same error it would without CAS.
+func liftChecksum(rawURL string) (string, string) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL, ""
+	}
+
+	q := u.Query()
+
+	checksum := q.Get("checksum")
+	if checksum == "" {
+		return rawURL, ""
+	}
+
+	q.Del("checksum")
+	u.RawQuery = q.Encode()
+
+	return u.String(), checksum
+}
+
 // innerArchiveURL builds the URL for the inner single-getter client. The
 // outer v2 client consumes and removes the archive parameter before Get
 // runs, so neither CAS's injected marker nor a user's archive=false
 // survives on its own. When userDisabled is set, archive=false is
 // re-applied so the inner client also skips extraction; otherwise the
 // URL carries no archive parameter and the inner client's extension-based
-// detection extracts .tar.gz/.zip sources before ingest.
-func innerArchiveURL(u *url.URL, userDisabled bool) string {
+// detection extracts .tar.gz/.zip sources before ingest. A non-empty
+// checksum is re-applied so the inner client verifies the download.
+func innerArchiveURL(u *url.URL, userDisabled bool, checksum string) string {
 	if u == nil {
 		return ""
 	}
@@ -389,6 +422,10 @@ func innerArchiveURL(u *url.URL, userDisabled bool) string {
 		q.Set("archive", "false")
 	}
 
+	if checksum != "" {
+		q.Set("checksum", checksum)
+	}
+
 	clone.RawQuery = q.Encode()
 
 	return clone.String()
@@ -421,6 +458,10 @@ func (g *CASGetter) getGit(ctx context.Context, req *getter.Request) error {
 // performs archive extraction, so the URL passed to it carries archive=false
 // only when the user asked to disable archiving; otherwise the marker
 // Detect injected is dropped so extension-based extraction runs there.
+//
+// A checksum-pinned source is keyed by its URL and checksum through a
+// [ChecksumResolver] instead of the scheme's resolver, so it hits the
+// cache without probing the remote.
 func (g *CASGetter) getGeneric(ctx context.Context, req *getter.Request) error {
 	scheme, ok := g.lookupFetcher(strings.ToLower(req.Forced))
 	if !ok {
@@ -429,7 +470,12 @@ func (g *CASGetter) getGeneric(ctx context.Context, req *getter.Request) error {
 
 	bare := g.fetchers[scheme]
 
-	innerURL := innerArchiveURL(req.URL(), g.userDisabledArchive)
+	innerURL := innerArchiveURL(req.URL(), g.userDisabledArchive, g.checksum)
+
+	var resolver cas.SourceResolver = g.resolvers[scheme]
+	if checksumResolver, ok := NewChecksumResolver(scheme, g.checksum); ok {
+		resolver = checksumResolver
+	}
 
 	opts := *g.Opts
 	opts.Dir = req.Dst
@@ -437,7 +483,7 @@ func (g *CASGetter) getGeneric(ctx context.Context, req *getter.Request) error {
 	return g.CAS.FetchSource(ctx, g.Logger, g.Venv, &opts, cas.SourceRequest{
 		Scheme:   scheme,
 		URL:      innerURL,
-		Resolver: g.resolvers[scheme],
+		Resolver: resolver,
 		Fetch:    g.buildInnerFetch(bare, scheme, innerURL),
 	})
 }
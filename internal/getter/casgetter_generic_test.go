@@ -4,6 +4,8 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -238,3 +240,103 @@ func TestCASGetter_HTTPMissingETagFallsBackToContentHash(t *testing.T) {
 	assert.Equal(t, int32(2), gets.Load(),
 		"with no ETag, every run downloads; cache deduplication happens only at the blob level")
 }
+
+// TestCASGetter_HTTPChecksumKeysCache pins that a checksum-pinned archive
+// routes through CAS keyed by its URL and checksum, so a server without
+// ETag or Last-Modified still hits the cache on the second run without
+// being probed.
+func TestCASGetter_HTTPChecksumKeysCache(t *testing.T) {
+	t.Parallel()
+
+	body := makeTarGz(t, map[string]string{"main.tf": "ok"})
+
+	var gets, heads atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/gzip")
+
+		switch r.Method {
+		case http.MethodHead:
+			heads.Add(1)
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			gets.Add(1)
+			w.WriteHeader(http.StatusOK)
+
+			if _, err := w.Write(body); err != nil {
+				panic(err)
+			}
+		}
+	}))
+	defer srv.Close()
+
+	storePath := filepath.Join(helpers.TmpDirWOSymlinks(t), "store")
+	c, err := tgcas.New(tgcas.WithStorePath(storePath))
+	require.NoError(t, err)
+
+	v, err := tgcas.OSVenv()
+	require.NoError(t, err)
+
+	l := logger.CreateLogger()
+
+	sum := sha256.Sum256(body)
+	src := srv.URL + "/mod.tar.gz?checksum=sha256:" + hex.EncodeToString(sum[:])
+
+	runOnce := func(t *testing.T) {
+		t.Helper()
+
+		g := getter.NewCASGetter(l, c, v, &tgcas.CloneOptions{}, getter.WithDefaultGenericDispatch())
+		client := &gogetter.Client{Getters: []gogetter.Getter{g}}
+
+		dst := filepath.Join(t.TempDir(), "out")
+
+		_, err := client.Get(t.Context(), &gogetter.Request{
+			Src:     src,
+			Dst:     dst,
+			GetMode: gogetter.ModeAny,
+		})
+		require.NoError(t, err)
+		require.FileExists(t, filepath.Join(dst, "main.tf"))
+	}
+
+	runOnce(t)
+
+	require.Equal(t, int32(1), gets.Load(), "first run must download the archive once")
+
+	firstHeads := heads.Load()
+
+	runOnce(t)
+
+	assert.Equal(t, int32(1), gets.Load(), "second run must hit the CAS through the checksum key")
+	assert.Equal(t, firstHeads, heads.Load(), "a checksum-pinned source needs no probe")
+}
+
+// TestCASGetter_HTTPChecksumMismatchFails pins that the checksum still
+// verifies the downloaded archive before it is stored.
+func TestCASGetter_HTTPChecksumMismatchFails(t *testing.T) {
+	t.Parallel()
+
+	h := &tarballHandler{body: makeTarGz(t, map[string]string{"main.tf": "ok"}), etag: "stable-etag"}
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	storePath := filepath.Join(helpers.TmpDirWOSymlinks(t), "store")
+	c, err := tgcas.New(tgcas.WithStorePath(storePath))
+	require.NoError(t, err)
+
+	v, err := tgcas.OSVenv()
+	require.NoError(t, err)
+
+	g := getter.NewCASGetter(logger.CreateLogger(), c, v, &tgcas.CloneOptions{}, getter.WithDefaultGenericDispatch())
+	client := &gogetter.Client{Getters: []gogetter.Getter{g}}
+
+	sum := sha256.Sum256([]byte("something else"))
+
+	_, err = client.Get(t.Context(), &gogetter.Request{
+		Src:     srv.URL + "/mod.tar.gz?checksum=sha256:" + hex.EncodeToString(sum[:]),
+		Dst:     filepath.Join(t.TempDir(), "out"),
+		GetMode: gogetter.ModeAny,
+	})
+	require.Error(t, err)
+}
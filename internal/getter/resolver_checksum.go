@@ -0,0 +1,50 @@
+package getter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+)
+
+// ChecksumResolver is a [cas.SourceResolver] for sources pinned by a
+// go-getter checksum parameter (`?checksum=sha256:<hex>`).
+//
+// The checksum pins the downloaded bytes, so Probe derives the cache key
+// from the URL and checksum without a network round trip. The key stays
+// URL-scoped because the checksum covers the archive rather than the
+// extracted tree, which also depends on the archive parameter. The inner
+// fetch verifies the checksum before anything is stored, so a key never
+// names content that failed verification.
+type ChecksumResolver struct {
+	scheme   string
+	checksum string
+}
+
+// NewChecksumResolver returns a resolver for sources of scheme pinned by
+// checksum. It reports false when checksum is empty or names a remote
+// checksum file (`file:<url>`), whose value is not known without a fetch.
+func NewChecksumResolver(scheme, checksum string) (*ChecksumResolver, bool) {
+	if checksum == "" {
+		return nil, false
+	}
+
+	checksum = strings.ToLower(checksum)
+
+	if strings.HasPrefix(checksum, "file:") {
+		return nil, false
+	}
+
+	return &ChecksumResolver{scheme: scheme, checksum: checksum}, true
+}
+
+// Scheme returns the URL scheme of the pinned source.
+func (r *ChecksumResolver) Scheme() string { return r.scheme }
+
+// Probe returns the URL-scoped key for rawURL and the checksum. The
+// checksum parameter is stripped from rawURL so the key does not depend
+// on where the checksum was carried. The other go-getter magic
+// parameters stay in the key, since they change the extracted tree.
+func (r *ChecksumResolver) Probe(_ context.Context, rawURL string) (string, error) {
+	return cas.OpaqueKey(r.scheme, stripQueryParams(rawURL, "checksum"), "checksum:"+r.checksum), nil
+}
@@ -0,0 +1,53 @@
+package getter_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/cas"
+	"github.com/gruntwork-io/terragrunt/internal/getter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksumResolver(t *testing.T) {
+	t.Parallel()
+
+	const src = "https://example.com/mod.tar.gz"
+
+	r, ok := getter.NewChecksumResolver("https", "SHA256:ABCDEF")
+	require.True(t, ok)
+	assert.Equal(t, "https", r.Scheme())
+
+	key, err := r.Probe(t.Context(), src+"?checksum=sha256:abcdef")
+	require.NoError(t, err)
+	assert.Equal(t, cas.OpaqueKey("https", src, "checksum:sha256:abcdef"), key,
+		"the key must not depend on checksum case or on the checksum parameter in the URL")
+
+	other, ok := getter.NewChecksumResolver("https", "sha256:012345")
+	require.True(t, ok)
+
+	otherKey, err := other.Probe(t.Context(), src)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey, "a different checksum must produce a different key")
+
+	extractedKey, err := r.Probe(t.Context(), src+"?archive=tar.gz&checksum=sha256:abcdef")
+	require.NoError(t, err)
+
+	rawKey, err := r.Probe(t.Context(), src+"?archive=false&checksum=sha256:abcdef")
+	require.NoError(t, err)
+	assert.NotEqual(t, key, rawKey, "the archive parameter changes the stored tree, so it must be part of the key")
+	assert.NotEqual(t, extractedKey, rawKey)
+
+	mirrorKey, err := r.Probe(t.Context(), "https://mirror.example.com/mod.tar.gz")
+	require.NoError(t, err)
+	assert.NotEqual(t, key, mirrorKey, "keys are URL-scoped")
+}
+
+func TestChecksumResolver_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	for _, checksum := range []string{"", "file:https://example.com/SHA256SUMS"} {
+		_, ok := getter.NewChecksumResolver("https", checksum)
+		assert.False(t, ok, "checksum %q", checksum)
+	}
+}
@@ -138,6 +138,12 @@ var httpMagicParams = []string{"archive", "checksum", "filename"}
 // Unparsable inputs are returned unchanged so the HEAD request
 // surfaces the same error a fetch would.
 func stripHTTPMagicParams(rawURL string) string {
+	return stripQueryParams(rawURL, httpMagicParams...)
+}
+
+// stripQueryParams returns rawURL with the query keys removed.
+// Unparsable inputs are returned unchanged.
+func stripQueryParams(rawURL string, keys ...string) string {
 	u, err := url.Parse(rawURL)
 	if err != nil {
 		return rawURL
@@ -147,7 +153,7 @@ func stripHTTPMagicParams(rawURL string) string {
 
 	changed := false
 
-	for _, k := range httpMagicParams {
+	for _, k := range keys {
 		if q.Has(k) {
 			q.Del(k)
 
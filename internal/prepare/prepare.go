@@ -20,7 +20,9 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/runner/run"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/amazonsts"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/azuread"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/externalcmd"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/googleiam"
 	"github.com/gruntwork-io/terragrunt/internal/runner/runcfg"
 	"github.com/gruntwork-io/terragrunt/internal/telemetry"
 	"github.com/gruntwork-io/terragrunt/internal/util"
@@ -86,6 +88,12 @@ func PrepareSource(
 	// Only overwrite when the config actually defines error rules;
 	// otherwise preserve the built-in default retryable errors.
 	if errConfig != nil {
+		// Explain-only rules keep the built-in default retryable errors.
+		if !errConfig.HasRecoveryRules() && opts.Errors != nil {
+			errConfig.Retry = opts.Errors.Retry
+			errConfig.Ignore = opts.Errors.Ignore
+		}
+
 		opts.Errors = errConfig
 	}
 
@@ -120,7 +128,10 @@ func PrepareSource(
 
 	if err = opts.RunWithErrorHandling(ctx, l, r, func() error {
 		return credsGetter.ObtainAndUpdateEnvIfNecessary(
-			ctx, l, v.Exec, opts.Env, amazonsts.NewProvider(l, opts.IAMRoleOptions, opts.Env),
+			ctx, l, v.Exec, opts.Env,
+			amazonsts.NewProvider(l, opts.IAMRoleOptions, opts.Env),
+			googleiam.NewProvider(l, runCfg.GetGCPCredentialsOptions(), googleiam.WithFS(v.FS)),
+			azuread.NewProvider(l, runCfg.GetAzureCredentialsOptions(), opts.Env, azuread.WithFS(v.FS)),
 		)
 	}); err != nil {
 		return nil, err
@@ -0,0 +1,26 @@
+package providercache
+
+import "fmt"
+
+// MissingServerTokenError is returned when a shared cache server is used without the token to authenticate to it.
+type MissingServerTokenError struct {
+	URL string
+}
+
+func (err MissingServerTokenError) Error() string {
+	return fmt.Sprintf("the token of the provider cache server %s is required: set --provider-cache-token to the token the server was started with", err.URL)
+}
+
+// ServerRequestError is returned when the providers cached by a shared cache server can't be retrieved.
+type ServerRequestError struct {
+	Err error
+	URL string
+}
+
+func (err ServerRequestError) Error() string {
+	return fmt.Sprintf("failed to retrieve cached providers from the provider cache server %s: %v", err.URL, err.Err)
+}
+
+func (err ServerRequestError) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,191 @@
+package providercache_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gruntwork-io/terragrunt/internal/providercache"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/handlers"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/services"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cliconfig"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+// TestModuleCacheServesCachedArchive checks that module downloads requested through the cache server are
+// fetched from the upstream source once and then served from the local disk as checksummed archives.
+func TestModuleCacheServesCachedArchive(t *testing.T) {
+	t.Parallel()
+
+	const realUserToken = "real-user-token"
+
+	var (
+		downloadHits atomic.Int32
+		archiveHits  atomic.Int32
+	)
+
+	moduleArchive := newTarGz(t, map[string]string{
+		"main.tf":             "# root",
+		"modules/vpc/main.tf": "# vpc",
+	})
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/modules/acme/vpc/aws/1.0.0/download":
+			downloadHits.Add(1)
+
+			if r.Header.Get("Authorization") != "Bearer "+realUserToken {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			w.Header().Set("X-Terraform-Get", "/archives/vpc.tar.gz//modules/vpc")
+			w.WriteHeader(http.StatusNoContent)
+		case "/archives/vpc.tar.gz":
+			archiveHits.Add(1)
+
+			if _, err := w.Write(moduleArchive); err != nil {
+				t.Errorf("upstream write failed: %v", err)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(upstream.Close)
+
+	registryName := strings.TrimPrefix(upstream.URL, "http://")
+
+	cliCfg := &cliconfig.Config{
+		Credentials: []cliconfig.ConfigCredentials{
+			{Name: "127.0.0.1", Token: realUserToken},
+		},
+	}
+	credsSource := cliCfg.CredentialsSource()
+	discoverer := &fakeDiscoverer{modulesV1: upstream.URL + "/v1/modules/"}
+
+	cacheToken := fmt.Sprintf("%s:%s", providercache.APIKeyAuth, uuid.New().String())
+
+	l := logger.CreateLogger()
+	providerService := services.NewProviderService(helpers.TmpDirWOSymlinks(t), helpers.TmpDirWOSymlinks(t), nil, l)
+	moduleService := services.NewModuleService(helpers.TmpDirWOSymlinks(t), l)
+
+	server := cache.NewServer(
+		cache.WithToken(cacheToken),
+		cache.WithProviderService(providerService),
+		cache.WithModuleService(moduleService),
+		cache.WithProxyProviderHandler(handlers.NewProxyProviderHandler(l, credsSource)),
+		cache.WithProxyModuleHandler(handlers.NewProxyModuleHandler(l, credsSource, discoverer, []string{registryName})),
+		cache.WithLogger(l),
+	)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	ln, err := server.Listen(ctx)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		if err := ln.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			t.Errorf("listener close failed: %v", err)
+		}
+	})
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error { return server.Run(gctx, ln) })
+
+	moduleURL := server.ModuleController.URL()
+	moduleURL.Path += "/" + registryName + "/acme/vpc/aws/1.0.0/download"
+
+	var (
+		locations        []string
+		firstArchiveHits int32
+	)
+
+	for i := range 2 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, moduleURL.String(), nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+cacheToken)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		locations = append(locations, resp.Header.Get("X-Terraform-Get"))
+
+		if i == 0 {
+			firstArchiveHits = archiveHits.Load()
+		}
+	}
+
+	assert.Equal(t, int32(1), downloadHits.Load(), "upstream download endpoint should have been hit exactly once")
+	assert.Positive(t, firstArchiveHits, "upstream archive should have been downloaded")
+	assert.Equal(t, firstArchiveHits, archiveHits.Load(), "cached module must not be downloaded again")
+	assert.Equal(t, locations[0], locations[1])
+
+	archiveURL, subdir, found := strings.Cut(locations[0], ".tar.gz//")
+	require.True(t, found, "unexpected location %q", locations[0])
+	assert.Equal(t, "modules/vpc", subdir)
+
+	archiveURL += ".tar.gz"
+
+	// The archive is content-addressed and downloaded without the cache server token, like provider archives.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL, nil)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	sum := sha256.Sum256(body)
+	assert.Equal(t, hex.EncodeToString(sum[:])+".tar.gz", path.Base(archiveURL))
+
+	cancel()
+	require.NoError(t, g.Wait())
+}
+
+func newTarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for name, content := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+
+		_, err := tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	return buf.Bytes()
+}
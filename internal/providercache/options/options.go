@@ -14,10 +14,20 @@ var DefaultRegistryNames = []string{
 // ProviderCacheOptions holds provider-cache-specific configuration that was
 // previously spread across several fields on TerragruntOptions.
 type ProviderCacheOptions struct {
-	Dir           string
-	Hostname      string
-	Token         string
+	Dir      string
+	Hostname string
+	Token    string
+	// ServerURL is the URL of a shared provider cache server started with
+	// `terragrunt provider-cache serve`. When set, runs use that server
+	// instead of starting their own.
+	ServerURL     string
 	RegistryNames []string
-	Port          int
-	Enabled       bool
+	// MaxSize is the maximum total size, in bytes, of the cached providers,
+	// zero means no limit. Only used by `terragrunt provider-cache serve`.
+	MaxSize int64
+	Port    int
+	Enabled bool
+	// Metrics enables the `/metrics` endpoint of the server. Only used by
+	// `terragrunt provider-cache serve`.
+	Metrics bool
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -88,6 +89,9 @@ type ProviderCache struct {
 	cliCfg          *cliconfig.Config
 	providerService *services.ProviderService
 	fs              vfs.FS
+
+	// serverURL is the URL of the shared cache server used instead of the own server, if any.
+	serverURL *url.URL
 }
 
 // NewProviderCache creates a new ProviderCache with sensible defaults.
@@ -132,6 +136,20 @@ func (pc *ProviderCache) Init(l log.Logger, pcOpts *pcoptions.ProviderCacheOptio
 
 	pcOpts.Dir = filepath.Clean(pcOpts.Dir)
 
+	if pcOpts.ServerURL != "" {
+		serverURL, err := url.Parse(pcOpts.ServerURL)
+		if err != nil {
+			return fmt.Errorf("invalid provider cache server URL %q: %w", pcOpts.ServerURL, err)
+		}
+
+		// The token of the shared server can't be generated, it must be the one the server was started with.
+		if pcOpts.Token == "" {
+			return MissingServerTokenError{URL: serverURL.Redacted()}
+		}
+
+		pc.serverURL = serverURL
+	}
+
 	if pcOpts.Token == "" {
 		pcOpts.Token = uuid.New().String()
 	}
@@ -151,9 +169,20 @@ func (pc *ProviderCache) Init(l log.Logger, pcOpts *pcoptions.ProviderCacheOptio
 		return err
 	}
 
-	providerService := services.NewProviderService(pcOpts.Dir, userProviderDir, cliCfg.CredentialsSource(), l, services.WithFS(pc.FS()))
+	providerService := services.NewProviderService(
+		pcOpts.Dir,
+		userProviderDir,
+		cliCfg.CredentialsSource(),
+		l,
+		services.WithFS(pc.FS()),
+		services.WithMaxCacheSize(pcOpts.MaxSize),
+	)
 	proxyProviderHandler := handlers.NewProxyProviderHandler(l, cliCfg.CredentialsSource())
 
+	// Module archives are kept next to the providers, in the `modules` directory.
+	moduleService := services.NewModuleService(filepath.Join(filepath.Dir(pcOpts.Dir), "modules"), l,
+		services.WithModuleFS(pc.FS()))
+
 	// Custom hosts need handlers, but must not pollute pcOpts.RegistryNames — FilterRegistriesByImplementation
 	// relies on that slice containing only the standard registries to detect impl-based filtering.
 	// See: https://github.com/gruntwork-io/terragrunt/issues/5916
@@ -175,10 +204,12 @@ func (pc *ProviderCache) Init(l log.Logger, pcOpts *pcoptions.ProviderCacheOptio
 		cache.WithPort(pcOpts.Port),
 		cache.WithToken(pcOpts.Token),
 		cache.WithProviderService(providerService),
+		cache.WithModuleService(moduleService),
 		cache.WithProviderHandlers(providerHandlers...),
 		cache.WithProxyProviderHandler(proxyProviderHandler),
 		cache.WithProxyModuleHandler(proxyModuleHandler),
 		cache.WithCacheProviderHTTPStatusCode(CacheProviderHTTPStatusCode),
+		cache.WithMetrics(pcOpts.Metrics),
 		cache.WithLogger(l),
 	)
 
@@ -241,7 +272,12 @@ func (pc *ProviderCache) TerraformCommandHook(
 
 	env := pc.providerCacheEnvironment(tfOpts.ShellOptions.Env, tfOpts.TofuImplementation, cliConfigFilename)
 
-	if output, err := pc.warmUpCache(ctx, l, tfOpts, cliConfigFilename, args, env, lockfileExists); err != nil {
+	cacheRequestID := uuid.New().String()
+
+	// The providers must not be evicted by a shared cache server until the target command installed them.
+	defer pc.releaseCacheRequest(ctx, l, cacheRequestID)
+
+	if output, err := pc.warmUpCache(ctx, l, tfOpts, cliConfigFilename, cacheRequestID, args, env, lockfileExists); err != nil {
 		return output, err
 	}
 
@@ -257,14 +293,12 @@ func (pc *ProviderCache) warmUpCache(
 	l log.Logger,
 	tfOpts *tf.TFOptions,
 	cliConfigFilename string,
+	cacheRequestID string,
 	args clihelper.Args,
 	env map[string]string,
 	lockfileExists bool,
 ) (*util.CmdOutput, error) {
-	var (
-		cacheRequestID = uuid.New().String()
-		commandsArgs   = convertToMultipleCommandsByPlatforms(args)
-	)
+	commandsArgs := convertToMultipleCommandsByPlatforms(args)
 
 	// Create terraform cli config file that enables provider caching and does not use provider cache dir
 	if err := pc.createLocalCLIConfig(ctx, tfOpts.TofuImplementation, cliConfigFilename, cacheRequestID); err != nil {
@@ -282,7 +316,7 @@ func (pc *ProviderCache) warmUpCache(
 		}
 	}
 
-	caches, err := pc.providerService.WaitForCacheReady(cacheRequestID)
+	caches, err := pc.waitForCacheReady(ctx, l, cacheRequestID)
 	if err != nil {
 		return nil, err
 	}
@@ -306,15 +340,24 @@ func (pc *ProviderCache) warmUpCache(
 	}
 
 	for _, provider := range caches {
-		if providerCache, ok := provider.(*services.ProviderCache); ok {
-			providerAddr := provider.Address()
-			if constraint, exists := providerConstraints[providerAddr]; exists {
-				providerCache.Provider.OriginalConstraints = constraint
-				l.Debugf("Applied constraint %s to provider %s", constraint, providerAddr)
-			} else {
-				l.Debugf("No constraint found for provider %s", providerAddr)
-			}
+		providerAddr := provider.Address()
+
+		constraint, exists := providerConstraints[providerAddr]
+		if !exists {
+			l.Debugf("No constraint found for provider %s", providerAddr)
+			continue
 		}
+
+		switch provider := provider.(type) {
+		case *services.ProviderCache:
+			provider.Provider.OriginalConstraints = constraint
+		case *remoteProvider:
+			provider.constraints = constraint
+		default:
+			continue
+		}
+
+		l.Debugf("Applied constraint %s to provider %s", constraint, providerAddr)
 	}
 
 	err = getproviders.UpdateLockfile(ctx, tfOpts.ShellOptions.WorkingDir, caches)
@@ -437,14 +480,14 @@ func (pc *ProviderCache) configureRegistryHosts(
 		}
 
 		hostServices := map[string]string{
-			serviceProvidersV1: fmt.Sprintf("%s/%s/%s/", pc.ProviderController.URL(), cacheRequestID, registryName),
+			serviceProvidersV1: fmt.Sprintf("%s/%s/%s/", pc.providersURL(), cacheRequestID, registryName),
 		}
 
 		if hasModules {
 			// Route modules through the cache server so it can swap the cache
 			// server's API key (which TF_TOKEN_<host> is forced to) back out for
 			// the user's real upstream credentials before forwarding upstream.
-			hostServices[serviceModulesV1] = fmt.Sprintf("%s/%s/", pc.ModuleController.URL(), registryName)
+			hostServices[serviceModulesV1] = fmt.Sprintf("%s/%s/", pc.modulesURL(), registryName)
 		}
 
 		cfg.AddHost(registryName, hostServices)
@@ -240,3 +240,111 @@ func TestProviderCacheWithProviderCacheDir(t *testing.T) {
 		require.NotNil(t, server, "Init should return a valid server when using VFS")
 	})
 }
+
+func TestProviderCacheSharedServerEndpoints(t *testing.T) {
+	t.Parallel()
+
+	token := fmt.Sprintf("%s:%s", providercache.APIKeyAuth, uuid.New().String())
+	l := logger.CreateLogger()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	errGroup, ctx := errgroup.WithContext(ctx)
+
+	server := cache.NewServer(
+		cache.WithToken(token),
+		cache.WithMetrics(true),
+		cache.WithProviderService(services.NewProviderService(helpers.TmpDirWOSymlinks(t), helpers.TmpDirWOSymlinks(t), nil, l,
+			services.WithMaxCacheSize(1<<20))),
+		cache.WithProxyProviderHandler(handlers.NewProxyProviderHandler(l, nil)),
+	)
+
+	ln, err := server.Listen(t.Context())
+	require.NoError(t, err)
+
+	defer ln.Close()
+
+	errGroup.Go(func() error {
+		return server.Run(ctx, ln)
+	})
+
+	testCases := []struct {
+		expectedBodyReg    *regexp.Regexp
+		method             string
+		urlPath            string
+		token              string
+		expectedStatusCode int
+	}{
+		{
+			method:             http.MethodGet,
+			urlPath:            "/metrics",
+			expectedStatusCode: http.StatusOK,
+			expectedBodyReg: regexp.MustCompile(`(?m)^# TYPE terragrunt_provider_cache_hits_total counter\n` +
+				`terragrunt_provider_cache_hits_total 0$` +
+				`[\s\S]*^terragrunt_provider_cache_max_size_bytes 1048576$`),
+		},
+		{
+			method:             http.MethodGet,
+			urlPath:            "/v1/cache/" + uuid.New().String(),
+			token:              "invalid-token",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			method:             http.MethodGet,
+			urlPath:            "/v1/cache/" + uuid.New().String(),
+			token:              token,
+			expectedStatusCode: http.StatusOK,
+			expectedBodyReg:    regexp.MustCompile(regexp.QuoteMeta(`{"providers":[]}`)),
+		},
+		{
+			method:             http.MethodDelete,
+			urlPath:            "/v1/cache/" + uuid.New().String(),
+			token:              token,
+			expectedStatusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range testCases {
+		serverURL := server.URL()
+		serverURL.Path = tc.urlPath
+
+		req, err := http.NewRequestWithContext(ctx, tc.method, serverURL.String(), nil)
+		require.NoError(t, err)
+
+		req.Header.Set("Authorization", "Bearer "+tc.token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		assert.Equal(t, tc.expectedStatusCode, resp.StatusCode, "%s %s", tc.method, tc.urlPath)
+
+		if tc.expectedBodyReg != nil {
+			assert.Regexp(t, tc.expectedBodyReg, string(body))
+		}
+	}
+
+	cancel()
+
+	require.NoError(t, errGroup.Wait())
+}
+
+func TestProviderCacheSharedServerRequiresToken(t *testing.T) {
+	t.Parallel()
+
+	err := providercache.NewProviderCache().WithFS(vfs.NewMemMapFS()).Init(
+		logger.CreateLogger(),
+		&pcoptions.ProviderCacheOptions{
+			Dir:       "/vfs/provider-cache",
+			ServerURL: "http://provider-cache.internal:5758",
+		},
+		"",
+	)
+
+	var target providercache.MissingServerTokenError
+	require.ErrorAs(t, err, &target)
+}
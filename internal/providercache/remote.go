@@ -0,0 +1,169 @@
+package providercache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/internal/tf/getproviders"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// cachePath is the path of the cache server endpoint returning the providers cached for a cache request.
+const cachePath = "v1/cache"
+
+// remoteProvider is a provider cached by a shared cache server.
+type remoteProvider struct {
+	logger      log.Logger
+	cached      *models.CachedProvider
+	packageDir  string
+	constraints string
+}
+
+func (provider *remoteProvider) Address() string {
+	return provider.cached.Address
+}
+
+func (provider *remoteProvider) Version() string {
+	return provider.cached.Version
+}
+
+func (provider *remoteProvider) Constraints() string {
+	return provider.constraints
+}
+
+func (provider *remoteProvider) DocumentSHA256Sums(_ context.Context) ([]byte, error) {
+	return provider.cached.DocumentSHA256Sums, nil
+}
+
+func (provider *remoteProvider) PackageDir() string {
+	return provider.packageDir
+}
+
+func (provider *remoteProvider) RegistryHashes() map[string][]getproviders.Hash {
+	if len(provider.cached.RegistryHashes) == 0 {
+		return nil
+	}
+
+	out := make(map[string][]getproviders.Hash, len(provider.cached.RegistryHashes))
+
+	for platform, hashes := range provider.cached.RegistryHashes {
+		for _, hash := range hashes {
+			out[platform] = append(out[platform], getproviders.Hash(hash))
+		}
+	}
+
+	return out
+}
+
+func (provider *remoteProvider) Logger() log.Logger {
+	return provider.logger
+}
+
+// providersURL returns the URL of the providers.v1 endpoint of the cache server.
+func (pc *ProviderCache) providersURL() *url.URL {
+	if pc.serverURL != nil {
+		return pc.serverURL.JoinPath(pc.ProviderController.URL().Path)
+	}
+
+	return pc.ProviderController.URL()
+}
+
+// modulesURL returns the URL of the modules.v1 endpoint of the cache server.
+func (pc *ProviderCache) modulesURL() *url.URL {
+	if pc.serverURL != nil {
+		return pc.serverURL.JoinPath(pc.ModuleController.URL().Path)
+	}
+
+	return pc.ModuleController.URL()
+}
+
+// IsRemote reports whether the provider cache uses a shared cache server instead of its own.
+func (pc *ProviderCache) IsRemote() bool {
+	return pc.serverURL != nil
+}
+
+// waitForCacheReady returns the providers cached for the given cache request, once all of them are processed.
+func (pc *ProviderCache) waitForCacheReady(ctx context.Context, l log.Logger, requestID string) ([]getproviders.Provider, error) {
+	if pc.serverURL == nil {
+		return pc.providerService.WaitForCacheReady(requestID)
+	}
+
+	reqURL := pc.serverURL.JoinPath(cachePath, requestID)
+
+	body, err := pc.doServerRequest(ctx, http.MethodGet, reqURL)
+	if err != nil {
+		return nil, ServerRequestError{URL: pc.serverURL.Redacted(), Err: err}
+	}
+
+	var resp models.CacheResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, ServerRequestError{URL: pc.serverURL.Redacted(), Err: err}
+	}
+
+	providers := make([]getproviders.Provider, 0, len(resp.Providers))
+
+	for _, cached := range resp.Providers {
+		providers = append(providers, &remoteProvider{
+			cached:     cached,
+			packageDir: filepath.Join(pc.opts.Dir, filepath.FromSlash(cached.PackagePath)),
+			logger:     l,
+		})
+	}
+
+	errs := make([]error, 0, len(resp.Errors))
+	for _, msg := range resp.Errors {
+		errs = append(errs, errors.New(msg))
+	}
+
+	return providers, errors.Join(errs...)
+}
+
+// releaseCacheRequest tells the shared cache server that the providers of the given cache request are installed, so
+// that they can be evicted. The request is released even if the providers could not be retrieved or installed.
+func (pc *ProviderCache) releaseCacheRequest(ctx context.Context, l log.Logger, requestID string) {
+	if pc.serverURL == nil {
+		return
+	}
+
+	reqURL := pc.serverURL.JoinPath(cachePath, requestID)
+
+	// The request must be released even if the run was cancelled, otherwise its providers are never evicted.
+	if _, err := pc.doServerRequest(context.WithoutCancel(ctx), http.MethodDelete, reqURL); err != nil {
+		l.Warnf("Failed to release cache request %s on %s: %v", requestID, pc.serverURL.Redacted(), err)
+	}
+}
+
+// doServerRequest sends an authenticated request to the shared cache server and returns the response body.
+func (pc *ProviderCache) doServerRequest(ctx context.Context, method string, reqURL *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+pc.opts.Token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode/100 != 2 { //nolint:mnd
+		return nil, fmt.Errorf("%s %s returned %s", method, reqURL.Path, resp.Status)
+	}
+
+	return body, nil
+}
@@ -44,6 +44,14 @@ type Entry struct {
 	// running, succeeded, or failed. Status is updated as dependencies
 	// are resolved and as execution progresses.
 	Status Status
+
+	// Weight is the number of concurrency slots this entry occupies while running.
+	// Entries with a weight of zero occupy a single slot.
+	Weight int
+
+	// ConcurrencyGroups are the names of the concurrency groups this entry belongs to.
+	// At most Queue.ConcurrencyGroupLimits[group] entries of a group run at once.
+	ConcurrencyGroups []string
 }
 
 // Status represents the lifecycle state of a task in the queue.
@@ -155,6 +163,15 @@ type Queue struct {
 	// IgnoreDependencyErrors, if set to true, allows scheduling and running entries even if their
 	// dependencies failed. Additionally, failures will not propagate EarlyExit to dependents/dependencies.
 	IgnoreDependencyErrors bool
+	// ConcurrencyGroupLimits maps the names of concurrency groups to the maximum number of their entries
+	// running at once. Groups without a limit are unlimited.
+	ConcurrencyGroupLimits map[string]int
+	// claimed maps the entries claimed with ClaimWithinLimits to the number of slots they occupy.
+	claimed map[*Entry]int
+	// runningWeight is the number of concurrency slots occupied by claimed entries.
+	runningWeight int
+	// runningGroups is the number of claimed entries per concurrency group.
+	runningGroups map[string]int
 }
 
 type Entries []*Entry
@@ -412,6 +429,10 @@ func (q *Queue) SetEntryStatus(e *Entry, status Status) {
 		return
 	}
 
+	if status != StatusRunning {
+		q.releaseUnsafe(e)
+	}
+
 	e.Status = status
 }
 
@@ -432,6 +453,106 @@ func (q *Queue) ClaimForRunning(e *Entry) bool {
 	return true
 }
 
+// ClaimWithinLimits is like ClaimForRunning, but only claims the entry if running it keeps the queue within
+// maxConcurrency slots and the limits of the entry's concurrency groups. Returns false without changing the
+// status when the entry is in a terminal state or doesn't fit, in which case it stays ready and can be claimed
+// once running entries complete. Slots are released when the entry leaves StatusRunning.
+//
+// An entry whose weight exceeds maxConcurrency is capped to it, so it runs alone rather than never.
+func (q *Queue) ClaimWithinLimits(e *Entry, maxConcurrency int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.claimUnsafe(e, maxConcurrency) == claimed
+}
+
+// ClaimReadyWithinLimits claims, in order, the given ready entries that fit within the limits, as ClaimWithinLimits
+// does, and returns the claimed ones. Once an entry doesn't fit in the free concurrency slots, none of the entries
+// after it are claimed, so that lighter entries can't keep taking the slots freed by completed entries and starve
+// a heavier entry that was ready before them. Entries held back only by a concurrency group limit don't hold back
+// the entries after them.
+func (q *Queue) ClaimReadyWithinLimits(entries []*Entry, maxConcurrency int) []*Entry {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var claimedEntries []*Entry
+
+	for _, e := range entries {
+		switch q.claimUnsafe(e, maxConcurrency) {
+		case claimed:
+			claimedEntries = append(claimedEntries, e)
+		case deferredBySlots:
+			return claimedEntries
+		case deferredByGroup, notClaimable:
+		}
+	}
+
+	return claimedEntries
+}
+
+// claimResult is the outcome of claiming an entry for running.
+type claimResult byte
+
+const (
+	claimed claimResult = iota
+	notClaimable
+	deferredBySlots
+	deferredByGroup
+)
+
+// claimUnsafe claims the entry if it fits within the limits.
+// Should only be called when the caller already holds a write lock.
+func (q *Queue) claimUnsafe(e *Entry, maxConcurrency int) claimResult {
+	if isTerminal(e.Status) {
+		return notClaimable
+	}
+
+	weight := min(max(e.Weight, 1), maxConcurrency)
+	if q.runningWeight > 0 && q.runningWeight+weight > maxConcurrency {
+		return deferredBySlots
+	}
+
+	for _, group := range e.ConcurrencyGroups {
+		limit, ok := q.ConcurrencyGroupLimits[group]
+		if ok && q.runningGroups[group] >= limit {
+			return deferredByGroup
+		}
+	}
+
+	if q.claimed == nil {
+		q.claimed = make(map[*Entry]int)
+		q.runningGroups = make(map[string]int)
+	}
+
+	q.claimed[e] = weight
+	q.runningWeight += weight
+
+	for _, group := range e.ConcurrencyGroups {
+		q.runningGroups[group]++
+	}
+
+	e.Status = StatusRunning
+
+	return claimed
+}
+
+// releaseUnsafe frees the concurrency slots of an entry claimed with ClaimWithinLimits.
+// Should only be called when the caller already holds a write lock.
+func (q *Queue) releaseUnsafe(e *Entry) {
+	weight, ok := q.claimed[e]
+	if !ok {
+		return
+	}
+
+	delete(q.claimed, e)
+
+	q.runningWeight -= weight
+
+	for _, group := range e.ConcurrencyGroups {
+		q.runningGroups[group]--
+	}
+}
+
 // FailEntry marks the entry as failed and updates related entries if needed.
 // For up commands, this marks entries that come after this one as early exit.
 // For destroy/down commands, this marks entries that come before this one as early exit.
@@ -440,6 +561,8 @@ func (q *Queue) FailEntry(e *Entry) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
+	q.releaseUnsafe(e)
+
 	e.Status = StatusFailed
 
 	// If this entry failed and has dependents/dependencies, we need to propagate the failure.
@@ -468,6 +591,40 @@ func (q *Queue) FailEntry(e *Entry) {
 	q.earlyExitDependencies(e)
 }
 
+// SkipEntry marks an entry that hasn't started running as early exit, so that it never runs, and propagates the
+// early exit like a failure of the entry would. Returns false without changing anything when the entry is already
+// running or in a terminal state.
+func (q *Queue) SkipEntry(e *Entry) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if isTerminalOrRunning(e.Status) {
+		return false
+	}
+
+	e.Status = StatusEarlyExit
+
+	if q.IgnoreDependencyErrors {
+		return true
+	}
+
+	if e.IsUp() {
+		q.earlyExitDependents(e)
+	} else {
+		q.earlyExitDependencies(e)
+	}
+
+	return true
+}
+
+// EntryStatus returns the status of the entry, synchronized with concurrent status changes.
+func (q *Queue) EntryStatus(e *Entry) Status {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	return e.Status
+}
+
 // earlyExitDependents - Recursively mark all entries that are dependent on this one as early exit.
 func (q *Queue) earlyExitDependents(e *Entry) {
 	for _, entry := range q.Entries {
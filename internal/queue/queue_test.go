@@ -1016,6 +1016,40 @@ func TestQueue_DestroyWithIgnoreDependencyErrors_AllowsProgressAfterFailure(t *t
 	assert.True(t, q.Finished(), "Queue should be finished")
 }
 
+func TestSkipEntry(t *testing.T) {
+	t.Parallel()
+	// Build a graph: A -> B -> C, A -> D
+	cfgA := component.NewUnit("A")
+	cfgB := component.NewUnit("B")
+	cfgB.AddDependency(cfgA)
+
+	cfgC := component.NewUnit("C")
+	cfgC.AddDependency(cfgB)
+
+	cfgD := component.NewUnit("D")
+	cfgD.AddDependency(cfgA)
+	configs := component.Components{cfgA, cfgB, cfgC, cfgD}
+
+	q, err := queue.NewQueue(configs)
+	require.NoError(t, err)
+
+	entryA := q.EntryByPath("A")
+	require.True(t, q.ClaimForRunning(entryA))
+
+	// A running entry can't be skipped
+	assert.False(t, q.SkipEntry(entryA))
+	assert.Equal(t, queue.StatusRunning, q.EntryStatus(entryA))
+
+	// Skipping B early exits its dependents only
+	assert.True(t, q.SkipEntry(q.EntryByPath("B")))
+	assert.Equal(t, queue.StatusEarlyExit, q.EntryStatus(q.EntryByPath("B")))
+	assert.Equal(t, queue.StatusEarlyExit, q.EntryStatus(q.EntryByPath("C")))
+	assert.NotEqual(t, queue.StatusEarlyExit, q.EntryStatus(q.EntryByPath("D")))
+
+	// An entry in a terminal state can't be skipped again
+	assert.False(t, q.SkipEntry(q.EntryByPath("C")))
+}
+
 func TestSetEntryStatus_TerminalGuard(t *testing.T) {
 	t.Parallel()
 
@@ -1120,3 +1154,156 @@ func TestQueueClaimForRunningRacesFailEntryWithRacing(t *testing.T) {
 		require.Equal(t, queue.StatusEarlyExit, entryB.Status, "iteration %d: claim false but B is %v", i, entryB.Status)
 	}
 }
+
+func TestQueue_ClaimWithinLimits(t *testing.T) {
+	t.Parallel()
+
+	entryByPath := func(q *queue.Queue, path string) *queue.Entry {
+		e := q.EntryByPath(path)
+		require.NotNil(t, e)
+
+		return e
+	}
+
+	t.Run("weights share the slots", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := queue.NewQueue(component.Components{
+			component.NewUnit("eks"),
+			component.NewUnit("vpc"),
+			component.NewUnit("dns"),
+		})
+		require.NoError(t, err)
+
+		eks, vpc, dns := entryByPath(q, "eks"), entryByPath(q, "vpc"), entryByPath(q, "dns")
+		eks.Weight = 3
+
+		assert.True(t, q.ClaimWithinLimits(eks, 4))
+		assert.True(t, q.ClaimWithinLimits(vpc, 4))
+		assert.False(t, q.ClaimWithinLimits(dns, 4))
+		assert.Equal(t, queue.StatusReady, dns.Status)
+
+		q.SetEntryStatus(eks, queue.StatusSucceeded)
+		assert.True(t, q.ClaimWithinLimits(dns, 4))
+	})
+
+	t.Run("weight above the limit runs alone", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := queue.NewQueue(component.Components{
+			component.NewUnit("eks"),
+			component.NewUnit("vpc"),
+		})
+		require.NoError(t, err)
+
+		eks, vpc := entryByPath(q, "eks"), entryByPath(q, "vpc")
+		eks.Weight = 10
+
+		assert.True(t, q.ClaimWithinLimits(eks, 2))
+		assert.False(t, q.ClaimWithinLimits(vpc, 2))
+
+		q.FailEntry(eks)
+		assert.True(t, q.ClaimWithinLimits(vpc, 2))
+	})
+
+	t.Run("group limits", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := queue.NewQueue(component.Components{
+			component.NewUnit("eks-a"),
+			component.NewUnit("eks-b"),
+			component.NewUnit("eks-c"),
+			component.NewUnit("vpc"),
+		})
+		require.NoError(t, err)
+
+		q.ConcurrencyGroupLimits = map[string]int{"aws-eks": 2}
+
+		eksA, eksB, eksC, vpc := entryByPath(q, "eks-a"), entryByPath(q, "eks-b"), entryByPath(q, "eks-c"), entryByPath(q, "vpc")
+		for _, e := range []*queue.Entry{eksA, eksB, eksC} {
+			e.ConcurrencyGroups = []string{"aws-eks"}
+		}
+
+		vpc.ConcurrencyGroups = []string{"undeclared"}
+
+		assert.True(t, q.ClaimWithinLimits(eksA, 10))
+		assert.True(t, q.ClaimWithinLimits(eksB, 10))
+		assert.False(t, q.ClaimWithinLimits(eksC, 10))
+		assert.True(t, q.ClaimWithinLimits(vpc, 10))
+
+		q.SetEntryStatus(eksB, queue.StatusSucceeded)
+		assert.True(t, q.ClaimWithinLimits(eksC, 10))
+	})
+
+	t.Run("terminal entries are not claimed", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := queue.NewQueue(component.Components{component.NewUnit("vpc")})
+		require.NoError(t, err)
+
+		vpc := entryByPath(q, "vpc")
+		vpc.Status = queue.StatusEarlyExit
+
+		assert.False(t, q.ClaimWithinLimits(vpc, 1))
+		assert.Equal(t, queue.StatusEarlyExit, vpc.Status)
+	})
+}
+
+func TestQueue_ClaimReadyWithinLimits(t *testing.T) {
+	t.Parallel()
+
+	entryByPath := func(q *queue.Queue, path string) *queue.Entry {
+		e := q.EntryByPath(path)
+		require.NotNil(t, e)
+
+		return e
+	}
+
+	t.Run("deferred heavy entry is not starved by lighter ones", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := queue.NewQueue(component.Components{
+			component.NewUnit("app-a"),
+			component.NewUnit("app-b"),
+			component.NewUnit("eks"),
+			component.NewUnit("vpc"),
+		})
+		require.NoError(t, err)
+
+		appA, appB, eks, vpc := entryByPath(q, "app-a"), entryByPath(q, "app-b"), entryByPath(q, "eks"), entryByPath(q, "vpc")
+		eks.Weight = 3
+
+		assert.Equal(t, []*queue.Entry{appA, appB}, q.ClaimReadyWithinLimits([]*queue.Entry{appA, appB}, 3))
+
+		// Once app-a completes there is a free slot, which vpc could take, but eks was ready before vpc.
+		q.SetEntryStatus(appA, queue.StatusSucceeded)
+		assert.Empty(t, q.ClaimReadyWithinLimits([]*queue.Entry{eks, vpc}, 3))
+		assert.Equal(t, queue.StatusReady, vpc.Status)
+
+		q.SetEntryStatus(appB, queue.StatusSucceeded)
+		assert.Equal(t, []*queue.Entry{eks}, q.ClaimReadyWithinLimits([]*queue.Entry{eks, vpc}, 3))
+
+		q.SetEntryStatus(eks, queue.StatusSucceeded)
+		assert.Equal(t, []*queue.Entry{vpc}, q.ClaimReadyWithinLimits([]*queue.Entry{vpc}, 3))
+	})
+
+	t.Run("group limits don't hold back later entries", func(t *testing.T) {
+		t.Parallel()
+
+		q, err := queue.NewQueue(component.Components{
+			component.NewUnit("eks-a"),
+			component.NewUnit("eks-b"),
+			component.NewUnit("vpc"),
+		})
+		require.NoError(t, err)
+
+		q.ConcurrencyGroupLimits = map[string]int{"aws-eks": 1}
+
+		eksA, eksB, vpc := entryByPath(q, "eks-a"), entryByPath(q, "eks-b"), entryByPath(q, "vpc")
+		eksA.ConcurrencyGroups = []string{"aws-eks"}
+		eksB.ConcurrencyGroups = []string{"aws-eks"}
+
+		assert.Equal(t, []*queue.Entry{eksA, vpc}, q.ClaimReadyWithinLimits([]*queue.Entry{eksA, eksB, vpc}, 3))
+		assert.Equal(t, queue.StatusReady, eksB.Status)
+	})
+}
@@ -4,12 +4,18 @@
 // This is a stub registration: it makes Terragrunt recognize backend = "azurerm"
 // and routes configuration through the common backend abstraction. Bootstrap,
 // delete, migrate and other lifecycle operations currently fall through to
-// CommonBackend defaults (no-op). Experiment gating and functional lifecycle
-// behavior for the Azure backend will be added in follow-up PRs.
+// CommonBackend defaults (no-op). The package also provides a minimal Blob
+// service client, used to read dependency outputs directly from state and to
+// inspect and release state locks.
+// Experiment gating and functional lifecycle behavior for the Azure backend
+// will be added in follow-up PRs.
 package azurerm
 
 import (
+	"context"
+
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
 )
 
 const BackendName = "azurerm"
@@ -25,3 +31,33 @@ func NewBackend() *Backend {
 		CommonBackend: backend.NewCommonBackend(BackendName),
 	}
 }
+
+// Locks returns the state lock held as a lease on the state blob.
+func (backend *Backend) Locks(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]*backend.Lock, error) {
+	azurermCfg, err := Config(backendConfig).AzurermConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(azurermCfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetStateLocks(ctx, l)
+}
+
+// ForceUnlock releases the lease held on the state blob with the given lock ID.
+func (backend *Backend) ForceUnlock(ctx context.Context, l log.Logger, backendConfig backend.Config, lockID string, opts *backend.Options) error {
+	azurermCfg, err := Config(backendConfig).AzurermConfig()
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(azurermCfg, opts)
+	if err != nil {
+		return err
+	}
+
+	return client.ForceUnlockState(ctx, l, lockID)
+}
@@ -0,0 +1,314 @@
+package azurerm
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+)
+
+const (
+	// storageAPIVersion is the Blob service REST API version sent with every request.
+	storageAPIVersion = "2021-08-06"
+
+	storageScope = "https://storage.azure.com/.default"
+
+	maxErrorBodySize = 4096
+)
+
+// Client talks to the Azure Blob service REST API of a single storage account.
+type Client struct {
+	*RemoteStateConfigAzurerm
+
+	httpClient *http.Client
+	credential azcore.TokenCredential
+	endpoint   string
+	accessKey  string
+	sasToken   string
+}
+
+// ClientOption configures a Client.
+type ClientOption func(*Client)
+
+// WithEndpoint overrides the blob service endpoint, e.g. to point the client at a local stand-in.
+func WithEndpoint(endpoint string) ClientOption {
+	return func(client *Client) {
+		client.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used to talk to the blob service.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(client *Client) {
+		client.httpClient = httpClient
+	}
+}
+
+// WithTokenCredential sets the Azure AD credential used when neither an access key nor a SAS token is configured.
+func WithTokenCredential(credential azcore.TokenCredential) ClientOption {
+	return func(client *Client) {
+		client.credential = credential
+	}
+}
+
+// NewClient inits azurerm client. Credentials are resolved the same way as the azurerm backend does:
+// `access_key`, then `sas_token`, then `ARM_ACCESS_KEY`/`ARM_SAS_TOKEN` from env, and finally Azure AD.
+func NewClient(config *RemoteStateConfigAzurerm, opts *backend.Options, clientOpts ...ClientOption) (*Client, error) {
+	client := &Client{
+		RemoteStateConfigAzurerm: config,
+		httpClient:               http.DefaultClient,
+		accessKey:                config.AccessKey,
+		sasToken:                 strings.TrimPrefix(config.SASToken, "?"),
+	}
+
+	useAzureAD := config.UseAzureADAuth
+	if !useAzureAD {
+		useAzureAD, _ = strconv.ParseBool(opts.Env[envUseAzureAD])
+	}
+
+	if client.accessKey == "" && client.sasToken == "" && !useAzureAD {
+		client.accessKey = opts.Env[envAccessKey]
+		client.sasToken = strings.TrimPrefix(opts.Env[envSASToken], "?")
+	}
+
+	for _, opt := range clientOpts {
+		opt(client)
+	}
+
+	if client.endpoint == "" {
+		endpoint, err := config.BlobEndpoint(opts.Env)
+		if err != nil {
+			return nil, err
+		}
+
+		client.endpoint = endpoint
+	}
+
+	if client.accessKey == "" && client.sasToken == "" && client.credential == nil {
+		credential, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating Azure credential: %w", err)
+		}
+
+		client.credential = credential
+	}
+
+	return client, nil
+}
+
+// GetBlob returns the content of the given blob.
+func (client *Client) GetBlob(ctx context.Context, containerName, blobName string) ([]byte, error) {
+	resp, err := client.do(ctx, http.MethodGet, containerName, blobName, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, BlobNotFoundError{Container: containerName, Blob: blobName}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, unexpectedStatus(resp)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// do sends a signed request to the blob service for the given container and blob.
+func (client *Client) do(
+	ctx context.Context,
+	method, containerName, blobName string,
+	query url.Values,
+	header http.Header,
+	body []byte,
+) (*http.Response, error) {
+	resourcePath := "/" + containerName
+	if blobName != "" {
+		resourcePath += "/" + blobName
+	}
+
+	reqURL, err := url.Parse(client.endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Setting the decoded path and clearing RawPath makes the URL escape blob names containing
+	// spaces and other reserved characters, which the signature has to cover in the same form.
+	reqURL.Path += resourcePath
+	reqURL.RawPath = ""
+
+	if query == nil {
+		query = url.Values{}
+	}
+
+	reqURL.RawQuery = query.Encode()
+
+	if client.accessKey == "" && client.sasToken != "" {
+		reqURL.RawQuery = joinQuery(reqURL.RawQuery, client.sasToken)
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = strings.NewReader(string(body))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", storageAPIVersion)
+
+	if body != nil {
+		req.ContentLength = int64(len(body))
+	}
+
+	switch {
+	case client.accessKey != "":
+		signature, err := sharedKeySignature(req, client.StorageAccountName, client.accessKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Authorization", "SharedKey "+client.StorageAccountName+":"+signature)
+	case client.sasToken != "":
+		// The SAS token is already part of the query string.
+	case client.credential != nil:
+		token, err := client.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{storageScope}})
+		if err != nil {
+			return nil, fmt.Errorf("error obtaining Azure AD token for storage account %s: %w", client.StorageAccountName, err)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token.Token)
+	}
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", method, resourcePath, err)
+	}
+
+	return resp, nil
+}
+
+// sharedKeySignature signs the request using the Shared Key authorization scheme,
+// https://learn.microsoft.com/rest/api/storageservices/authorize-with-shared-key.
+func sharedKeySignature(req *http.Request, accountName, accessKey string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(accessKey)
+	if err != nil {
+		return "", fmt.Errorf("decoding azurerm access key: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(sharedKeyStringToSign(req, accountName)))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// sharedKeyStringToSign builds the string the Shared Key signature is computed over.
+func sharedKeyStringToSign(req *http.Request, accountName string) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	var msHeaders []string
+
+	for name := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") {
+			msHeaders = append(msHeaders, lower)
+		}
+	}
+
+	slices.Sort(msHeaders)
+
+	var canonicalHeaders strings.Builder
+
+	for _, name := range msHeaders {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n")
+	}
+
+	return strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is used instead.
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalHeaders.String() + canonicalizedResource(req.URL, accountName),
+	}, "\n")
+}
+
+// canonicalizedResource returns the account name followed by the escaped request path, as it is
+// sent on the wire, and the lowercased query parameters sorted by name.
+func canonicalizedResource(reqURL *url.URL, accountName string) string {
+	resource := "/" + accountName + reqURL.EscapedPath()
+	if reqURL.Path == "" {
+		resource += "/"
+	}
+
+	query := reqURL.Query()
+
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+
+	slices.SortFunc(names, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+
+	for _, name := range names {
+		values := slices.Clone(query[name])
+		slices.Sort(values)
+		resource += "\n" + strings.ToLower(name) + ":" + strings.Join(values, ",")
+	}
+
+	return resource
+}
+
+func joinQuery(query, extra string) string {
+	if query == "" {
+		return extra
+	}
+
+	return query + "&" + extra
+}
+
+func unexpectedStatus(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	return UnexpectedStatusError{
+		Method:     resp.Request.Method,
+		URL:        (&url.URL{Scheme: resp.Request.URL.Scheme, Host: resp.Request.URL.Host, Path: resp.Request.URL.Path}).String(),
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(body)),
+	}
+}
@@ -0,0 +1,105 @@
+package azurerm
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// devStoreAccountKey is the published well-known key of the Azure Storage emulator account.
+const devStoreAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+func TestSharedKeySignature(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                 string
+		method               string
+		url                  string
+		header               map[string]string
+		body                 string
+		expectedStringToSign string
+		expectedSignature    string
+	}{
+		{
+			// The Get Container Metadata example request of
+			// https://learn.microsoft.com/rest/api/storageservices/authorize-with-shared-key.
+			name:   "container-metadata",
+			method: http.MethodGet,
+			url:    "https://myaccount.blob.core.windows.net/mycontainer?restype=container&comp=metadata&timeout=20",
+			header: map[string]string{
+				"x-ms-date":    "Fri, 26 Jun 2015 23:39:12 GMT",
+				"x-ms-version": "2015-02-21",
+			},
+			expectedStringToSign: "GET\n\n\n\n\n\n\n\n\n\n\n\n" +
+				"x-ms-date:Fri, 26 Jun 2015 23:39:12 GMT\nx-ms-version:2015-02-21\n" +
+				"/myaccount/mycontainer\ncomp:metadata\nrestype:container\ntimeout:20",
+			expectedSignature: "1u9lui2jDxj0+fpbHjQ5m5NnastJRSYM+PSmfi8TXx4=",
+		},
+		{
+			name:   "escaped-blob-path",
+			method: http.MethodPut,
+			url:    "https://myaccount.blob.core.windows.net/mycontainer/env:dev/my%20state.tfstate",
+			header: map[string]string{
+				"Content-Type":   "application/json",
+				"x-ms-blob-type": "BlockBlob",
+				"x-ms-date":      "Fri, 26 Jun 2015 23:39:12 GMT",
+				"x-ms-version":   "2015-02-21",
+			},
+			body: "hello world",
+			expectedStringToSign: "PUT\n\n\n11\n\napplication/json\n\n\n\n\n\n\n" +
+				"x-ms-blob-type:BlockBlob\nx-ms-date:Fri, 26 Jun 2015 23:39:12 GMT\nx-ms-version:2015-02-21\n" +
+				"/myaccount/mycontainer/env:dev/my%20state.tfstate",
+			expectedSignature: "u8/Q44OtgYTMbuW+i9mWsR58hwvm/NWdoCMFuxYRr3g=",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(t.Context(), tc.method, tc.url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+
+			for name, value := range tc.header {
+				req.Header.Set(name, value)
+			}
+
+			assert.Equal(t, tc.expectedStringToSign, sharedKeyStringToSign(req, "myaccount"))
+
+			signature, err := sharedKeySignature(req, "myaccount", devStoreAccountKey)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSignature, signature)
+		})
+	}
+}
+
+func TestClientEscapesBlobPath(t *testing.T) {
+	t.Parallel()
+
+	var requestPath string
+
+	client := &Client{
+		RemoteStateConfigAzurerm: &RemoteStateConfigAzurerm{StorageAccountName: "myaccount"},
+		httpClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			requestPath = req.URL.EscapedPath()
+
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+		})},
+		endpoint:  "https://myaccount.blob.core.windows.net",
+		accessKey: devStoreAccountKey,
+	}
+
+	_, err := client.GetBlob(t.Context(), "mycontainer", "dir/my state.tfstate")
+	require.NoError(t, err)
+	assert.Equal(t, "/mycontainer/dir/my%20state.tfstate", requestPath)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
@@ -0,0 +1,28 @@
+package azurerm
+
+import (
+	"github.com/mitchellh/mapstructure"
+)
+
+type Config map[string]any
+
+// ParseAzurermConfig parses the given map into an azurerm config.
+func (cfg Config) ParseAzurermConfig() (*RemoteStateConfigAzurerm, error) {
+	var azurermConfig RemoteStateConfigAzurerm
+
+	if err := mapstructure.WeakDecode(cfg, &azurermConfig); err != nil {
+		return nil, err
+	}
+
+	return &azurermConfig, nil
+}
+
+// AzurermConfig parses the given map into an azurerm config and validates this config.
+func (cfg Config) AzurermConfig() (*RemoteStateConfigAzurerm, error) {
+	azurermCfg, err := cfg.ParseAzurermConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return azurermCfg, azurermCfg.Validate()
+}
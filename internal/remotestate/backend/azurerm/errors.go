@@ -0,0 +1,37 @@
+package azurerm
+
+import "fmt"
+
+type MissingRequiredAzurermRemoteStateConfig string
+
+func (configName MissingRequiredAzurermRemoteStateConfig) Error() string {
+	return "Missing required azurerm remote state configuration " + string(configName)
+}
+
+type UnsupportedAzurermEnvironment string
+
+func (environment UnsupportedAzurermEnvironment) Error() string {
+	return fmt.Sprintf("Unsupported azurerm environment %q, expected one of public, usgovernment or china", string(environment))
+}
+
+// BlobNotFoundError is returned when the requested blob does not exist in the storage container.
+type BlobNotFoundError struct {
+	Container string
+	Blob      string
+}
+
+func (err BlobNotFoundError) Error() string {
+	return fmt.Sprintf("blob %s does not exist in container %s", err.Blob, err.Container)
+}
+
+// UnexpectedStatusError is returned when the blob service responds with an unexpected HTTP status.
+type UnexpectedStatusError struct {
+	Method     string
+	URL        string
+	Body       string
+	StatusCode int
+}
+
+func (err UnexpectedStatusError) Error() string {
+	return fmt.Sprintf("%s %s: unexpected status %d: %s", err.Method, err.URL, err.StatusCode, err.Body)
+}
@@ -0,0 +1,137 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// lockInfoMetadataKey is the state blob metadata OpenTofu/Terraform stores the base64 encoded lock info in.
+	lockInfoMetadataKey = "terraformlockid"
+
+	metadataHeaderPrefix = "X-Ms-Meta-"
+
+	leaseStateLeased = "leased"
+)
+
+// GetStateLocks returns the lock held on the state blob, if any. The azurerm backend locks the state by leasing
+// the state blob, the lock ID being the lease ID. A lease without lock info is reported with an empty ID.
+func (client *Client) GetStateLocks(ctx context.Context, l log.Logger) ([]*backend.Lock, error) {
+	lock, _, err := client.getStateLock(ctx, l)
+	if err != nil || lock == nil {
+		return nil, err
+	}
+
+	return []*backend.Lock{lock}, nil
+}
+
+// ForceUnlockState releases the lease held on the state blob with the given lock ID and removes the lock info.
+// An empty lock ID breaks a lease held without lock info.
+func (client *Client) ForceUnlockState(ctx context.Context, l log.Logger, lockID string) error {
+	lock, header, err := client.getStateLock(ctx, l)
+	if err != nil {
+		return err
+	}
+
+	if lock == nil {
+		return backend.LockNotFoundError{Path: client.ContainerName + "/" + client.Key, ID: lockID}
+	}
+
+	if lock.ID != lockID {
+		return backend.LockIDMismatchError{Path: lock.Path, ExpectedID: lockID, ActualID: lock.ID}
+	}
+
+	if lockID == "" {
+		return client.leaseAction(ctx, http.Header{"X-Ms-Lease-Action": {"break"}, "X-Ms-Lease-Break-Period": {"0"}}, http.StatusAccepted)
+	}
+
+	// Keep any other metadata, the lease ID is required to update the metadata of a leased blob.
+	metadataHeader := http.Header{"X-Ms-Lease-Id": {lockID}}
+
+	for name, values := range header {
+		if strings.HasPrefix(name, metadataHeaderPrefix) && !strings.EqualFold(name, metadataHeaderPrefix+lockInfoMetadataKey) {
+			metadataHeader[name] = values
+		}
+	}
+
+	resp, err := client.do(ctx, http.MethodPut, client.ContainerName, client.Key, url.Values{"comp": {"metadata"}}, metadataHeader, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return unexpectedStatus(resp)
+	}
+
+	return client.leaseAction(ctx, http.Header{"X-Ms-Lease-Action": {"release"}, "X-Ms-Lease-Id": {lockID}}, http.StatusOK)
+}
+
+func (client *Client) getStateLock(ctx context.Context, l log.Logger) (*backend.Lock, http.Header, error) {
+	statePath := client.ContainerName + "/" + client.Key
+
+	l.Debugf("Reading lease of blob %s in storage account %s", statePath, client.StorageAccountName)
+
+	resp, err := client.do(ctx, http.MethodHead, client.ContainerName, client.Key, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, unexpectedStatus(resp)
+	}
+
+	if resp.Header.Get("X-Ms-Lease-State") != leaseStateLeased {
+		return nil, resp.Header, nil
+	}
+
+	encoded := resp.Header.Get(metadataHeaderPrefix + lockInfoMetadataKey)
+	if encoded == "" {
+		return &backend.Lock{Path: statePath, Info: "lease held without lock info"}, resp.Header, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding lock info of blob %s: %w", statePath, err)
+	}
+
+	lock, err := backend.ParseLockInfo(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("blob %s: %w", statePath, err)
+	}
+
+	if lock.Path == "" {
+		lock.Path = statePath
+	}
+
+	return lock, resp.Header, nil
+}
+
+func (client *Client) leaseAction(ctx context.Context, header http.Header, expectedStatus int) error {
+	resp, err := client.do(ctx, http.MethodPut, client.ContainerName, client.Key, url.Values{"comp": {"lease"}}, header, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != expectedStatus {
+		return unexpectedStatus(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package azurerm_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/azurerm"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLeasedBlob is a local stand-in for a state blob leased by OpenTofu/Terraform.
+type fakeLeasedBlob struct {
+	metadata map[string]string
+	leaseID  string
+	mu       sync.Mutex
+}
+
+func (blob *fakeLeasedBlob) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	blob.mu.Lock()
+	defer blob.mu.Unlock()
+
+	if r.URL.Path != "/tfstate/live/vpc.tfstate" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodHead:
+		if blob.leaseID != "" {
+			w.Header().Set("X-Ms-Lease-State", "leased")
+		} else {
+			w.Header().Set("X-Ms-Lease-State", "available")
+		}
+
+		for key, value := range blob.metadata {
+			w.Header().Set("X-Ms-Meta-"+key, value)
+		}
+	case r.URL.Query().Get("comp") == "metadata":
+		if r.Header.Get("X-Ms-Lease-Id") != blob.leaseID {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		blob.metadata = map[string]string{}
+
+		for key, values := range r.Header {
+			if name, ok := strings.CutPrefix(key, "X-Ms-Meta-"); ok {
+				blob.metadata[name] = values[0]
+			}
+		}
+	case r.URL.Query().Get("comp") == "lease":
+		switch r.Header.Get("X-Ms-Lease-Action") {
+		case "release":
+			if r.Header.Get("X-Ms-Lease-Id") != blob.leaseID {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+		case "break":
+			w.WriteHeader(http.StatusAccepted)
+		}
+
+		blob.leaseID = ""
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func newLockTestClient(t *testing.T, srv *httptest.Server) *azurerm.Client {
+	t.Helper()
+
+	cfg, err := azurerm.Config{
+		"storage_account_name": "tgstate",
+		"container_name":       "tfstate",
+		"key":                  "live/vpc.tfstate",
+		"sas_token":            "sig=abc",
+	}.AzurermConfig()
+	require.NoError(t, err)
+
+	client, err := azurerm.NewClient(cfg, &backend.Options{}, azurerm.WithEndpoint(srv.URL))
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestClient_ForceUnlockState(t *testing.T) {
+	t.Parallel()
+
+	lockInfo := `{"ID":"lease-1","Operation":"OperationTypeApply","Who":"ci@runner","Created":"2026-01-02T03:04:05Z"}`
+
+	blob := &fakeLeasedBlob{
+		leaseID: "lease-1",
+		metadata: map[string]string{
+			"Terraformlockid": base64.StdEncoding.EncodeToString([]byte(lockInfo)),
+			"Owner":           "platform",
+		},
+	}
+
+	srv := httptest.NewServer(blob)
+	t.Cleanup(srv.Close)
+
+	client := newLockTestClient(t, srv)
+	l := logger.CreateLogger()
+
+	locks, err := client.GetStateLocks(t.Context(), l)
+	require.NoError(t, err)
+	require.Len(t, locks, 1)
+	assert.Equal(t, "lease-1", locks[0].ID)
+	assert.Equal(t, "ci@runner", locks[0].Who)
+	assert.Equal(t, "OperationTypeApply", locks[0].Operation)
+	assert.Equal(t, "tfstate/live/vpc.tfstate", locks[0].Path)
+
+	err = client.ForceUnlockState(t.Context(), l, "lease-2")
+	require.ErrorAs(t, err, new(backend.LockIDMismatchError))
+
+	require.NoError(t, client.ForceUnlockState(t.Context(), l, "lease-1"))
+	assert.Empty(t, blob.leaseID)
+	assert.Equal(t, map[string]string{"Owner": "platform"}, blob.metadata)
+
+	locks, err = client.GetStateLocks(t.Context(), l)
+	require.NoError(t, err)
+	assert.Empty(t, locks)
+
+	err = client.ForceUnlockState(t.Context(), l, "lease-1")
+	require.ErrorAs(t, err, new(backend.LockNotFoundError))
+}
+
+func TestClient_ForceUnlockStateWithoutLockInfo(t *testing.T) {
+	t.Parallel()
+
+	blob := &fakeLeasedBlob{leaseID: "orphan"}
+
+	srv := httptest.NewServer(blob)
+	t.Cleanup(srv.Close)
+
+	client := newLockTestClient(t, srv)
+	l := logger.CreateLogger()
+
+	locks, err := client.GetStateLocks(t.Context(), l)
+	require.NoError(t, err)
+	require.Len(t, locks, 1)
+	assert.Empty(t, locks[0].ID)
+
+	require.NoError(t, client.ForceUnlockState(t.Context(), l, ""))
+	assert.Empty(t, blob.leaseID)
+}
@@ -0,0 +1,89 @@
+package azurerm
+
+import (
+	"strings"
+)
+
+const (
+	defaultEnvironment = "public"
+	defaultWorkspace   = "default"
+
+	// workspaceKeyPrefix separates the state key from the workspace name in the blob names of non-default workspaces.
+	workspaceKeyPrefix = "env:"
+
+	envAccessKey   = "ARM_ACCESS_KEY"
+	envSASToken    = "ARM_SAS_TOKEN"
+	envUseAzureAD  = "ARM_USE_AZUREAD"
+	envEnvironment = "ARM_ENVIRONMENT"
+)
+
+// blobEndpointSuffixes maps the azurerm backend `environment` values to the storage endpoint suffix of that cloud.
+var blobEndpointSuffixes = map[string]string{
+	"public":       "core.windows.net",
+	"usgovernment": "core.usgovcloudapi.net",
+	"china":        "core.chinacloudapi.cn",
+}
+
+// RemoteStateConfigAzurerm is a representation of the configuration
+// options available for azurerm remote state.
+type RemoteStateConfigAzurerm struct {
+	StorageAccountName string `mapstructure:"storage_account_name"`
+	ContainerName      string `mapstructure:"container_name"`
+	Key                string `mapstructure:"key"`
+	AccessKey          string `mapstructure:"access_key"`
+	SASToken           string `mapstructure:"sas_token"`
+	Environment        string `mapstructure:"environment"`
+	UseAzureADAuth     bool   `mapstructure:"use_azuread_auth"`
+}
+
+// Validate validates the configuration for azurerm remote state.
+func (cfg *RemoteStateConfigAzurerm) Validate() error {
+	if cfg.StorageAccountName == "" {
+		return MissingRequiredAzurermRemoteStateConfig("storage_account_name")
+	}
+
+	if cfg.ContainerName == "" {
+		return MissingRequiredAzurermRemoteStateConfig("container_name")
+	}
+
+	if cfg.Key == "" {
+		return MissingRequiredAzurermRemoteStateConfig("key")
+	}
+
+	return nil
+}
+
+// CacheKey returns a unique key for the given azurerm config that can be used to cache the initialization.
+func (cfg *RemoteStateConfigAzurerm) CacheKey() string {
+	return cfg.StorageAccountName + "/" + cfg.ContainerName
+}
+
+// StateBlobName returns the blob name OpenTofu/Terraform uses to store the state of the given workspace,
+// `<key>` for the default workspace and `<key>env:<workspace>` for any other. An empty workspace is the default one.
+func (cfg *RemoteStateConfigAzurerm) StateBlobName(workspace string) string {
+	if workspace == "" || workspace == defaultWorkspace {
+		return cfg.Key
+	}
+
+	return cfg.Key + workspaceKeyPrefix + workspace
+}
+
+// BlobEndpoint returns the blob service endpoint of the configured storage account,
+// falling back to `ARM_ENVIRONMENT` from the given env when `environment` is not set.
+func (cfg *RemoteStateConfigAzurerm) BlobEndpoint(env map[string]string) (string, error) {
+	environment := cfg.Environment
+	if environment == "" {
+		environment = env[envEnvironment]
+	}
+
+	if environment == "" {
+		environment = defaultEnvironment
+	}
+
+	suffix, ok := blobEndpointSuffixes[strings.ToLower(environment)]
+	if !ok {
+		return "", UnsupportedAzurermEnvironment(environment)
+	}
+
+	return "https://" + cfg.StorageAccountName + ".blob." + suffix, nil
+}
@@ -0,0 +1,34 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// ReadStateOutputs reads the state blob of the given workspace in the configured container and key
+// and returns its outputs encoded the same way `tofu output -json` does.
+func (client *Client) ReadStateOutputs(ctx context.Context, l log.Logger, workspace string) ([]byte, error) {
+	blobName := client.StateBlobName(workspace)
+	location := client.stateLocation(blobName)
+
+	l.Debugf("Fetching outputs directly from %s", location)
+
+	stateBody, err := client.GetBlob(ctx, client.ContainerName, blobName)
+	if err != nil {
+		return nil, fmt.Errorf("fetching dependency state from %s: %w", location, err)
+	}
+
+	jsonOutputs, err := backend.StateOutputsJSON(stateBody)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", location, err)
+	}
+
+	return jsonOutputs, nil
+}
+
+func (client *Client) stateLocation(blobName string) string {
+	return fmt.Sprintf("azurerm://%s/%s/%s", client.StorageAccountName, client.ContainerName, blobName)
+}
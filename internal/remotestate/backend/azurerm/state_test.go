@@ -0,0 +1,150 @@
+package azurerm_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/azurerm"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeBlobServer starts a local stand-in for the Azure Blob service serving the given blobs,
+// keyed by `/<container>/<blob>`, and rejecting requests that carry no credentials.
+func newFakeBlobServer(t *testing.T, blobs map[string]string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-ms-version") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "SharedKey tgstate:") && r.URL.Query().Get("sig") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		body, ok := blobs[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestClient_ReadStateOutputs(t *testing.T) {
+	t.Parallel()
+
+	srv := newFakeBlobServer(t, map[string]string{
+		"/tfstate/live/vpc.tfstate":         `{"version":4,"serial":2,"outputs":{"vnet_id":{"value":"vnet-1","type":"string"}}}`,
+		"/tfstate/live/vpc.tfstateenv:prod": `{"version":4,"serial":5,"outputs":{"vnet_id":{"value":"vnet-2","type":"string"}}}`,
+	})
+
+	testCases := []struct {
+		name      string
+		config    azurerm.Config
+		env       map[string]string
+		workspace string
+		expected  string
+		wantErr   error
+	}{
+		{
+			name: "access-key",
+			config: azurerm.Config{
+				"storage_account_name": "tgstate",
+				"container_name":       "tfstate",
+				"key":                  "live/vpc.tfstate",
+				"access_key":           base64.StdEncoding.EncodeToString([]byte("secret")),
+			},
+			expected: `{"vnet_id":{"value":"vnet-1","type":"string"}}`,
+		},
+		{
+			name: "workspace",
+			config: azurerm.Config{
+				"storage_account_name": "tgstate",
+				"container_name":       "tfstate",
+				"key":                  "live/vpc.tfstate",
+				"access_key":           base64.StdEncoding.EncodeToString([]byte("secret")),
+			},
+			workspace: "prod",
+			expected:  `{"vnet_id":{"value":"vnet-2","type":"string"}}`,
+		},
+		{
+			name: "sas-token-from-env",
+			config: azurerm.Config{
+				"storage_account_name": "tgstate",
+				"container_name":       "tfstate",
+				"key":                  "live/vpc.tfstate",
+			},
+			env:      map[string]string{"ARM_SAS_TOKEN": "?sv=2021-08-06&sig=abc"},
+			expected: `{"vnet_id":{"value":"vnet-1","type":"string"}}`,
+		},
+		{
+			name: "missing-blob",
+			config: azurerm.Config{
+				"storage_account_name": "tgstate",
+				"container_name":       "tfstate",
+				"key":                  "live/missing.tfstate",
+				"sas_token":            "sig=abc",
+			},
+			wantErr: azurerm.BlobNotFoundError{Container: "tfstate", Blob: "live/missing.tfstate"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg, err := tc.config.AzurermConfig()
+			require.NoError(t, err)
+
+			client, err := azurerm.NewClient(cfg, &backend.Options{Env: tc.env}, azurerm.WithEndpoint(srv.URL))
+			require.NoError(t, err)
+
+			actual, err := client.ReadStateOutputs(t.Context(), logger.CreateLogger(), tc.workspace)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestRemoteStateConfigAzurerm_BlobEndpoint(t *testing.T) {
+	t.Parallel()
+
+	cfg := &azurerm.RemoteStateConfigAzurerm{StorageAccountName: "tgstate"}
+
+	endpoint, err := cfg.BlobEndpoint(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://tgstate.blob.core.windows.net", endpoint)
+
+	endpoint, err = cfg.BlobEndpoint(map[string]string{"ARM_ENVIRONMENT": "usgovernment"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://tgstate.blob.core.usgovcloudapi.net", endpoint)
+
+	_, err = (&azurerm.RemoteStateConfigAzurerm{StorageAccountName: "tgstate", Environment: "mars"}).BlobEndpoint(nil)
+	require.Error(t, err)
+}
+
+func TestConfig_AzurermConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	_, err := azurerm.Config{"storage_account_name": "tgstate", "key": "terraform.tfstate"}.AzurermConfig()
+	require.EqualError(t, err, "Missing required azurerm remote state configuration container_name")
+}
@@ -10,9 +10,14 @@ import (
 )
 
 // Options contains the subset of configuration needed by backend operations.
+//
+// UnitDir is the directory of the unit configuration, set by the `backend` commands. Relative local paths of the
+// backend config, such as the `path` of the local backend, are resolved against it, or against WorkingDir when unset.
 type Options struct {
 	Writers                      writer.Writers
 	Env                          map[string]string
+	WorkingDir                   string
+	UnitDir                      string
 	IAMRoleOptions               iam.RoleOptions
 	NonInteractive               bool
 	FailIfBucketCreationRequired bool
@@ -31,6 +36,12 @@ func (backends Backends) Get(name string) Backend {
 	return nil
 }
 
+// StateReader is implemented by the backends that can read the state of a unit directly, without OpenTofu/Terraform.
+type StateReader interface {
+	// ReadState returns the raw state stored for the given config, or nil if there is none.
+	ReadState(ctx context.Context, l log.Logger, config Config, opts *Options) ([]byte, error)
+}
+
 type Backend interface {
 	// Names returns the backend name.
 	Name() string
@@ -44,6 +55,16 @@ type Backend interface {
 	// Bootstrap bootstraps the remote state.
 	Bootstrap(ctx context.Context, l log.Logger, config Config, opts *Options) error
 
+	// Audit checks, without changing anything, whether the remote state resources still match the config
+	// and returns the drift found.
+	Audit(ctx context.Context, l log.Logger, config Config, opts *Options) ([]Drift, error)
+
+	// Locks returns the state locks currently held in the backend.
+	Locks(ctx context.Context, l log.Logger, config Config, opts *Options) ([]*Lock, error)
+
+	// ForceUnlock releases the state lock with the given ID, without checking whether its holder is still running.
+	ForceUnlock(ctx context.Context, l log.Logger, config Config, lockID string, opts *Options) error
+
 	// Migrate determines where the remote state resources exist for source backend config and migrate them to dest backend config.
 	Migrate(ctx context.Context, l log.Logger, srcConfig, dstConfig Config, opts *Options) error
 
@@ -47,6 +47,23 @@ func (backend *CommonBackend) Bootstrap(ctx context.Context, l log.Logger, confi
 	return nil
 }
 
+// Audit implements `backends.Audit` interface.
+func (backend *CommonBackend) Audit(ctx context.Context, l log.Logger, config Config, opts *Options) ([]Drift, error) {
+	return nil, AuditNotSupportedError{BackendName: backend.Name()}
+}
+
+// Locks implements `backends.Locks` interface.
+func (backend *CommonBackend) Locks(ctx context.Context, l log.Logger, config Config, opts *Options) ([]*Lock, error) {
+	return nil, LocksNotSupportedError{BackendName: backend.Name()}
+}
+
+// ForceUnlock implements `backends.ForceUnlock` interface.
+func (backend *CommonBackend) ForceUnlock(ctx context.Context, l log.Logger, config Config, lockID string, opts *Options) error {
+	l.Warnf("ForceUnlock for %s backend not implemented.", backend.Name())
+
+	return nil
+}
+
 // Migrate implements `backends.Migrate` interface.
 func (backend *CommonBackend) Migrate(ctx context.Context, l log.Logger, srcConfig, dstConfig Config, opts *Options) error {
 	l.Warnf("Migrate for %s backend not implemented.", backend.Name())
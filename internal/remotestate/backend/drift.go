@@ -0,0 +1,36 @@
+package backend
+
+import "fmt"
+
+// Drift describes a remote state resource whose settings no longer match what bootstrap configured.
+type Drift struct {
+	// Resource is the bucket, table, directory or schema the drift was found on.
+	Resource string `json:"resource"`
+	// Setting is the name of the setting that drifted, e.g. `versioning`.
+	Setting string `json:"setting"`
+	// Message explains the drift in a human readable way.
+	Message string `json:"message"`
+}
+
+// NewDrift creates a new `Drift` instance.
+func NewDrift(resource, setting, format string, args ...any) Drift {
+	return Drift{
+		Resource: resource,
+		Setting:  setting,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// String implements `fmt.Stringer` interface.
+func (drift Drift) String() string {
+	return fmt.Sprintf("%s: %s: %s", drift.Resource, drift.Setting, drift.Message)
+}
+
+// AuditNotSupportedError is returned by the backends that can't be audited.
+type AuditNotSupportedError struct {
+	BackendName string
+}
+
+func (err AuditNotSupportedError) Error() string {
+	return fmt.Sprintf("audit is not supported for the %s backend", err.BackendName)
+}
@@ -24,3 +24,23 @@ func NewBucketDoesNotExistError(bucketName string) *BucketDoesNotExistError {
 func (err BucketDoesNotExistError) Error() string {
 	return fmt.Sprintf("S3 bucket %s does not exist", err.bucketName)
 }
+
+// StateLineageMismatchError is returned when the source and destination states are unrelated.
+type StateLineageMismatchError struct {
+	SrcLineage string
+	DstLineage string
+}
+
+func (err StateLineageMismatchError) Error() string {
+	return fmt.Sprintf("destination state has lineage %s, which differs from the source state lineage %s, refusing to overwrite an unrelated state", err.DstLineage, err.SrcLineage)
+}
+
+// StateSerialConflictError is returned when the destination holds a newer or equal revision of the source state.
+type StateSerialConflictError struct {
+	SrcSerial int
+	DstSerial int
+}
+
+func (err StateSerialConflictError) Error() string {
+	return fmt.Sprintf("destination state serial %d is not lower than the source state serial %d, refusing to overwrite a newer state", err.DstSerial, err.SrcSerial)
+}
@@ -14,7 +14,9 @@ import (
 const (
 	BackendName = "gcs"
 
-	defaultTfState = "default.tfstate"
+	defaultWorkspace = "default"
+	tfStateSuffix    = ".tfstate"
+	defaultTfState   = defaultWorkspace + tfStateSuffix
 )
 
 var _ backend.Backend = new(Backend)
@@ -136,6 +138,72 @@ func (backend *Backend) IsVersionControlEnabled(ctx context.Context, l log.Logge
 	return client.CheckIfGCSVersioningEnabled(ctx, l, bucketName)
 }
 
+// Audit checks that the GCS bucket specified in the given config still exists and, unless `skip_bucket_versioning`
+// is set, has versioning enabled, without changing it.
+func (backend *Backend) Audit(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]backend.Drift, error) {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var bucketName = extGCSCfg.RemoteStateConfigGCS.Bucket
+
+	client, err := NewClient(ctx, extGCSCfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	return client.AuditGCSBucket(ctx, l, bucketName)
+}
+
+// Locks returns the state lock held on the `<prefix>/default.tflock` object.
+func (backend *Backend) Locks(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]*backend.Lock, error) {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	return client.GetStateLocks(ctx, l)
+}
+
+// ForceUnlock deletes the lock object with the generation given as lock ID.
+func (backend *Backend) ForceUnlock(ctx context.Context, l log.Logger, backendConfig backend.Config, lockID string, opts *backend.Options) error {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg, opts)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	return client.ForceUnlockState(ctx, l, lockID)
+}
+
 func (backend *Backend) Migrate(ctx context.Context, l log.Logger, srcBackendConfig, dstBackendConfig backend.Config, opts *backend.Options) error {
 	srcExtGCSCfg, err := Config(srcBackendConfig).ExtendedGCSConfig()
 	if err != nil {
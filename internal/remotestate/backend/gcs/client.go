@@ -25,6 +25,12 @@ const (
 	gcpSleepBetweenRetries = 10 * time.Second
 )
 
+// Drift settings reported by the GCS backend audit.
+const (
+	DriftBucketMissing = "bucket"
+	DriftVersioning    = "versioning"
+)
+
 type Client struct {
 	*ExtendedRemoteStateConfigGCS
 	*storage.Client
@@ -115,6 +121,29 @@ func (client *Client) CheckIfGCSVersioningEnabled(ctx context.Context, l log.Log
 	return attrs.VersioningEnabled, nil
 }
 
+// AuditGCSBucket checks that the given bucket still exists and, unless `skip_bucket_versioning` is set,
+// has versioning enabled, and returns the drift found.
+func (client *Client) AuditGCSBucket(ctx context.Context, l log.Logger, bucketName string) ([]backend.Drift, error) {
+	if !client.DoesGCSBucketExist(ctx, bucketName) {
+		return []backend.Drift{backend.NewDrift(bucketName, DriftBucketMissing, "GCS bucket does not exist")}, nil
+	}
+
+	if client.SkipBucketVersioning {
+		return nil, nil
+	}
+
+	enabled, err := client.CheckIfGCSVersioningEnabled(ctx, l, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !enabled {
+		return []backend.Drift{backend.NewDrift(bucketName, DriftVersioning, "versioning is not enabled")}, nil
+	}
+
+	return nil, nil
+}
+
 // CreateGCSBucketWithVersioning creates the given GCS bucket and enables versioning for it.
 func (client *Client) CreateGCSBucketWithVersioning(ctx context.Context, l log.Logger, bucketName string) error {
 	if err := client.CreateGCSBucket(ctx, l, bucketName); err != nil {
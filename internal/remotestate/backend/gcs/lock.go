@@ -0,0 +1,85 @@
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+
+	"cloud.google.com/go/storage"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const defaultTfLock = "default.tflock"
+
+// LockObjectKey returns the object name OpenTofu/Terraform uses to lock the state of the default workspace,
+// `<prefix>/default.tflock`.
+func (cfg *RemoteStateConfigGCS) LockObjectKey() string {
+	return path.Join(cfg.Prefix, defaultTfLock)
+}
+
+// GetStateLocks returns the lock held on the state of the configured bucket and prefix, if any.
+// As with OpenTofu/Terraform, the lock ID is the generation of the lock object.
+func (client *Client) GetStateLocks(ctx context.Context, l log.Logger) ([]*backend.Lock, error) {
+	bucketName := client.RemoteStateConfigGCS.Bucket
+	key := client.RemoteStateConfigGCS.LockObjectKey()
+
+	l.Debugf("Reading lock gs://%s/%s", bucketName, key)
+
+	reader, err := client.Bucket(bucketName).Object(key).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("reading lock gs://%s/%s: %w", bucketName, key, err)
+	}
+
+	defer func() {
+		if err := reader.Close(); err != nil {
+			l.Warnf("Failed to close lock reader %v", err)
+		}
+	}()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading lock gs://%s/%s: %w", bucketName, key, err)
+	}
+
+	lock, err := backend.ParseLockInfo(data)
+	if err != nil {
+		return nil, fmt.Errorf("gs://%s/%s: %w", bucketName, key, err)
+	}
+
+	lock.ID = strconv.FormatInt(reader.Attrs.Generation, 10)
+
+	return []*backend.Lock{lock}, nil
+}
+
+// ForceUnlockState deletes the lock object, provided it still has the generation given as lock ID.
+func (client *Client) ForceUnlockState(ctx context.Context, l log.Logger, lockID string) error {
+	bucketName := client.RemoteStateConfigGCS.Bucket
+	key := client.RemoteStateConfigGCS.LockObjectKey()
+	lockPath := "gs://" + bucketName + "/" + key
+
+	generation, err := strconv.ParseInt(lockID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid lock ID %q for %s, expected the generation of the lock object", lockID, lockPath)
+	}
+
+	l.Debugf("Deleting lock %s with generation %d", lockPath, generation)
+
+	err = client.Bucket(bucketName).Object(key).If(storage.Conditions{GenerationMatch: generation}).Delete(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return backend.LockNotFoundError{Path: lockPath, ID: lockID}
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting lock %s: %w", lockPath, err)
+	}
+
+	return nil
+}
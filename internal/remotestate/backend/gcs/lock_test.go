@@ -0,0 +1,94 @@
+package gcs_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	gcsbackend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/gcs"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+// fakeLockObject is a local stand-in for the lock object OpenTofu/Terraform writes next to the state.
+type fakeLockObject struct {
+	body       string
+	generation string
+	mu         sync.Mutex
+}
+
+func (object *fakeLockObject) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	object.mu.Lock()
+	defer object.mu.Unlock()
+
+	if object.body == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("X-Goog-Generation", object.generation)
+		_, _ = w.Write([]byte(object.body))
+	case http.MethodDelete:
+		if !strings.HasSuffix(r.URL.Path, "/b/tg-state/o/live/vpc/default.tflock") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Query().Get("ifGenerationMatch") != object.generation {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		object.body = ""
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestClient_ForceUnlockState(t *testing.T) {
+	t.Parallel()
+
+	object := &fakeLockObject{
+		body:       `{"ID":"f4b9c1d2","Operation":"OperationTypePlan","Who":"dev@laptop","Created":"2026-01-02T03:04:05Z","Path":"gs://tg-state/live/vpc/default.tflock"}`,
+		generation: "1767323045000000",
+	}
+
+	srv := httptest.NewServer(object)
+	t.Cleanup(srv.Close)
+
+	storageClient, err := storage.NewClient(t.Context(), option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	client := &gcsbackend.Client{
+		ExtendedRemoteStateConfigGCS: &gcsbackend.ExtendedRemoteStateConfigGCS{
+			RemoteStateConfigGCS: gcsbackend.RemoteStateConfigGCS{Bucket: "tg-state", Prefix: "live/vpc"},
+		},
+		Client: storageClient,
+	}
+	l := logger.CreateLogger()
+
+	locks, err := client.GetStateLocks(t.Context(), l)
+	require.NoError(t, err)
+	require.Len(t, locks, 1)
+	assert.Equal(t, "1767323045000000", locks[0].ID)
+	assert.Equal(t, "dev@laptop", locks[0].Who)
+
+	require.Error(t, client.ForceUnlockState(t.Context(), l, "42"))
+
+	require.NoError(t, client.ForceUnlockState(t.Context(), l, "1767323045000000"))
+
+	locks, err = client.GetStateLocks(t.Context(), l)
+	require.NoError(t, err)
+	assert.Empty(t, locks)
+
+	err = client.ForceUnlockState(t.Context(), l, "1767323045000000")
+	require.ErrorAs(t, err, new(backend.LockNotFoundError))
+}
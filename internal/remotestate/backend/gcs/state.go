@@ -0,0 +1,92 @@
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"cloud.google.com/go/storage"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// StateObjectKey returns the object name OpenTofu/Terraform uses to store the state of the given workspace,
+// `<prefix>/<workspace>.tfstate`. An empty workspace is the default one.
+func (cfg *RemoteStateConfigGCS) StateObjectKey(workspace string) string {
+	if workspace == "" || workspace == defaultWorkspace {
+		return path.Join(cfg.Prefix, defaultTfState)
+	}
+
+	return path.Join(cfg.Prefix, workspace+tfStateSuffix)
+}
+
+// ReadState returns the default workspace state stored in the configured bucket and prefix, or nil if there is none.
+func (backend *Backend) ReadState(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]byte, error) {
+	extGCSCfg, err := Config(backendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, extGCSCfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := client.Close(); err != nil {
+			l.Warnf("Error closing GCS client: %v", err)
+		}
+	}()
+
+	bucketName := extGCSCfg.RemoteStateConfigGCS.Bucket
+	key := extGCSCfg.RemoteStateConfigGCS.StateObjectKey("")
+
+	l.Debugf("Reading state from gs://%s/%s", bucketName, key)
+
+	reader, err := client.Bucket(bucketName).Object(key).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) || errors.Is(err, storage.ErrBucketNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading state from gs://%s/%s: %w", bucketName, key, err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	return io.ReadAll(reader)
+}
+
+// ReadStateOutputs reads the state object of the given workspace in the configured bucket and prefix
+// and returns its outputs encoded the same way `tofu output -json` does.
+func (client *Client) ReadStateOutputs(ctx context.Context, l log.Logger, workspace string) ([]byte, error) {
+	bucketName := client.RemoteStateConfigGCS.Bucket
+	key := client.RemoteStateConfigGCS.StateObjectKey(workspace)
+
+	l.Debugf("Fetching outputs directly from gs://%s/%s", bucketName, key)
+
+	reader, err := client.Bucket(bucketName).Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching dependency state from gs://%s/%s: %w", bucketName, key, err)
+	}
+
+	defer func() {
+		if err := reader.Close(); err != nil {
+			l.Warnf("Failed to close remote state reader %v", err)
+		}
+	}()
+
+	stateBody, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading dependency state body from gs://%s/%s: %w", bucketName, key, err)
+	}
+
+	jsonOutputs, err := backend.StateOutputsJSON(stateBody)
+	if err != nil {
+		return nil, fmt.Errorf("gs://%s/%s: %w", bucketName, key, err)
+	}
+
+	return jsonOutputs, nil
+}
@@ -0,0 +1,85 @@
+package gcs_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	gcsbackend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/gcs"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+// newFakeGCSClient returns a GCS client that talks to a local fake object store serving the given objects,
+// keyed by `/<bucket>/<object>`.
+func newFakeGCSClient(t *testing.T, cfg *gcsbackend.ExtendedRemoteStateConfigGCS, objects map[string]string) *gcsbackend.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	storageClient, err := storage.NewClient(t.Context(), option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	return &gcsbackend.Client{ExtendedRemoteStateConfigGCS: cfg, Client: storageClient}
+}
+
+func TestRemoteStateConfigGCS_StateObjectKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "default.tfstate", (&gcsbackend.RemoteStateConfigGCS{}).StateObjectKey(""))
+	assert.Equal(t, "live/vpc/default.tfstate", (&gcsbackend.RemoteStateConfigGCS{Prefix: "live/vpc"}).StateObjectKey(""))
+	assert.Equal(t, "live/vpc/default.tfstate", (&gcsbackend.RemoteStateConfigGCS{Prefix: "live/vpc"}).StateObjectKey("default"))
+	assert.Equal(t, "live/vpc/staging.tfstate", (&gcsbackend.RemoteStateConfigGCS{Prefix: "live/vpc"}).StateObjectKey("staging"))
+}
+
+func TestClient_ReadStateOutputs(t *testing.T) {
+	t.Parallel()
+
+	cfg := &gcsbackend.ExtendedRemoteStateConfigGCS{
+		RemoteStateConfigGCS: gcsbackend.RemoteStateConfigGCS{
+			Bucket: "tg-state",
+			Prefix: "live/vpc",
+		},
+	}
+
+	client := newFakeGCSClient(t, cfg, map[string]string{
+		"/tg-state/live/vpc/default.tfstate": `{"version":4,"serial":7,"outputs":{"vpc_id":{"value":"vpc-123","type":"string"}}}`,
+		"/tg-state/live/vpc/staging.tfstate": `{"version":4,"serial":3,"outputs":{"vpc_id":{"value":"vpc-456","type":"string"}}}`,
+	})
+
+	actual, err := client.ReadStateOutputs(t.Context(), logger.CreateLogger(), "")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"vpc_id":{"value":"vpc-123","type":"string"}}`, string(actual))
+
+	actual, err = client.ReadStateOutputs(t.Context(), logger.CreateLogger(), "staging")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"vpc_id":{"value":"vpc-456","type":"string"}}`, string(actual))
+}
+
+func TestClient_ReadStateOutputsMissingObject(t *testing.T) {
+	t.Parallel()
+
+	cfg := &gcsbackend.ExtendedRemoteStateConfigGCS{
+		RemoteStateConfigGCS: gcsbackend.RemoteStateConfigGCS{
+			Bucket: "tg-state",
+			Prefix: "missing",
+		},
+	}
+
+	client := newFakeGCSClient(t, cfg, map[string]string{})
+
+	_, err := client.ReadStateOutputs(t.Context(), logger.CreateLogger(), "")
+	require.ErrorIs(t, err, storage.ErrObjectNotExist)
+}
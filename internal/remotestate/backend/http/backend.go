@@ -0,0 +1,123 @@
+// Package http represents the http backend for interacting with remote state.
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/shell"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const BackendName = "http"
+
+var _ backend.Backend = new(Backend)
+
+type Backend struct {
+	*backend.CommonBackend
+}
+
+func NewBackend() *Backend {
+	return &Backend{
+		CommonBackend: backend.NewCommonBackend(BackendName),
+	}
+}
+
+// NeedsBootstrap always returns false, the http backend has no resources Terragrunt can create.
+func (backend *Backend) NeedsBootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) (bool, error) {
+	return false, nil
+}
+
+// Bootstrap validates the config. The state endpoint itself is managed outside Terragrunt,
+// so there is nothing to create.
+func (backend *Backend) Bootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) error {
+	if _, err := Config(backendConfig).ParseHTTPConfig(opts.Env); err != nil {
+		return err
+	}
+
+	l.Debugf("Nothing to bootstrap for the %s backend", BackendName)
+
+	return nil
+}
+
+// Migrate copies the state from the source address to the destination address and then removes it from the source.
+func (backend *Backend) Migrate(ctx context.Context, l log.Logger, srcBackendConfig, dstBackendConfig backend.Config, opts *backend.Options) error {
+	srcHTTPCfg, err := Config(srcBackendConfig).ParseHTTPConfig(opts.Env)
+	if err != nil {
+		return err
+	}
+
+	dstHTTPCfg, err := Config(dstBackendConfig).ParseHTTPConfig(opts.Env)
+	if err != nil {
+		return err
+	}
+
+	var (
+		srcClient = NewClient(srcHTTPCfg)
+		dstClient = NewClient(dstHTTPCfg)
+	)
+
+	state, err := srcClient.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state == nil {
+		l.Debugf("Remote state at %s does not exist, nothing to migrate", redactAddress(srcHTTPCfg.Address))
+		return nil
+	}
+
+	dstState, err := dstClient.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMigrationDestination(dstHTTPCfg.Address, state, dstState); err != nil {
+		return err
+	}
+
+	l.Debugf("Moving remote state from %s to %s", redactAddress(srcHTTPCfg.Address), redactAddress(dstHTTPCfg.Address))
+
+	if err := dstClient.PutState(ctx, state); err != nil {
+		return err
+	}
+
+	return srcClient.DeleteState(ctx)
+}
+
+// ReadState returns the state stored at the configured address, or nil if there is none.
+func (backend *Backend) ReadState(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]byte, error) {
+	httpCfg, err := Config(backendConfig).ParseHTTPConfig(opts.Env)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(httpCfg).GetState(ctx)
+}
+
+// Delete deletes the remote state stored at the configured address.
+func (backend *Backend) Delete(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) error {
+	httpCfg, err := Config(backendConfig).ParseHTTPConfig(opts.Env)
+	if err != nil {
+		return err
+	}
+
+	prompt := fmt.Sprintf("Remote state at %s will be deleted. Do you want to continue?", redactAddress(httpCfg.Address))
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.NonInteractive, opts.Writers.ErrWriter); err != nil {
+		return err
+	} else if yes {
+		return NewClient(httpCfg).DeleteState(ctx)
+	}
+
+	return nil
+}
+
+// checkMigrationDestination refuses to overwrite an unrelated or newer state stored at the destination address.
+func checkMigrationDestination(address string, srcState, dstState []byte) error {
+	if err := backend.CheckStateOverwrite(srcState, dstState); err != nil {
+		return fmt.Errorf("remote state at %s: %w", redactAddress(address), err)
+	}
+
+	return nil
+}
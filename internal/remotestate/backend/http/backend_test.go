@@ -0,0 +1,127 @@
+package http_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	httpbackend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/http"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStateServer is a local stand-in for an http state endpoint, storing one state per URL path.
+type fakeStateServer struct {
+	*httptest.Server
+	states map[string]string
+	mu     sync.Mutex
+}
+
+func newFakeStateServer(t *testing.T, states map[string]string) *fakeStateServer {
+	t.Helper()
+
+	srv := &fakeStateServer{states: states}
+	srv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+
+		if username, password, _ := r.BasicAuth(); username != "tg" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			state, ok := srv.states[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_, _ = w.Write([]byte(state))
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			srv.states[r.URL.Path] = string(body)
+		case http.MethodDelete:
+			delete(srv.states, r.URL.Path)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func (srv *fakeStateServer) state(path string) (string, bool) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	state, ok := srv.states[path]
+
+	return state, ok
+}
+
+func TestBackend_Migrate(t *testing.T) {
+	t.Parallel()
+
+	srv := newFakeStateServer(t, map[string]string{
+		"/state/old":       `{"version":4,"lineage":"a","serial":3,"outputs":{"id":{"value":"x","type":"string"}}}`,
+		"/state/unrelated": `{"version":4,"lineage":"b","serial":1,"outputs":{"id":{"value":"y","type":"string"}}}`,
+		"/state/outdated":  `{"version":4,"lineage":"a","serial":2,"outputs":{"id":{"value":"z","type":"string"}}}`,
+	})
+
+	opts := &backend.Options{Env: map[string]string{"TF_HTTP_USERNAME": "tg", "TF_HTTP_PASSWORD": "secret"}}
+	l := logger.CreateLogger()
+
+	httpBackend := httpbackend.NewBackend()
+
+	err := httpBackend.Migrate(t.Context(), l,
+		backend.Config{"address": srv.URL + "/state/old"},
+		backend.Config{"address": srv.URL + "/state/unrelated"},
+		opts)
+	require.ErrorAs(t, err, new(backend.StateLineageMismatchError))
+
+	_, ok := srv.state("/state/old")
+	assert.True(t, ok)
+
+	err = httpBackend.Migrate(t.Context(), l,
+		backend.Config{"address": srv.URL + "/state/old"},
+		backend.Config{"address": srv.URL + "/state/outdated"},
+		opts)
+	require.NoError(t, err)
+
+	_, ok = srv.state("/state/old")
+	assert.False(t, ok)
+
+	state, ok := srv.state("/state/outdated")
+	assert.True(t, ok)
+	assert.JSONEq(t, `{"version":4,"lineage":"a","serial":3,"outputs":{"id":{"value":"x","type":"string"}}}`, state)
+}
+
+func TestBackend_Delete(t *testing.T) {
+	t.Parallel()
+
+	srv := newFakeStateServer(t, map[string]string{
+		"/state/vpc": `{"version":4}`,
+	})
+
+	opts := &backend.Options{NonInteractive: true}
+	cfg := backend.Config{"address": srv.URL + "/state/vpc", "username": "tg", "password": "secret"}
+
+	require.NoError(t, httpbackend.NewBackend().Delete(t.Context(), logger.CreateLogger(), cfg, opts))
+
+	_, ok := srv.state("/state/vpc")
+	assert.False(t, ok)
+}
+
+func TestBackend_BootstrapRequiresAddress(t *testing.T) {
+	t.Parallel()
+
+	err := httpbackend.NewBackend().Bootstrap(t.Context(), logger.CreateLogger(), backend.Config{}, &backend.Options{})
+	require.EqualError(t, err, "Missing required http remote state configuration address")
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Client talks to an http state endpoint the same way the OpenTofu/Terraform http backend does.
+type Client struct {
+	*RemoteStateConfigHTTP
+	httpClient *http.Client
+}
+
+// NewClient inits http client.
+func NewClient(config *RemoteStateConfigHTTP) *Client {
+	httpClient := http.DefaultClient
+
+	if config.SkipCertVerification {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+
+		httpClient = &http.Client{Transport: transport}
+	}
+
+	return &Client{
+		RemoteStateConfigHTTP: config,
+		httpClient:            httpClient,
+	}
+}
+
+// GetState returns the state stored at the configured address, or nil if there is none.
+func (client *Client) GetState(ctx context.Context) ([]byte, error) {
+	resp, err := client.do(ctx, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		body, err := io.ReadAll(resp.Body)
+		if err != nil || len(body) == 0 {
+			return nil, err
+		}
+
+		return body, nil
+	case http.StatusNoContent, http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, client.unexpectedStatus(http.MethodGet, resp)
+	}
+}
+
+// PutState stores the given state at the configured address using the configured update method.
+func (client *Client) PutState(ctx context.Context, state []byte) error {
+	resp, err := client.do(ctx, client.UpdateMethod, state)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return nil
+	default:
+		return client.unexpectedStatus(client.UpdateMethod, resp)
+	}
+}
+
+// DeleteState deletes the state stored at the configured address.
+func (client *Client) DeleteState(ctx context.Context) error {
+	resp, err := client.do(ctx, http.MethodDelete, nil)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return client.unexpectedStatus(http.MethodDelete, resp)
+	}
+}
+
+func (client *Client) do(ctx context.Context, method string, body []byte) (*http.Response, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, client.Address, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if client.Username != "" {
+		req.SetBasicAuth(client.Username, client.Password)
+	}
+
+	return client.httpClient.Do(req)
+}
+
+func (client *Client) unexpectedStatus(method string, resp *http.Response) error {
+	return UnexpectedStatusError{
+		Method:     method,
+		Address:    redactAddress(client.Address),
+		StatusCode: resp.StatusCode,
+	}
+}
+
+// redactAddress drops credentials and query parameters, which may carry tokens, from the given address.
+func redactAddress(address string) string {
+	parsed, err := url.Parse(address)
+	if err != nil {
+		return address
+	}
+
+	parsed.User = nil
+	parsed.RawQuery = ""
+
+	return parsed.String()
+}
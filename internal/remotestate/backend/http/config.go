@@ -0,0 +1,67 @@
+package http
+
+import (
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	defaultUpdateMethod = "POST"
+
+	envAddress      = "TF_HTTP_ADDRESS"
+	envUpdateMethod = "TF_HTTP_UPDATE_METHOD"
+	envUsername     = "TF_HTTP_USERNAME"
+	envPassword     = "TF_HTTP_PASSWORD"
+)
+
+type Config map[string]any
+
+// RemoteStateConfigHTTP is a representation of the configuration
+// options available for http remote state.
+type RemoteStateConfigHTTP struct {
+	Address              string `mapstructure:"address"`
+	UpdateMethod         string `mapstructure:"update_method"`
+	Username             string `mapstructure:"username"`
+	Password             string `mapstructure:"password"`
+	SkipCertVerification bool   `mapstructure:"skip_cert_verification"`
+}
+
+// ParseHTTPConfig parses the given map into an http config, falling back to the
+// `TF_HTTP_*` variables of the given env for the settings that are not set.
+func (cfg Config) ParseHTTPConfig(env map[string]string) (*RemoteStateConfigHTTP, error) {
+	var httpConfig RemoteStateConfigHTTP
+
+	if err := mapstructure.WeakDecode(cfg, &httpConfig); err != nil {
+		return nil, err
+	}
+
+	if httpConfig.Address == "" {
+		httpConfig.Address = env[envAddress]
+	}
+
+	if httpConfig.UpdateMethod == "" {
+		httpConfig.UpdateMethod = env[envUpdateMethod]
+	}
+
+	if httpConfig.UpdateMethod == "" {
+		httpConfig.UpdateMethod = defaultUpdateMethod
+	}
+
+	if httpConfig.Username == "" {
+		httpConfig.Username = env[envUsername]
+	}
+
+	if httpConfig.Password == "" {
+		httpConfig.Password = env[envPassword]
+	}
+
+	return &httpConfig, httpConfig.Validate()
+}
+
+// Validate validates the configuration for http remote state.
+func (cfg *RemoteStateConfigHTTP) Validate() error {
+	if cfg.Address == "" {
+		return MissingRequiredHTTPRemoteStateConfig("address")
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package http
+
+import "fmt"
+
+type MissingRequiredHTTPRemoteStateConfig string
+
+func (configName MissingRequiredHTTPRemoteStateConfig) Error() string {
+	return "Missing required http remote state configuration " + string(configName)
+}
+
+// UnexpectedStatusError is returned when the state endpoint responds with an unexpected HTTP status.
+type UnexpectedStatusError struct {
+	Method     string
+	Address    string
+	StatusCode int
+}
+
+func (err UnexpectedStatusError) Error() string {
+	return fmt.Sprintf("%s %s: unexpected status %d", err.Method, err.Address, err.StatusCode)
+}
@@ -0,0 +1,212 @@
+// Package local represents the local filesystem backend for interacting with remote state.
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/shell"
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	BackendName = "local"
+
+	stateDirPerm = 0o755
+
+	// DriftStateDirMissing is reported by the audit when the state directory does not exist.
+	DriftStateDirMissing = "state_directory"
+)
+
+var _ backend.Backend = new(Backend)
+
+type Backend struct {
+	*backend.CommonBackend
+}
+
+func NewBackend() *Backend {
+	return &Backend{
+		CommonBackend: backend.NewCommonBackend(BackendName),
+	}
+}
+
+// IsVersionControlEnabled always returns false, local state files are not versioned. Migrating a local state file
+// moves it, keeping a copy in the `.backup` file that OpenTofu/Terraform writes next to it, so `backend migrate`
+// requires --force, as it does for any unversioned state.
+func (backend *Backend) IsVersionControlEnabled(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) (bool, error) {
+	return false, nil
+}
+
+// NeedsBootstrap returns true if the directory of the configured state file does not exist.
+func (backend *Backend) NeedsBootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) (bool, error) {
+	localCfg, err := Config(backendConfig).ParseLocalConfig()
+	if err != nil {
+		return false, err
+	}
+
+	return !util.IsDir(filepath.Dir(localCfg.StatePath(unitDir(opts)))), nil
+}
+
+// Audit reports a drift if the directory of the configured state file no longer exists.
+func (backend *Backend) Audit(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]backend.Drift, error) {
+	localCfg, err := Config(backendConfig).ParseLocalConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return auditStateDir(filepath.Dir(localCfg.StatePath(unitDir(opts)))), nil
+}
+
+// Bootstrap creates the directory of the configured state file if it doesn't already exist.
+func (backend *Backend) Bootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) error {
+	localCfg, err := Config(backendConfig).ParseLocalConfig()
+	if err != nil {
+		return err
+	}
+
+	stateDir := filepath.Dir(localCfg.StatePath(unitDir(opts)))
+
+	mu := backend.GetBucketMutex(stateDir)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if util.IsDir(stateDir) {
+		return nil
+	}
+
+	l.Debugf("Creating local state directory %s", stateDir)
+
+	if err := os.MkdirAll(stateDir, stateDirPerm); err != nil {
+		return fmt.Errorf("creating local state directory %s: %w", stateDir, err)
+	}
+
+	return nil
+}
+
+// Migrate moves the state file of the source config to the path of the destination config.
+func (backend *Backend) Migrate(ctx context.Context, l log.Logger, srcBackendConfig, dstBackendConfig backend.Config, opts *backend.Options) error {
+	srcLocalCfg, err := Config(srcBackendConfig).ParseLocalConfig()
+	if err != nil {
+		return err
+	}
+
+	dstLocalCfg, err := Config(dstBackendConfig).ParseLocalConfig()
+	if err != nil {
+		return err
+	}
+
+	var (
+		srcPath = srcLocalCfg.StatePath(unitDir(opts))
+		dstPath = dstLocalCfg.StatePath(unitDir(opts))
+	)
+
+	if !util.FileExists(srcPath) {
+		l.Debugf("Local state file %s does not exist, nothing to migrate", srcPath)
+		return nil
+	}
+
+	if util.FileExists(dstPath) {
+		return fmt.Errorf("destination local state file %s already exists", dstPath)
+	}
+
+	l.Debugf("Moving local state file from %s to %s", srcPath, dstPath)
+
+	if err := os.MkdirAll(filepath.Dir(dstPath), stateDirPerm); err != nil {
+		return fmt.Errorf("creating local state directory %s: %w", filepath.Dir(dstPath), err)
+	}
+
+	return os.Rename(srcPath, dstPath)
+}
+
+// ReadState returns the content of the configured state file, or nil if it does not exist.
+func (backend *Backend) ReadState(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]byte, error) {
+	localCfg, err := Config(backendConfig).ParseLocalConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := os.ReadFile(localCfg.StatePath(unitDir(opts)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	return state, err
+}
+
+// Delete deletes the state file specified in the given config.
+func (backend *Backend) Delete(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) error {
+	localCfg, err := Config(backendConfig).ParseLocalConfig()
+	if err != nil {
+		return err
+	}
+
+	statePath := localCfg.StatePath(unitDir(opts))
+
+	prompt := fmt.Sprintf("Local state file %s will be deleted. Do you want to continue?", statePath)
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.NonInteractive, opts.Writers.ErrWriter); err != nil {
+		return err
+	} else if !yes {
+		return nil
+	}
+
+	l.Debugf("Deleting local state file %s", statePath)
+
+	if err := os.Remove(statePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("deleting local state file %s: %w", statePath, err)
+	}
+
+	// OpenTofu/Terraform keeps the previous state next to the current one.
+	if err := os.Remove(statePath + ".backup"); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("deleting local state backup file %s.backup: %w", statePath, err)
+	}
+
+	return nil
+}
+
+// DeleteBucket deletes the workspace directory of the given config, the local counterpart of a bucket.
+func (backend *Backend) DeleteBucket(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) error {
+	localCfg, err := Config(backendConfig).ParseLocalConfig()
+	if err != nil {
+		return err
+	}
+
+	if localCfg.WorkspaceDir == "" {
+		l.Warnf("No workspace_dir configured for the %s backend, nothing to delete.", BackendName)
+		return nil
+	}
+
+	workspaceDir := localCfg.WorkspaceDirPath(unitDir(opts))
+
+	prompt := fmt.Sprintf("Local workspace directory %s will be completely deleted. Do you want to continue?", workspaceDir)
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.NonInteractive, opts.Writers.ErrWriter); err != nil {
+		return err
+	} else if yes {
+		return os.RemoveAll(workspaceDir)
+	}
+
+	return nil
+}
+
+// unitDir returns the directory that relative paths of the config are resolved against: the unit directory, or the
+// working directory when the unit directory is not known.
+func unitDir(opts *backend.Options) string {
+	if opts.UnitDir != "" {
+		return opts.UnitDir
+	}
+
+	return opts.WorkingDir
+}
+
+func auditStateDir(stateDir string) []backend.Drift {
+	if util.IsDir(stateDir) {
+		return nil
+	}
+
+	return []backend.Drift{backend.NewDrift(stateDir, DriftStateDirMissing, "local state directory does not exist")}
+}
@@ -0,0 +1,107 @@
+package local_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/local"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackend_Bootstrap(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	opts := &backend.Options{WorkingDir: workingDir}
+	cfg := backend.Config{"path": "state/live/vpc/terraform.tfstate"}
+	l := logger.CreateLogger()
+
+	localBackend := local.NewBackend()
+
+	needsBootstrap, err := localBackend.NeedsBootstrap(t.Context(), l, cfg, opts)
+	require.NoError(t, err)
+	assert.True(t, needsBootstrap)
+
+	require.NoError(t, localBackend.Bootstrap(t.Context(), l, cfg, opts))
+	assert.DirExists(t, filepath.Join(workingDir, "state", "live", "vpc"))
+
+	needsBootstrap, err = localBackend.NeedsBootstrap(t.Context(), l, cfg, opts)
+	require.NoError(t, err)
+	assert.False(t, needsBootstrap)
+}
+
+func TestBackend_Migrate(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	opts := &backend.Options{WorkingDir: workingDir}
+	l := logger.CreateLogger()
+
+	srcPath := filepath.Join(workingDir, "terraform.tfstate")
+	require.NoError(t, os.WriteFile(srcPath, []byte(`{"version":4}`), 0o644))
+
+	dstCfg := backend.Config{"path": filepath.Join(workingDir, "moved", "terraform.tfstate")}
+
+	require.NoError(t, local.NewBackend().Migrate(t.Context(), l, backend.Config{}, dstCfg, opts))
+	assert.NoFileExists(t, srcPath)
+	assert.FileExists(t, filepath.Join(workingDir, "moved", "terraform.tfstate"))
+
+	// Migrating onto an existing state file must fail rather than overwrite it.
+	require.NoError(t, os.WriteFile(srcPath, []byte(`{"version":4}`), 0o644))
+	require.Error(t, local.NewBackend().Migrate(t.Context(), l, backend.Config{}, dstCfg, opts))
+}
+
+func TestBackend_Delete(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	opts := &backend.Options{WorkingDir: workingDir, NonInteractive: true}
+
+	statePath := filepath.Join(workingDir, "terraform.tfstate")
+	require.NoError(t, os.WriteFile(statePath, []byte(`{"version":4}`), 0o644))
+	require.NoError(t, os.WriteFile(statePath+".backup", []byte(`{"version":4}`), 0o644))
+
+	require.NoError(t, local.NewBackend().Delete(t.Context(), logger.CreateLogger(), backend.Config{}, opts))
+	assert.NoFileExists(t, statePath)
+	assert.NoFileExists(t, statePath+".backup")
+}
+
+func TestBackend_Audit(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	opts := &backend.Options{WorkingDir: workingDir}
+	cfg := backend.Config{"path": "state/terraform.tfstate"}
+	l := logger.CreateLogger()
+
+	localBackend := local.NewBackend()
+
+	drifts, err := localBackend.Audit(t.Context(), l, cfg, opts)
+	require.NoError(t, err)
+	require.Len(t, drifts, 1)
+	assert.Equal(t, local.DriftStateDirMissing, drifts[0].Setting)
+	assert.Equal(t, filepath.Join(workingDir, "state"), drifts[0].Resource)
+
+	require.NoError(t, localBackend.Bootstrap(t.Context(), l, cfg, opts))
+
+	drifts, err = localBackend.Audit(t.Context(), l, cfg, opts)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+}
+
+func TestBackend_UnitDir(t *testing.T) {
+	t.Parallel()
+
+	unitDir := t.TempDir()
+	opts := &backend.Options{WorkingDir: filepath.Join(unitDir, ".terragrunt-cache", "abc"), UnitDir: unitDir}
+	cfg := backend.Config{"path": "state/terraform.tfstate"}
+	l := logger.CreateLogger()
+
+	require.NoError(t, local.NewBackend().Bootstrap(t.Context(), l, cfg, opts))
+	assert.DirExists(t, filepath.Join(unitDir, "state"))
+	assert.NoDirExists(t, filepath.Join(opts.WorkingDir, "state"))
+}
@@ -0,0 +1,54 @@
+package local
+
+import (
+	"path/filepath"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+const defaultStatePath = "terraform.tfstate"
+
+type Config map[string]any
+
+// RemoteStateConfigLocal is a representation of the configuration
+// options available for local remote state.
+type RemoteStateConfigLocal struct {
+	Path         string `mapstructure:"path"`
+	WorkspaceDir string `mapstructure:"workspace_dir"`
+}
+
+// ParseLocalConfig parses the given map into a local config.
+func (cfg Config) ParseLocalConfig() (*RemoteStateConfigLocal, error) {
+	var localConfig RemoteStateConfigLocal
+
+	if err := mapstructure.WeakDecode(cfg, &localConfig); err != nil {
+		return nil, err
+	}
+
+	return &localConfig, nil
+}
+
+// StatePath returns the absolute path of the state file, resolving a relative `path` against the given unit
+// directory.
+func (cfg *RemoteStateConfigLocal) StatePath(unitDir string) string {
+	statePath := cfg.Path
+	if statePath == "" {
+		statePath = defaultStatePath
+	}
+
+	if filepath.IsAbs(statePath) {
+		return filepath.Clean(statePath)
+	}
+
+	return filepath.Join(unitDir, statePath)
+}
+
+// WorkspaceDirPath returns the absolute path of the workspace directory, resolving a relative `workspace_dir` against
+// the given unit directory.
+func (cfg *RemoteStateConfigLocal) WorkspaceDirPath(unitDir string) string {
+	if filepath.IsAbs(cfg.WorkspaceDir) {
+		return filepath.Clean(cfg.WorkspaceDir)
+	}
+
+	return filepath.Join(unitDir, cfg.WorkspaceDir)
+}
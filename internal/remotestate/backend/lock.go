@@ -0,0 +1,81 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Lock describes a state lock held in a backend. The fields mirror the lock info OpenTofu/Terraform
+// stores alongside the lock, so that it can be decoded with `ParseLockInfo`.
+type Lock struct {
+	// Created is the time the lock was acquired.
+	Created time.Time `json:"Created"`
+	// ID is the lock ID, as expected by `force-unlock`.
+	ID string `json:"ID"`
+	// Operation is the OpenTofu/Terraform operation holding the lock, e.g. `OperationTypeApply`.
+	Operation string `json:"Operation"`
+	// Info is extra information about the lock.
+	Info string `json:"Info"`
+	// Who is the user and host holding the lock.
+	Who string `json:"Who"`
+	// Version is the OpenTofu/Terraform version of the lock holder.
+	Version string `json:"Version"`
+	// Path is the path of the locked state.
+	Path string `json:"Path"`
+}
+
+// ParseLockInfo decodes the lock info written by OpenTofu/Terraform.
+func ParseLockInfo(data []byte) (*Lock, error) {
+	lock := new(Lock)
+
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, fmt.Errorf("decoding lock info: %w", err)
+	}
+
+	return lock, nil
+}
+
+// Age returns how long the lock has been held at the given time.
+func (lock *Lock) Age(now time.Time) time.Duration {
+	if lock.Created.IsZero() {
+		return 0
+	}
+
+	return now.Sub(lock.Created)
+}
+
+// String implements `fmt.Stringer` interface.
+func (lock *Lock) String() string {
+	return fmt.Sprintf("%s held by %s for %s", lock.ID, lock.Who, lock.Operation)
+}
+
+// LockIDMismatchError is returned when the lock to release has been replaced by another one.
+type LockIDMismatchError struct {
+	Path       string
+	ExpectedID string
+	ActualID   string
+}
+
+func (err LockIDMismatchError) Error() string {
+	return fmt.Sprintf("lock %s of %s is now held with ID %s, refusing to release it", err.ExpectedID, err.Path, err.ActualID)
+}
+
+// LockNotFoundError is returned when the lock to release is no longer held.
+type LockNotFoundError struct {
+	Path string
+	ID   string
+}
+
+func (err LockNotFoundError) Error() string {
+	return fmt.Sprintf("lock %s of %s is not held", err.ID, err.Path)
+}
+
+// LocksNotSupportedError is returned by the backends whose state locks can't be listed.
+type LocksNotSupportedError struct {
+	BackendName string
+}
+
+func (err LocksNotSupportedError) Error() string {
+	return fmt.Sprintf("listing state locks is not supported for the %s backend", err.BackendName)
+}
@@ -0,0 +1,25 @@
+package backend_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLockInfo(t *testing.T) {
+	t.Parallel()
+
+	lock, err := backend.ParseLockInfo([]byte(`{"ID":"2f8e1c","Operation":"OperationTypeApply","Info":"","Who":"ci@runner","Version":"1.9.0","Created":"2026-01-02T03:04:05.000000Z","Path":"tg-state/live/vpc/terraform.tfstate"}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "2f8e1c", lock.ID)
+	assert.Equal(t, "ci@runner", lock.Who)
+	assert.Equal(t, "OperationTypeApply", lock.Operation)
+	assert.Equal(t, 2*time.Hour, lock.Age(time.Date(2026, 1, 2, 5, 4, 5, 0, time.UTC)))
+
+	_, err = backend.ParseLockInfo([]byte("not json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,264 @@
+// Package pg represents the PostgreSQL (pg) backend for interacting with remote state.
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/shell"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+
+	// Registers the `postgres` SQL driver.
+	_ "github.com/lib/pq"
+)
+
+const (
+	BackendName = "pg"
+
+	defaultDriverName = "postgres"
+)
+
+var _ backend.Backend = new(Backend)
+
+type Backend struct {
+	*backend.CommonBackend
+	driverName string
+}
+
+// Option configures a Backend.
+type Option func(*Backend)
+
+// WithDriverName overrides the SQL driver used to connect to the database, e.g. to use a local stand-in.
+func WithDriverName(driverName string) Option {
+	return func(backend *Backend) {
+		backend.driverName = driverName
+	}
+}
+
+func NewBackend(opts ...Option) *Backend {
+	backend := &Backend{
+		CommonBackend: backend.NewCommonBackend(BackendName),
+		driverName:    defaultDriverName,
+	}
+
+	for _, opt := range opts {
+		opt(backend)
+	}
+
+	return backend
+}
+
+// NeedsBootstrap returns true if the states table does not exist in the configured schema.
+func (backend *Backend) NeedsBootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) (bool, error) {
+	pgCfg, err := Config(backendConfig).ParsePGConfig(opts.Env)
+	if err != nil {
+		return false, err
+	}
+
+	if backend.IsConfigInited(pgCfg) {
+		l.Debugf("%s backend already inited", BackendName)
+		return false, nil
+	}
+
+	client, err := backend.newClient(l, pgCfg)
+	if err != nil {
+		return false, err
+	}
+	defer backend.closeClient(l, client)
+
+	exists, err := client.DoesStatesTableExist(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return !exists, nil
+}
+
+// Audit reports a drift if the states table no longer exists in the configured schema.
+func (backend *Backend) Audit(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]backend.Drift, error) {
+	pgCfg, err := Config(backendConfig).ParsePGConfig(opts.Env)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := backend.newClient(l, pgCfg)
+	if err != nil {
+		return nil, err
+	}
+	defer backend.closeClient(l, client)
+
+	return client.AuditStatesTable(ctx)
+}
+
+// Bootstrap creates the schema, the states table and its index if they don't already exist.
+func (backend *Backend) Bootstrap(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) error {
+	pgCfg, err := Config(backendConfig).ParsePGConfig(opts.Env)
+	if err != nil {
+		return err
+	}
+
+	mu := backend.GetBucketMutex(pgCfg.CacheKey())
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if backend.IsConfigInited(pgCfg) {
+		l.Debugf("%s backend already inited", BackendName)
+		return nil
+	}
+
+	client, err := backend.newClient(l, pgCfg)
+	if err != nil {
+		return err
+	}
+	defer backend.closeClient(l, client)
+
+	if err := client.CreateStatesTableIfNecessary(ctx, l); err != nil {
+		return err
+	}
+
+	backend.MarkConfigInited(pgCfg)
+
+	return nil
+}
+
+// Migrate copies the default workspace state from the source schema to the destination schema
+// and then removes it from the source.
+func (backend *Backend) Migrate(ctx context.Context, l log.Logger, srcBackendConfig, dstBackendConfig backend.Config, opts *backend.Options) error {
+	srcPGCfg, err := Config(srcBackendConfig).ParsePGConfig(opts.Env)
+	if err != nil {
+		return err
+	}
+
+	dstPGCfg, err := Config(dstBackendConfig).ParsePGConfig(opts.Env)
+	if err != nil {
+		return err
+	}
+
+	srcClient, err := backend.newClient(l, srcPGCfg)
+	if err != nil {
+		return err
+	}
+	defer backend.closeClient(l, srcClient)
+
+	dstClient, err := backend.newClient(l, dstPGCfg)
+	if err != nil {
+		return err
+	}
+	defer backend.closeClient(l, dstClient)
+
+	state, err := srcClient.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state == nil {
+		l.Debugf("Remote state in pg schema %s does not exist, nothing to migrate", srcPGCfg.SchemaName)
+		return nil
+	}
+
+	if err := dstClient.CreateStatesTableIfNecessary(ctx, l); err != nil {
+		return err
+	}
+
+	dstState, err := dstClient.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := checkMigrationDestination(dstPGCfg.SchemaName, state, dstState); err != nil {
+		return err
+	}
+
+	l.Debugf("Moving remote state from pg schema %s to %s", srcPGCfg.SchemaName, dstPGCfg.SchemaName)
+
+	if err := dstClient.PutState(ctx, state); err != nil {
+		return err
+	}
+
+	return srcClient.DeleteState(ctx)
+}
+
+// ReadState returns the default workspace state stored in the configured schema, or nil if there is none.
+func (backend *Backend) ReadState(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]byte, error) {
+	pgCfg, err := Config(backendConfig).ParsePGConfig(opts.Env)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := backend.newClient(l, pgCfg)
+	if err != nil {
+		return nil, err
+	}
+	defer backend.closeClient(l, client)
+
+	if exists, err := client.DoesStatesTableExist(ctx); err != nil || !exists {
+		return nil, err
+	}
+
+	return client.GetState(ctx)
+}
+
+// Delete deletes the default workspace state from the configured schema.
+func (backend *Backend) Delete(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) error {
+	pgCfg, err := Config(backendConfig).ParsePGConfig(opts.Env)
+	if err != nil {
+		return err
+	}
+
+	prompt := fmt.Sprintf("Remote state in pg schema %s will be deleted. Do you want to continue?", pgCfg.SchemaName)
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.NonInteractive, opts.Writers.ErrWriter); err != nil || !yes {
+		return err
+	}
+
+	client, err := backend.newClient(l, pgCfg)
+	if err != nil {
+		return err
+	}
+	defer backend.closeClient(l, client)
+
+	return client.DeleteState(ctx)
+}
+
+// DeleteBucket drops the states table of the configured schema, the pg counterpart of a bucket.
+func (backend *Backend) DeleteBucket(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) error {
+	pgCfg, err := Config(backendConfig).ParsePGConfig(opts.Env)
+	if err != nil {
+		return err
+	}
+
+	prompt := fmt.Sprintf("States table in pg schema %s will be completely deleted. Do you want to continue?", pgCfg.SchemaName)
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.NonInteractive, opts.Writers.ErrWriter); err != nil || !yes {
+		return err
+	}
+
+	client, err := backend.newClient(l, pgCfg)
+	if err != nil {
+		return err
+	}
+	defer backend.closeClient(l, client)
+
+	return client.DropStatesTable(ctx)
+}
+
+func (backend *Backend) newClient(l log.Logger, pgCfg *RemoteStateConfigPG) (*Client, error) {
+	l.Debugf("Connecting to %s backend schema %s", BackendName, pgCfg.SchemaName)
+
+	return NewClient(pgCfg, backend.driverName)
+}
+
+func (backend *Backend) closeClient(l log.Logger, client *Client) {
+	if err := client.Close(); err != nil {
+		l.Warnf("Error closing %s backend connection: %v", BackendName, err)
+	}
+}
+
+// checkMigrationDestination refuses to overwrite an unrelated or newer state stored in the destination schema.
+func checkMigrationDestination(schemaName string, srcState, dstState []byte) error {
+	if err := backend.CheckStateOverwrite(srcState, dstState); err != nil {
+		return fmt.Errorf("remote state in pg schema %s: %w", schemaName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package pg_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/pg"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fakeDriverName = "pgfake"
+
+var fakeDatabases = &fakeDriver{databases: map[string]*fakeDatabase{}}
+
+func init() {
+	sql.Register(fakeDriverName, fakeDatabases)
+}
+
+func TestBackend_Bootstrap(t *testing.T) {
+	t.Parallel()
+
+	db := fakeDatabases.database(t.Name())
+	cfg := backend.Config{"conn_str": t.Name(), "schema_name": "live"}
+	l := logger.CreateLogger()
+
+	pgBackend := pg.NewBackend(pg.WithDriverName(fakeDriverName))
+
+	needsBootstrap, err := pgBackend.NeedsBootstrap(t.Context(), l, cfg, &backend.Options{})
+	require.NoError(t, err)
+	assert.True(t, needsBootstrap)
+
+	require.NoError(t, pgBackend.Bootstrap(t.Context(), l, cfg, &backend.Options{}))
+	assert.True(t, db.schemas["live"])
+	assert.NotNil(t, db.tables[`"live".states`])
+
+	needsBootstrap, err = pgBackend.NeedsBootstrap(t.Context(), l, cfg, &backend.Options{})
+	require.NoError(t, err)
+	assert.False(t, needsBootstrap)
+}
+
+func TestBackend_BootstrapSkipSchemaCreation(t *testing.T) {
+	t.Parallel()
+
+	db := fakeDatabases.database(t.Name())
+	cfg := backend.Config{"conn_str": t.Name(), "skip_schema_creation": true}
+
+	require.NoError(t, pg.NewBackend(pg.WithDriverName(fakeDriverName)).Bootstrap(t.Context(), logger.CreateLogger(), cfg, &backend.Options{}))
+	assert.False(t, db.schemas["terraform_remote_state"])
+	assert.NotNil(t, db.tables[`"terraform_remote_state".states`])
+}
+
+func TestBackend_Audit(t *testing.T) {
+	t.Parallel()
+
+	db := fakeDatabases.database(t.Name())
+	cfg := backend.Config{"conn_str": t.Name()}
+	pgBackend := pg.NewBackend(pg.WithDriverName(fakeDriverName))
+
+	drifts, err := pgBackend.Audit(t.Context(), logger.CreateLogger(), cfg, &backend.Options{})
+	require.NoError(t, err)
+	require.Len(t, drifts, 1)
+	assert.Equal(t, pg.DriftStatesTableMissing, drifts[0].Setting)
+
+	db.tables[`"terraform_remote_state".states`] = map[string]string{}
+
+	drifts, err = pgBackend.Audit(t.Context(), logger.CreateLogger(), cfg, &backend.Options{})
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+}
+
+func TestBackend_Migrate(t *testing.T) {
+	t.Parallel()
+
+	db := fakeDatabases.database(t.Name())
+	db.tables[`"old".states`] = map[string]string{"default": `{"version":4,"serial":5}`}
+
+	err := pg.NewBackend(pg.WithDriverName(fakeDriverName)).Migrate(t.Context(), logger.CreateLogger(),
+		backend.Config{"conn_str": t.Name(), "schema_name": "old"},
+		backend.Config{"conn_str": t.Name(), "schema_name": "new"},
+		&backend.Options{})
+	require.NoError(t, err)
+
+	assert.Empty(t, db.tables[`"old".states`])
+	assert.JSONEq(t, `{"version":4,"serial":5}`, db.tables[`"new".states`]["default"])
+}
+
+func TestBackend_MigrateUnrelatedState(t *testing.T) {
+	t.Parallel()
+
+	db := fakeDatabases.database(t.Name())
+	db.tables[`"old".states`] = map[string]string{"default": `{"version":4,"lineage":"a","serial":5,"outputs":{"id":{"value":"x"}}}`}
+	db.tables[`"new".states`] = map[string]string{"default": `{"version":4,"lineage":"b","serial":1,"outputs":{"id":{"value":"y"}}}`}
+
+	err := pg.NewBackend(pg.WithDriverName(fakeDriverName)).Migrate(t.Context(), logger.CreateLogger(),
+		backend.Config{"conn_str": t.Name(), "schema_name": "old"},
+		backend.Config{"conn_str": t.Name(), "schema_name": "new"},
+		&backend.Options{})
+	require.ErrorAs(t, err, new(backend.StateLineageMismatchError))
+
+	assert.NotEmpty(t, db.tables[`"old".states`])
+	assert.JSONEq(t, `{"version":4,"lineage":"b","serial":1,"outputs":{"id":{"value":"y"}}}`, db.tables[`"new".states`]["default"])
+}
+
+func TestBackend_Delete(t *testing.T) {
+	t.Parallel()
+
+	db := fakeDatabases.database(t.Name())
+	db.tables[`"terraform_remote_state".states`] = map[string]string{"default": `{}`, "staging": `{}`}
+
+	err := pg.NewBackend(pg.WithDriverName(fakeDriverName)).Delete(t.Context(), logger.CreateLogger(),
+		backend.Config{"conn_str": t.Name()},
+		&backend.Options{NonInteractive: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"staging": `{}`}, db.tables[`"terraform_remote_state".states`])
+}
+
+// fakeDriver is a local stand-in for PostgreSQL that understands just the statements the pg backend issues.
+// Every connection string gets its own in-memory database.
+type fakeDriver struct {
+	databases map[string]*fakeDatabase
+	mu        sync.Mutex
+}
+
+func (d *fakeDriver) database(name string) *fakeDatabase {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	db, ok := d.databases[name]
+	if !ok {
+		db = &fakeDatabase{schemas: map[string]bool{}, tables: map[string]map[string]string{}}
+		d.databases[name] = db
+	}
+
+	return db
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{db: d.database(name)}, nil
+}
+
+type fakeDatabase struct {
+	schemas map[string]bool
+	tables  map[string]map[string]string
+	mu      sync.Mutex
+}
+
+var (
+	createSchemaRe = regexp.MustCompile(`^CREATE SCHEMA IF NOT EXISTS "([^"]+)"$`)
+	createTableRe  = regexp.MustCompile(`^CREATE TABLE IF NOT EXISTS ("[^"]+"\.states) `)
+	tableRe        = regexp.MustCompile(`("[^"]+"\.states)`)
+)
+
+func (db *fakeDatabase) exec(query string, args []driver.NamedValue) (int64, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	switch {
+	case createSchemaRe.MatchString(query):
+		db.schemas[createSchemaRe.FindStringSubmatch(query)[1]] = true
+	case createTableRe.MatchString(query):
+		table := createTableRe.FindStringSubmatch(query)[1]
+		if db.tables[table] == nil {
+			db.tables[table] = map[string]string{}
+		}
+	case strings.HasPrefix(query, "CREATE SEQUENCE"), strings.HasPrefix(query, "CREATE UNIQUE INDEX"):
+	case strings.HasPrefix(query, "INSERT INTO"):
+		table := tableRe.FindString(query)
+		if db.tables[table] == nil {
+			return 0, fmt.Errorf("relation %s does not exist", table)
+		}
+
+		db.tables[table][args[0].Value.(string)] = args[1].Value.(string)
+	case strings.HasPrefix(query, "DELETE FROM"):
+		delete(db.tables[tableRe.FindString(query)], args[0].Value.(string))
+	case strings.HasPrefix(query, "DROP TABLE"):
+		delete(db.tables, tableRe.FindString(query))
+	default:
+		return 0, fmt.Errorf("unexpected statement %q", query)
+	}
+
+	return 1, nil
+}
+
+func (db *fakeDatabase) query(query string, args []driver.NamedValue) ([][]driver.Value, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	switch {
+	case strings.Contains(query, "information_schema.tables"):
+		var count int64
+		if _, ok := db.tables[fmt.Sprintf(`"%s".%s`, args[0].Value, args[1].Value)]; ok {
+			count = 1
+		}
+
+		return [][]driver.Value{{count}}, nil
+	case strings.HasPrefix(query, "SELECT data FROM"):
+		table := tableRe.FindString(query)
+		if db.tables[table] == nil {
+			return nil, fmt.Errorf("relation %s does not exist", table)
+		}
+
+		if data, ok := db.tables[table][args[0].Value.(string)]; ok {
+			return [][]driver.Value{{data}}, nil
+		}
+
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+}
+
+type fakeConn struct {
+	db *fakeDatabase
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare is not supported: %q", query)
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	affected, err := c.db.exec(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return driver.RowsAffected(affected), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.db.query(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/lib/pq"
+)
+
+const (
+	// defaultStateName is the name OpenTofu/Terraform stores the default workspace state under.
+	defaultStateName = "default"
+
+	statesTableName = "states"
+
+	// DriftStatesTableMissing is reported by the audit when the states table does not exist.
+	DriftStatesTableMissing = "states_table"
+)
+
+// Client manages the schema and the states table used by the OpenTofu/Terraform pg backend.
+type Client struct {
+	*RemoteStateConfigPG
+	db *sql.DB
+}
+
+// NewClient opens a connection to the database using the given SQL driver.
+func NewClient(config *RemoteStateConfigPG, driverName string) (*Client, error) {
+	db, err := sql.Open(driverName, config.ConnStr)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to pg backend database: %w", err)
+	}
+
+	return &Client{
+		RemoteStateConfigPG: config,
+		db:                  db,
+	}, nil
+}
+
+// Close closes the database connection.
+func (client *Client) Close() error {
+	return client.db.Close()
+}
+
+// DoesStatesTableExist returns true if the states table exists in the configured schema.
+func (client *Client) DoesStatesTableExist(ctx context.Context) (bool, error) {
+	var count int
+
+	query := `SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = $1 AND table_name = $2`
+	if err := client.db.QueryRowContext(ctx, query, client.SchemaName, statesTableName).Scan(&count); err != nil {
+		return false, fmt.Errorf("checking pg states table in schema %s: %w", client.SchemaName, err)
+	}
+
+	return count > 0, nil
+}
+
+// AuditStatesTable reports a drift if the states table no longer exists in the configured schema.
+func (client *Client) AuditStatesTable(ctx context.Context) ([]backend.Drift, error) {
+	exists, err := client.DoesStatesTableExist(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return []backend.Drift{backend.NewDrift(client.SchemaName, DriftStatesTableMissing, "states table does not exist")}, nil
+	}
+
+	return nil, nil
+}
+
+// CreateStatesTableIfNecessary creates the schema, the states table and its index the same way
+// the OpenTofu/Terraform pg backend does on `init`, honoring the `skip_*_creation` settings.
+func (client *Client) CreateStatesTableIfNecessary(ctx context.Context, l log.Logger) error {
+	schema := pq.QuoteIdentifier(client.SchemaName)
+
+	var statements []string
+
+	if !client.SkipSchemaCreation {
+		statements = append(statements, `CREATE SCHEMA IF NOT EXISTS `+schema)
+	}
+
+	if !client.SkipTableCreation {
+		statements = append(statements,
+			`CREATE SEQUENCE IF NOT EXISTS public.global_states_id_seq AS bigint`,
+			`CREATE TABLE IF NOT EXISTS `+schema+`.`+statesTableName+` (`+
+				`id bigint NOT NULL DEFAULT nextval('public.global_states_id_seq') PRIMARY KEY, `+
+				`name text UNIQUE, data text)`,
+		)
+	}
+
+	if !client.SkipIndexCreation {
+		statements = append(statements, `CREATE UNIQUE INDEX IF NOT EXISTS states_by_name ON `+schema+`.`+statesTableName+` (name)`)
+	}
+
+	for _, statement := range statements {
+		l.Debugf("Executing pg backend statement: %s", statement)
+
+		if _, err := client.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("bootstrapping pg schema %s: %w", client.SchemaName, err)
+		}
+	}
+
+	return nil
+}
+
+// GetState returns the state of the default workspace, or nil if there is none.
+func (client *Client) GetState(ctx context.Context) ([]byte, error) {
+	var data string
+
+	query := `SELECT data FROM ` + client.statesTable() + ` WHERE name = $1`
+	if err := client.db.QueryRowContext(ctx, query, defaultStateName).Scan(&data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("reading state from pg schema %s: %w", client.SchemaName, err)
+	}
+
+	return []byte(data), nil
+}
+
+// PutState stores the given state as the state of the default workspace.
+func (client *Client) PutState(ctx context.Context, state []byte) error {
+	statement := `INSERT INTO ` + client.statesTable() + ` (name, data) VALUES ($1, $2) ` +
+		`ON CONFLICT (name) DO UPDATE SET data = EXCLUDED.data`
+	if _, err := client.db.ExecContext(ctx, statement, defaultStateName, string(state)); err != nil {
+		return fmt.Errorf("writing state to pg schema %s: %w", client.SchemaName, err)
+	}
+
+	return nil
+}
+
+// DeleteState deletes the state of the default workspace.
+func (client *Client) DeleteState(ctx context.Context) error {
+	statement := `DELETE FROM ` + client.statesTable() + ` WHERE name = $1`
+	if _, err := client.db.ExecContext(ctx, statement, defaultStateName); err != nil {
+		return fmt.Errorf("deleting state from pg schema %s: %w", client.SchemaName, err)
+	}
+
+	return nil
+}
+
+// DropStatesTable drops the states table with all the workspace states it holds.
+func (client *Client) DropStatesTable(ctx context.Context) error {
+	statement := `DROP TABLE IF EXISTS ` + client.statesTable()
+	if _, err := client.db.ExecContext(ctx, statement); err != nil {
+		return fmt.Errorf("dropping states table from pg schema %s: %w", client.SchemaName, err)
+	}
+
+	return nil
+}
+
+func (client *Client) statesTable() string {
+	return pq.QuoteIdentifier(client.SchemaName) + "." + statesTableName
+}
@@ -0,0 +1,62 @@
+package pg
+
+import (
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	defaultSchemaName = "terraform_remote_state"
+
+	envConnStr    = "PG_CONN_STR"
+	envSchemaName = "PG_SCHEMA_NAME"
+)
+
+type Config map[string]any
+
+// RemoteStateConfigPG is a representation of the configuration
+// options available for pg remote state.
+type RemoteStateConfigPG struct {
+	ConnStr            string `mapstructure:"conn_str"`
+	SchemaName         string `mapstructure:"schema_name"`
+	SkipSchemaCreation bool   `mapstructure:"skip_schema_creation"`
+	SkipTableCreation  bool   `mapstructure:"skip_table_creation"`
+	SkipIndexCreation  bool   `mapstructure:"skip_index_creation"`
+}
+
+// ParsePGConfig parses the given map into a pg config, falling back to the
+// `PG_CONN_STR` and `PG_SCHEMA_NAME` variables of the given env for the settings that are not set.
+func (cfg Config) ParsePGConfig(env map[string]string) (*RemoteStateConfigPG, error) {
+	var pgConfig RemoteStateConfigPG
+
+	if err := mapstructure.WeakDecode(cfg, &pgConfig); err != nil {
+		return nil, err
+	}
+
+	if pgConfig.ConnStr == "" {
+		pgConfig.ConnStr = env[envConnStr]
+	}
+
+	if pgConfig.SchemaName == "" {
+		pgConfig.SchemaName = env[envSchemaName]
+	}
+
+	if pgConfig.SchemaName == "" {
+		pgConfig.SchemaName = defaultSchemaName
+	}
+
+	return &pgConfig, pgConfig.Validate()
+}
+
+// Validate validates the configuration for pg remote state.
+func (cfg *RemoteStateConfigPG) Validate() error {
+	if cfg.ConnStr == "" {
+		return MissingRequiredPGRemoteStateConfig("conn_str")
+	}
+
+	return nil
+}
+
+// CacheKey returns a unique key for the given pg config that can be used to cache the initialization.
+func (cfg *RemoteStateConfigPG) CacheKey() string {
+	return cfg.ConnStr + "/" + cfg.SchemaName
+}
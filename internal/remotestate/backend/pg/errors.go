@@ -0,0 +1,7 @@
+package pg
+
+type MissingRequiredPGRemoteStateConfig string
+
+func (configName MissingRequiredPGRemoteStateConfig) Error() string {
+	return "Missing required pg remote state configuration " + string(configName)
+}
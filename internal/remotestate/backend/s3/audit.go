@@ -0,0 +1,145 @@
+package s3
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// Drift settings reported by the S3 backend audit.
+const (
+	DriftBucketMissing        = "bucket"
+	DriftVersioning           = "versioning"
+	DriftSSEncryption         = "server_side_encryption"
+	DriftRootAccessPolicy     = "bucket_policy_root_access"
+	DriftEnforcedTLSPolicy    = "bucket_policy_enforced_tls"
+	DriftAccessLogging        = "access_logging"
+	DriftPublicAccessBlocking = "public_access_block"
+	DriftLockTableMissing     = "lock_table"
+	DriftLockTableEncryption  = "lock_table_encryption"
+)
+
+// AuditRemoteState checks that the bucket and lock table of the client config still have the settings
+// bootstrap configures, honoring the `skip_*` settings, and returns the drift found.
+func (client *Client) AuditRemoteState(ctx context.Context, l log.Logger) ([]backend.Drift, error) {
+	var (
+		bucketName = client.RemoteStateConfigS3.Bucket
+		tableName  = client.RemoteStateConfigS3.GetLockTableName()
+		drifts     []backend.Drift
+	)
+
+	exists, err := client.DoesS3BucketExistWithLogging(ctx, l, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		drifts = append(drifts, backend.NewDrift(bucketName, DriftBucketMissing, "S3 bucket does not exist"))
+	} else {
+		bucketDrifts, err := client.auditS3Bucket(ctx, l, bucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		drifts = append(drifts, bucketDrifts...)
+	}
+
+	if tableName == "" {
+		return drifts, nil
+	}
+
+	exists, err = client.DoesLockTableExist(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return append(drifts, backend.NewDrift(tableName, DriftLockTableMissing, "DynamoDB lock table does not exist")), nil
+	}
+
+	if client.EnableLockTableSSEncryption {
+		enabled, err := client.LockTableCheckSSEncryptionIsOn(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			drifts = append(drifts, backend.NewDrift(tableName, DriftLockTableEncryption, "server-side encryption is not enabled for the DynamoDB lock table"))
+		}
+	}
+
+	return drifts, nil
+}
+
+func (client *Client) auditS3Bucket(ctx context.Context, l log.Logger, bucketName string) ([]backend.Drift, error) {
+	var drifts []backend.Drift
+
+	if !client.SkipBucketVersioning {
+		enabled, err := client.CheckIfVersioningEnabled(ctx, l, bucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			drifts = append(drifts, backend.NewDrift(bucketName, DriftVersioning, "versioning is not enabled"))
+		}
+	}
+
+	if !client.SkipBucketSSEncryption {
+		matches, err := client.checkIfSSEForS3MatchesConfig(ctx, bucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matches {
+			drifts = append(drifts, backend.NewDrift(bucketName, DriftSSEncryption, "server-side encryption does not match the configured algorithm"))
+		}
+	}
+
+	if !client.SkipBucketRootAccess {
+		enabled, err := client.checkIfBucketRootAccess(ctx, l, bucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			drifts = append(drifts, backend.NewDrift(bucketName, DriftRootAccessPolicy, "bucket policy is missing the %s statement", SidRootPolicy))
+		}
+	}
+
+	if !client.SkipBucketEnforcedTLS {
+		enabled, err := client.checkIfBucketEnforcedTLS(ctx, l, bucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			drifts = append(drifts, backend.NewDrift(bucketName, DriftEnforcedTLSPolicy, "bucket policy is missing the %s statement", SidEnforcedTLSPolicy))
+		}
+	}
+
+	if !client.SkipBucketAccessLogging && client.AccessLoggingBucketName != "" {
+		enabled, err := client.checkS3AccessLoggingConfiguration(ctx, bucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			drifts = append(drifts, backend.NewDrift(bucketName, DriftAccessLogging, "access logging to %s is not enabled", client.AccessLoggingBucketName))
+		}
+	}
+
+	if !client.SkipBucketPublicAccessBlocking {
+		enabled, err := client.checkIfS3PublicAccessBlockingEnabled(ctx, bucketName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			drifts = append(drifts, backend.NewDrift(bucketName, DriftPublicAccessBlocking, "public access blocking is not fully enabled"))
+		}
+	}
+
+	return drifts, nil
+}
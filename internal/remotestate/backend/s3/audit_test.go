@@ -0,0 +1,114 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	s3backend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackend_Audit(t *testing.T) {
+	t.Parallel()
+
+	compliantBucket := func() *fakeBucket {
+		return &fakeBucket{
+			versioning:        true,
+			encryption:        "aws:kms",
+			policySids:        []string{s3backend.SidRootPolicy, s3backend.SidEnforcedTLSPolicy},
+			publicAccessBlock: true,
+		}
+	}
+
+	testCases := []struct {
+		bucket   *fakeBucket
+		table    *fakeTable
+		config   backend.Config
+		name     string
+		expected []string
+	}{
+		{
+			name:   "in-sync",
+			bucket: compliantBucket(),
+			table:  &fakeTable{},
+		},
+		{
+			name:     "bucket-missing",
+			table:    &fakeTable{},
+			expected: []string{s3backend.DriftBucketMissing},
+		},
+		{
+			name:     "lock-table-missing",
+			bucket:   compliantBucket(),
+			expected: []string{s3backend.DriftLockTableMissing},
+		},
+		{
+			name:   "bucket-drifted",
+			bucket: &fakeBucket{encryption: "AES256", policySids: []string{s3backend.SidRootPolicy}},
+			table:  &fakeTable{},
+			expected: []string{
+				s3backend.DriftVersioning,
+				s3backend.DriftSSEncryption,
+				s3backend.DriftEnforcedTLSPolicy,
+				s3backend.DriftPublicAccessBlocking,
+			},
+		},
+		{
+			name:   "skipped-settings",
+			bucket: &fakeBucket{},
+			table:  &fakeTable{},
+			config: backend.Config{
+				"skip_bucket_versioning":             true,
+				"skip_bucket_ssencryption":           true,
+				"skip_bucket_root_access":            true,
+				"skip_bucket_enforced_tls":           true,
+				"skip_bucket_public_access_blocking": true,
+			},
+		},
+		{
+			name:     "lock-table-encryption",
+			bucket:   compliantBucket(),
+			table:    &fakeTable{},
+			config:   backend.Config{"enable_lock_table_ssencryption": true},
+			expected: []string{s3backend.DriftLockTableEncryption},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake, srv := newFakeAWS(t)
+
+			if tc.bucket != nil {
+				fake.buckets["state-bucket"] = tc.bucket
+			}
+
+			if tc.table != nil {
+				fake.tables["locks"] = tc.table
+			}
+
+			cfg := fakeBackendConfig(srv, "state-bucket", "locks")
+			for key, value := range tc.config {
+				cfg[key] = value
+			}
+
+			drifts, err := s3backend.NewBackend().Audit(t.Context(), logger.CreateLogger(), cfg, backendOptions())
+			require.NoError(t, err)
+
+			settings := make([]string, 0, len(drifts))
+			for _, drift := range drifts {
+				settings = append(settings, drift.Setting)
+			}
+
+			if len(tc.expected) == 0 {
+				assert.Empty(t, settings)
+				return
+			}
+
+			assert.Equal(t, tc.expected, settings)
+		})
+	}
+}
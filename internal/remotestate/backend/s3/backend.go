@@ -133,6 +133,52 @@ func (backend *Backend) Bootstrap(
 	return nil
 }
 
+// Audit checks that the S3 bucket and DynamoDB lock table specified in the given config still have the settings
+// bootstrap configures, without changing them.
+func (backend *Backend) Audit(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]backend.Drift, error) {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, l, extS3Cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.AuditRemoteState(ctx, l)
+}
+
+// Locks returns the state locks held in the DynamoDB lock table and, with `use_lockfile`, the S3 native lock file.
+func (backend *Backend) Locks(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]*backend.Lock, error) {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, l, extS3Cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetStateLocks(ctx, l)
+}
+
+// ForceUnlock releases the state lock with the given ID.
+func (backend *Backend) ForceUnlock(ctx context.Context, l log.Logger, backendConfig backend.Config, lockID string, opts *backend.Options) error {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(ctx, l, extS3Cfg, opts)
+	if err != nil {
+		return err
+	}
+
+	return client.ForceUnlockState(ctx, l, lockID)
+}
+
 // IsVersionControlEnabled returns true if version control for s3 bucket is enabled.
 func (backend *Backend) IsVersionControlEnabled(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) (bool, error) {
 	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
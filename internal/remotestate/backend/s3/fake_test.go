@@ -0,0 +1,252 @@
+package s3_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	s3backend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
+)
+
+// fakeBucket is the state of an S3 bucket served by fakeAWS.
+type fakeBucket struct {
+	objects           map[string][]byte
+	encryption        string
+	policySids        []string
+	versioning        bool
+	publicAccessBlock bool
+}
+
+// fakeTable is the state of a DynamoDB table served by fakeAWS.
+type fakeTable struct {
+	// items maps the LockID of the items to their Info attribute.
+	items      map[string]string
+	sseEnabled bool
+}
+
+// fakeAWS serves the subset of the S3 and DynamoDB APIs used by the S3 backend audit and locks.
+type fakeAWS struct {
+	buckets map[string]*fakeBucket
+	tables  map[string]*fakeTable
+	mu      sync.Mutex
+}
+
+func newFakeAWS(t *testing.T) (*fakeAWS, *httptest.Server) {
+	t.Helper()
+
+	fake := &fakeAWS{
+		buckets: make(map[string]*fakeBucket),
+		tables:  make(map[string]*fakeTable),
+	}
+
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	return fake, srv
+}
+
+// fakeBackendConfig returns the config of an S3 backend using the fake server for both S3 and DynamoDB.
+func fakeBackendConfig(srv *httptest.Server, bucket, table string) backend.Config {
+	cfg := backend.Config{
+		"bucket":                      bucket,
+		"key":                         "prod/terraform.tfstate",
+		"region":                      "us-east-1",
+		"endpoint":                    srv.URL,
+		"dynamodb_endpoint":           srv.URL,
+		"force_path_style":            true,
+		"skip_credentials_validation": true,
+	}
+
+	if table != "" {
+		cfg["dynamodb_table"] = table
+	}
+
+	return cfg
+}
+
+// backendOptions returns options with static credentials, so that the SDK never looks for real ones.
+func backendOptions() *backend.Options {
+	return &backend.Options{
+		Env: map[string]string{
+			"AWS_ACCESS_KEY_ID":     "test",
+			"AWS_SECRET_ACCESS_KEY": "test",
+		},
+		NonInteractive: true,
+	}
+}
+
+func (fake *fakeAWS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		fake.serveDynamoDB(w, r, strings.TrimPrefix(target, "DynamoDB_20120810."))
+
+		return
+	}
+
+	fake.serveS3(w, r)
+}
+
+func (fake *fakeAWS) serveS3(w http.ResponseWriter, r *http.Request) {
+	bucketName, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	bucket, ok := fake.buckets[bucketName]
+	if !ok {
+		writeS3Error(w, r, http.StatusNotFound, "NoSuchBucket")
+
+		return
+	}
+
+	switch {
+	case key != "":
+		fake.serveS3Object(w, r, bucket, key)
+	case r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case query.Has("versioning"):
+		status := "Suspended"
+		if bucket.versioning {
+			status = "Enabled"
+		}
+
+		writeXML(w, `<VersioningConfiguration><Status>`+status+`</Status></VersioningConfiguration>`)
+	case query.Has("encryption"):
+		if bucket.encryption == "" {
+			writeS3Error(w, r, http.StatusNotFound, "ServerSideEncryptionConfigurationNotFoundError")
+
+			return
+		}
+
+		writeXML(w, `<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>`+
+			bucket.encryption+`</SSEAlgorithm></ApplyServerSideEncryptionByDefault></Rule></ServerSideEncryptionConfiguration>`)
+	case query.Has("policy"):
+		if len(bucket.policySids) == 0 {
+			writeS3Error(w, r, http.StatusNotFound, "NoSuchBucketPolicy")
+
+			return
+		}
+
+		statements := make([]map[string]any, 0, len(bucket.policySids))
+		for _, sid := range bucket.policySids {
+			statements = append(statements, map[string]any{"Sid": sid, "Effect": "Allow", "Action": "s3:*", "Resource": "*"})
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"Version": "2012-10-17", "Statement": statements})
+	case query.Has("publicAccessBlock"):
+		if !bucket.publicAccessBlock {
+			writeS3Error(w, r, http.StatusNotFound, "NoSuchPublicAccessBlockConfiguration")
+
+			return
+		}
+
+		writeXML(w, `<PublicAccessBlockConfiguration><BlockPublicAcls>true</BlockPublicAcls>`+
+			`<IgnorePublicAcls>true</IgnorePublicAcls><BlockPublicPolicy>true</BlockPublicPolicy>`+
+			`<RestrictPublicBuckets>true</RestrictPublicBuckets></PublicAccessBlockConfiguration>`)
+	default:
+		writeS3Error(w, r, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (fake *fakeAWS) serveS3Object(w http.ResponseWriter, r *http.Request, bucket *fakeBucket, key string) {
+	data, ok := bucket.objects[key]
+
+	switch r.Method {
+	case http.MethodHead, http.MethodGet:
+		if !ok {
+			writeS3Error(w, r, http.StatusNotFound, "NoSuchKey")
+
+			return
+		}
+
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		w.WriteHeader(http.StatusOK)
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(bucket.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, r, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (fake *fakeAWS) serveDynamoDB(w http.ResponseWriter, r *http.Request, operation string) {
+	var input struct {
+		Key struct {
+			LockID struct {
+				S string `json:"S"`
+			} `json:"LockID"`
+		} `json:"Key"`
+		TableName string `json:"TableName"`
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	_ = json.Unmarshal(body, &input)
+
+	table, ok := fake.tables[input.TableName]
+	if !ok {
+		writeDynamoDBError(w, "ResourceNotFoundException")
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+
+	switch operation {
+	case "DescribeTable":
+		description := map[string]any{"TableName": input.TableName, "TableStatus": "ACTIVE"}
+		if table.sseEnabled {
+			description["SSEDescription"] = map[string]any{"Status": "ENABLED"}
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"Table": description})
+	case "GetItem":
+		info, ok := table.items[input.Key.LockID.S]
+		if !ok {
+			_, _ = io.WriteString(w, `{}`)
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"Item": map[string]any{
+			s3backend.AttrLockID: map[string]string{"S": input.Key.LockID.S},
+			"Info":               map[string]string{"S": info},
+		}})
+	case "DeleteItem":
+		delete(table.items, input.Key.LockID.S)
+
+		_, _ = io.WriteString(w, `{}`)
+	default:
+		writeDynamoDBError(w, "UnknownOperationException")
+	}
+}
+
+func writeXML(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = io.WriteString(w, xml.Header+body)
+}
+
+func writeS3Error(w http.ResponseWriter, r *http.Request, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+
+	if r.Method != http.MethodHead {
+		_, _ = io.WriteString(w, xml.Header+`<Error><Code>`+code+`</Code><Message>`+code+`</Message></Error>`)
+	}
+}
+
+func writeDynamoDBError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.WriteHeader(http.StatusBadRequest)
+	_, _ = io.WriteString(w, `{"__type":"com.amazonaws.dynamodb.v20120810#`+code+`","message":"`+code+`"}`)
+}
@@ -0,0 +1,153 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// attrLockInfo is the DynamoDB attribute OpenTofu/Terraform stores the lock info in.
+	attrLockInfo = "Info"
+
+	// lockFileSuffix is appended to the state key to get the S3 native lock file, used with `use_lockfile`.
+	lockFileSuffix = ".tflock"
+)
+
+// GetStateLocks returns the locks held on the state, in the DynamoDB lock table and, with `use_lockfile`,
+// in the S3 native lock file.
+func (client *Client) GetStateLocks(ctx context.Context, l log.Logger) ([]*backend.Lock, error) {
+	var locks []*backend.Lock
+
+	if tableName := client.RemoteStateConfigS3.GetLockTableName(); tableName != "" {
+		lock, err := client.getTableLock(ctx, l, tableName, client.lockTableKey())
+		if err != nil {
+			return nil, err
+		}
+
+		if lock != nil {
+			locks = append(locks, lock)
+		}
+	}
+
+	if client.RemoteStateConfigS3.UseLockfile {
+		lock, err := client.getLockFile(ctx, l, client.RemoteStateConfigS3.Bucket, client.lockFileKey())
+		if err != nil {
+			return nil, err
+		}
+
+		if lock != nil {
+			locks = append(locks, lock)
+		}
+	}
+
+	return locks, nil
+}
+
+// ForceUnlockState releases the state lock with the given ID from the DynamoDB lock table and the S3 native lock file.
+func (client *Client) ForceUnlockState(ctx context.Context, l log.Logger, lockID string) error {
+	locks, err := client.GetStateLocks(ctx, l)
+	if err != nil {
+		return err
+	}
+
+	var (
+		bucketName = client.RemoteStateConfigS3.Bucket
+		tableName  = client.RemoteStateConfigS3.GetLockTableName()
+		statePath  = path.Join(bucketName, client.RemoteStateConfigS3.Key)
+	)
+
+	if len(locks) == 0 {
+		return backend.LockNotFoundError{Path: statePath, ID: lockID}
+	}
+
+	for _, lock := range locks {
+		if lock.ID != lockID {
+			return backend.LockIDMismatchError{Path: statePath, ExpectedID: lockID, ActualID: lock.ID}
+		}
+	}
+
+	if tableName != "" {
+		if err := client.DeleteTableItemIfNecessary(ctx, l, tableName, client.lockTableKey()); err != nil {
+			return err
+		}
+	}
+
+	if client.RemoteStateConfigS3.UseLockfile {
+		if err := client.DeleteS3ObjectIfNecessary(ctx, l, bucketName, client.lockFileKey()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (client *Client) lockTableKey() string {
+	return path.Join(client.RemoteStateConfigS3.Bucket, client.RemoteStateConfigS3.Key)
+}
+
+func (client *Client) lockFileKey() string {
+	return client.RemoteStateConfigS3.Key + lockFileSuffix
+}
+
+func (client *Client) getTableLock(ctx context.Context, l log.Logger, tableName, key string) (*backend.Lock, error) {
+	l.Debugf("Reading lock %s from DynamoDB table %s", key, tableName)
+
+	input := &dynamodb.GetItemInput{
+		TableName:      aws.String(tableName),
+		ConsistentRead: aws.Bool(true),
+		Key: map[string]dynamodbtypes.AttributeValue{
+			AttrLockID: &dynamodbtypes.AttributeValueMemberS{Value: key},
+		},
+	}
+
+	res, err := client.dynamoClient.GetItem(ctx, input)
+	if err != nil {
+		var notFoundErr *dynamodbtypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get item by key %s of table %s: %w", key, tableName, err)
+	}
+
+	info, ok := res.Item[attrLockInfo].(*dynamodbtypes.AttributeValueMemberS)
+	if !ok {
+		return nil, nil
+	}
+
+	return backend.ParseLockInfo([]byte(info.Value))
+}
+
+func (client *Client) getLockFile(ctx context.Context, l log.Logger, bucketName, key string) (*backend.Lock, error) {
+	l.Debugf("Reading lock file %s from S3 bucket %s", key, bucketName)
+
+	res, err := client.s3Client.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(bucketName), Key: aws.String(key)})
+	if err != nil {
+		var notFoundErr *types.NoSuchKey
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get lock file %s from S3 bucket %s: %w", key, bucketName, err)
+	}
+
+	defer res.Body.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return backend.ParseLockInfo(data)
+}
@@ -0,0 +1,98 @@
+package s3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	s3backend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLockTableKey = "state-bucket/prod/terraform.tfstate"
+	testLockFileKey  = "prod/terraform.tfstate.tflock"
+	testLockInfo     = `{"ID":"5a9d8c3e","Operation":"OperationTypeApply","Who":"ci@runner","Created":"2026-01-02T03:04:05Z"}`
+)
+
+// newLockedFakeAWS returns a fake holding the same lock in the DynamoDB lock table and the S3 native lock file.
+func newLockedFakeAWS(t *testing.T, lockInfo string) (*fakeAWS, backend.Config) {
+	t.Helper()
+
+	fake, srv := newFakeAWS(t)
+	fake.buckets["state-bucket"] = &fakeBucket{objects: map[string][]byte{testLockFileKey: []byte(lockInfo)}}
+	fake.tables["locks"] = &fakeTable{items: map[string]string{testLockTableKey: lockInfo}}
+
+	cfg := fakeBackendConfig(srv, "state-bucket", "locks")
+	cfg["use_lockfile"] = true
+
+	return fake, cfg
+}
+
+func TestBackend_Locks(t *testing.T) {
+	t.Parallel()
+
+	_, cfg := newLockedFakeAWS(t, testLockInfo)
+
+	locks, err := s3backend.NewBackend().Locks(t.Context(), logger.CreateLogger(), cfg, backendOptions())
+	require.NoError(t, err)
+	require.Len(t, locks, 2)
+
+	for _, lock := range locks {
+		assert.Equal(t, "5a9d8c3e", lock.ID)
+		assert.Equal(t, "OperationTypeApply", lock.Operation)
+		assert.Equal(t, "ci@runner", lock.Who)
+		assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), lock.Created)
+	}
+}
+
+func TestBackend_LocksNotHeld(t *testing.T) {
+	t.Parallel()
+
+	fake, srv := newFakeAWS(t)
+	fake.buckets["state-bucket"] = &fakeBucket{}
+	fake.tables["locks"] = &fakeTable{}
+
+	cfg := fakeBackendConfig(srv, "state-bucket", "locks")
+	cfg["use_lockfile"] = true
+
+	locks, err := s3backend.NewBackend().Locks(t.Context(), logger.CreateLogger(), cfg, backendOptions())
+	require.NoError(t, err)
+	assert.Empty(t, locks)
+}
+
+func TestBackend_ForceUnlock(t *testing.T) {
+	t.Parallel()
+
+	fake, cfg := newLockedFakeAWS(t, testLockInfo)
+
+	require.NoError(t, s3backend.NewBackend().ForceUnlock(t.Context(), logger.CreateLogger(), cfg, "5a9d8c3e", backendOptions()))
+
+	assert.Empty(t, fake.tables["locks"].items)
+	assert.Empty(t, fake.buckets["state-bucket"].objects)
+
+	err := s3backend.NewBackend().ForceUnlock(t.Context(), logger.CreateLogger(), cfg, "5a9d8c3e", backendOptions())
+
+	var notFoundErr backend.LockNotFoundError
+
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "5a9d8c3e", notFoundErr.ID)
+}
+
+func TestBackend_ForceUnlockIDMismatch(t *testing.T) {
+	t.Parallel()
+
+	fake, cfg := newLockedFakeAWS(t, testLockInfo)
+
+	err := s3backend.NewBackend().ForceUnlock(t.Context(), logger.CreateLogger(), cfg, "another-id", backendOptions())
+
+	var mismatchErr backend.LockIDMismatchError
+
+	require.ErrorAs(t, err, &mismatchErr)
+	assert.Equal(t, "5a9d8c3e", mismatchErr.ActualID)
+
+	assert.Contains(t, fake.tables["locks"].items, testLockTableKey)
+	assert.Contains(t, fake.buckets["state-bucket"].objects, testLockFileKey)
+}
@@ -0,0 +1,47 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// ReadState returns the state object stored at the configured bucket and key, or nil if there is none.
+func (backend *Backend) ReadState(ctx context.Context, l log.Logger, backendConfig backend.Config, opts *backend.Options) ([]byte, error) {
+	extS3Cfg, err := Config(backendConfig).ExtendedS3Config(l)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(ctx, l, extS3Cfg, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetS3ObjectIfExists(ctx, l, extS3Cfg.RemoteStateConfigS3.Bucket, extS3Cfg.RemoteStateConfigS3.Key)
+}
+
+// GetS3ObjectIfExists returns the content of the given S3 object, or nil if neither the object nor its bucket exist.
+func (client *Client) GetS3ObjectIfExists(ctx context.Context, l log.Logger, bucketName, key string) ([]byte, error) {
+	l.Debugf("Reading object %s from S3 bucket %s", key, bucketName)
+
+	res, err := client.s3Client.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(bucketName), Key: aws.String(key)})
+	if err != nil {
+		if errors.As(err, new(*types.NoSuchKey)) || errors.As(err, new(*types.NoSuchBucket)) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get object %s from S3 bucket %s: %w", key, bucketName, err)
+	}
+
+	defer res.Body.Close() //nolint:errcheck
+
+	return io.ReadAll(res.Body)
+}
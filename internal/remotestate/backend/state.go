@@ -0,0 +1,64 @@
+package backend
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// StateOutputsJSON extracts the `outputs` section from the raw OpenTofu/Terraform state body
+// and encodes it the same way `tofu output -json` does.
+func StateOutputsJSON(stateBody []byte) ([]byte, error) {
+	var state struct {
+		Outputs map[string]any `json:"outputs"`
+	}
+
+	if err := json.Unmarshal(stateBody, &state); err != nil {
+		return nil, fmt.Errorf("parsing state JSON: %w", err)
+	}
+
+	jsonOutputs, err := json.Marshal(state.Outputs)
+	if err != nil {
+		return nil, fmt.Errorf("encoding state outputs: %w", err)
+	}
+
+	return jsonOutputs, nil
+}
+
+// CheckStateOverwrite returns an error if writing the source state over the destination state would lose data, because
+// the destination holds an unrelated state, with a different lineage, or a newer revision of the source state. A
+// missing destination state, or one holding neither resources nor outputs, can always be overwritten.
+func CheckStateOverwrite(srcState, dstState []byte) error {
+	var src, dst struct {
+		Lineage   string         `json:"lineage"`
+		Serial    int            `json:"serial"`
+		Resources []any          `json:"resources"`
+		Outputs   map[string]any `json:"outputs"`
+	}
+
+	if len(bytes.TrimSpace(dstState)) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(dstState, &dst); err != nil {
+		return fmt.Errorf("parsing destination state JSON: %w", err)
+	}
+
+	if len(dst.Resources) == 0 && len(dst.Outputs) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(srcState, &src); err != nil {
+		return fmt.Errorf("parsing source state JSON: %w", err)
+	}
+
+	if src.Lineage != dst.Lineage {
+		return StateLineageMismatchError{SrcLineage: src.Lineage, DstLineage: dst.Lineage}
+	}
+
+	if dst.Serial >= src.Serial {
+		return StateSerialConflictError{SrcSerial: src.Serial, DstSerial: dst.Serial}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package backend_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOutputsJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		state    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "outputs",
+			state:    `{"version":4,"serial":3,"outputs":{"vpc_id":{"value":"vpc-123","type":"string"}},"resources":[]}`,
+			expected: `{"vpc_id":{"type":"string","value":"vpc-123"}}`,
+		},
+		{
+			name:     "no-outputs",
+			state:    `{"version":4,"serial":1,"resources":[]}`,
+			expected: `null`,
+		},
+		{
+			name:     "empty-outputs",
+			state:    `{"version":4,"serial":1,"outputs":{},"resources":[]}`,
+			expected: `{}`,
+		},
+		{
+			name:    "invalid-json",
+			state:   `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := backend.StateOutputsJSON([]byte(tc.state))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(actual))
+		})
+	}
+}
+
+func TestCheckStateOverwrite(t *testing.T) {
+	t.Parallel()
+
+	const src = `{"version":4,"lineage":"a","serial":3,"resources":[{"type":"null_resource"}]}`
+
+	testCases := []struct {
+		name     string
+		dstState string
+		wantErr  error
+	}{
+		{
+			name: "missing",
+		},
+		{
+			name:     "empty",
+			dstState: `{"version":4,"lineage":"b","serial":1,"resources":[]}`,
+		},
+		{
+			name:     "older-revision",
+			dstState: `{"version":4,"lineage":"a","serial":2,"resources":[{"type":"null_resource"}]}`,
+		},
+		{
+			name:     "unrelated",
+			dstState: `{"version":4,"lineage":"b","serial":1,"resources":[{"type":"null_resource"}]}`,
+			wantErr:  backend.StateLineageMismatchError{SrcLineage: "a", DstLineage: "b"},
+		},
+		{
+			name:     "newer-revision",
+			dstState: `{"version":4,"lineage":"a","serial":3,"outputs":{"id":{"value":"x"}}}`,
+			wantErr:  backend.StateSerialConflictError{SrcSerial: 3, DstSerial: 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := backend.CheckStateOverwrite([]byte(src), []byte(tc.dstState))
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package remotestate
+
+import (
+	"fmt"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"strings"
+)
+
+// StateSummary describes the state stored by one side of a migration.
+type StateSummary struct {
+	Backend   string
+	Lineage   string
+	Serial    int
+	Resources int
+	Outputs   int
+	Exists    bool
+}
+
+// NewStateSummary summarizes the given raw state, as returned by `tofu state pull`.
+func NewStateSummary(backendName string, stateData []byte) (*StateSummary, error) {
+	summary := &StateSummary{Backend: backendName}
+
+	if len(strings.TrimSpace(string(stateData))) == 0 {
+		return summary, nil
+	}
+
+	state, err := ParseTerraformState(stateData)
+	if err != nil {
+		return nil, err
+	}
+
+	summary.Exists = true
+	summary.Lineage = state.Lineage
+	summary.Serial = state.Serial
+	summary.Resources = len(state.Resources)
+	summary.Outputs = len(state.Outputs)
+
+	for _, module := range state.Modules {
+		summary.Resources += len(module.Resources)
+		summary.Outputs += len(module.Outputs)
+	}
+
+	return summary, nil
+}
+
+// IsEmpty returns true if there is no state or the state holds neither resources nor outputs.
+func (summary *StateSummary) IsEmpty() bool {
+	return !summary.Exists || (summary.Resources == 0 && summary.Outputs == 0)
+}
+
+// String implements `fmt.Stringer` interface.
+func (summary *StateSummary) String() string {
+	if !summary.Exists {
+		return summary.Backend + " backend, no state"
+	}
+
+	return fmt.Sprintf("%s backend, lineage %s, serial %d, %d resources, %d outputs",
+		summary.Backend, summary.Lineage, summary.Serial, summary.Resources, summary.Outputs)
+}
+
+// MigrationPlan describes what migrating state from one remote state to another would do.
+type MigrationPlan struct {
+	Src *StateSummary
+	Dst *StateSummary
+
+	srcState []byte
+}
+
+// Check returns an error if moving the source state onto the destination state could lose data:
+// the destination holds an unrelated state (different lineage), or a newer or equal revision
+// of the same state (serial not lower than the source). `force` skips these checks.
+func (plan *MigrationPlan) Check(force bool) error {
+	if force || !plan.Src.Exists || plan.Dst.IsEmpty() {
+		return nil
+	}
+
+	if plan.Src.Lineage != plan.Dst.Lineage {
+		return StateLineageMismatchError{SrcLineage: plan.Src.Lineage, DstLineage: plan.Dst.Lineage}
+	}
+
+	if plan.Dst.Serial >= plan.Src.Serial {
+		return StateSerialConflictError{SrcSerial: plan.Src.Serial, DstSerial: plan.Dst.Serial}
+	}
+
+	return nil
+}
+
+// String implements `fmt.Stringer` interface.
+func (plan *MigrationPlan) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("Source:      " + plan.Src.String() + "\n")
+	sb.WriteString("Destination: " + plan.Dst.String() + "\n")
+
+	switch {
+	case !plan.Src.Exists:
+		sb.WriteString("Nothing to migrate, the source has no state.\n")
+	case plan.Dst.IsEmpty():
+		fmt.Fprintf(&sb, "Would move %d resources and %d outputs to the destination.\n", plan.Src.Resources, plan.Src.Outputs)
+	default:
+		fmt.Fprintf(&sb, "Would replace the destination state with %d resources and %d outputs.\n", plan.Src.Resources, plan.Src.Outputs)
+	}
+
+	return sb.String()
+}
+
+// StateLineageMismatchError is returned when the source and destination states are unrelated.
+type StateLineageMismatchError = backend.StateLineageMismatchError
+
+// StateSerialConflictError is returned when the destination holds a newer or equal revision of the source state.
+type StateSerialConflictError = backend.StateSerialConflictError
@@ -0,0 +1,138 @@
+package remotestate_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateSummary(t *testing.T) {
+	t.Parallel()
+
+	summary, err := remotestate.NewStateSummary("s3", []byte(`{
+		"version": 4,
+		"lineage": "abc",
+		"serial": 7,
+		"outputs": {"vpc_id": {"value": "vpc-1", "type": "string"}},
+		"resources": [{"type": "aws_vpc", "name": "main"}, {"type": "aws_subnet", "name": "a"}]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, &remotestate.StateSummary{
+		Backend:   "s3",
+		Lineage:   "abc",
+		Serial:    7,
+		Resources: 2,
+		Outputs:   1,
+		Exists:    true,
+	}, summary)
+
+	summary, err = remotestate.NewStateSummary("gcs", []byte("\n"))
+	require.NoError(t, err)
+	assert.False(t, summary.Exists)
+	assert.True(t, summary.IsEmpty())
+}
+
+func TestMigrationPlan_Check(t *testing.T) {
+	t.Parallel()
+
+	src := &remotestate.StateSummary{Backend: "s3", Lineage: "abc", Serial: 5, Resources: 3, Exists: true}
+
+	testCases := []struct {
+		dst     *remotestate.StateSummary
+		wantErr error
+		name    string
+		force   bool
+	}{
+		{
+			name: "no-destination-state",
+			dst:  &remotestate.StateSummary{Backend: "gcs"},
+		},
+		{
+			name: "empty-destination-state",
+			dst:  &remotestate.StateSummary{Backend: "gcs", Lineage: "other", Serial: 1, Exists: true},
+		},
+		{
+			name: "older-destination-serial",
+			dst:  &remotestate.StateSummary{Backend: "gcs", Lineage: "abc", Serial: 4, Resources: 3, Exists: true},
+		},
+		{
+			name:    "different-lineage",
+			dst:     &remotestate.StateSummary{Backend: "gcs", Lineage: "other", Serial: 1, Resources: 1, Exists: true},
+			wantErr: remotestate.StateLineageMismatchError{SrcLineage: "abc", DstLineage: "other"},
+		},
+		{
+			name:    "newer-destination-serial",
+			dst:     &remotestate.StateSummary{Backend: "gcs", Lineage: "abc", Serial: 5, Resources: 3, Exists: true},
+			wantErr: remotestate.StateSerialConflictError{SrcSerial: 5, DstSerial: 5},
+		},
+		{
+			name:  "forced",
+			dst:   &remotestate.StateSummary{Backend: "gcs", Lineage: "other", Serial: 9, Resources: 1, Exists: true},
+			force: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := &remotestate.MigrationPlan{Src: src, Dst: tc.dst}
+
+			err := plan.Check(tc.force)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMigrationPlan_String(t *testing.T) {
+	t.Parallel()
+
+	plan := &remotestate.MigrationPlan{
+		Src: &remotestate.StateSummary{Backend: "s3", Lineage: "abc", Serial: 5, Resources: 3, Outputs: 1, Exists: true},
+		Dst: &remotestate.StateSummary{Backend: "gcs"},
+	}
+
+	assert.Equal(t, "Source:      s3 backend, lineage abc, serial 5, 3 resources, 1 outputs\n"+
+		"Destination: gcs backend, no state\n"+
+		"Would move 3 resources and 1 outputs to the destination.\n", plan.String())
+}
+
+func TestPlanMigrationReadsStateFromBackend(t *testing.T) {
+	t.Parallel()
+
+	var (
+		srcDir = t.TempDir()
+		dstDir = t.TempDir()
+		l      = logger.CreateLogger()
+	)
+
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "terraform.tfstate"),
+		[]byte(`{"version":4,"lineage":"a","serial":3,"outputs":{"id":{"value":"x"}}}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dstDir, "terraform.tfstate"),
+		[]byte(`{"version":4,"lineage":"b","serial":1,"outputs":{"id":{"value":"y"}}}`), 0o644))
+
+	srcRemote := remotestate.New(&remotestate.Config{BackendName: "local", BackendConfig: map[string]any{}})
+	dstRemote := remotestate.New(&remotestate.Config{BackendName: "local", BackendConfig: map[string]any{}})
+
+	// No TFRunOpts are set, reading either state through OpenTofu/Terraform would fail.
+	plan, err := srcRemote.PlanMigration(t.Context(), l, nil,
+		&remotestate.Options{Options: backend.Options{UnitDir: srcDir}},
+		&remotestate.Options{Options: backend.Options{UnitDir: dstDir}},
+		dstRemote)
+	require.NoError(t, err)
+
+	assert.Equal(t, "a", plan.Src.Lineage)
+	assert.Equal(t, "b", plan.Dst.Lineage)
+	require.ErrorAs(t, plan.Check(false), new(remotestate.StateLineageMismatchError))
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/azurerm"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/gcs"
+	httpbackend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/http"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/local"
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/pg"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
 	"github.com/gruntwork-io/terragrunt/internal/tf"
 	"github.com/gruntwork-io/terragrunt/internal/vexec"
@@ -21,6 +24,9 @@ var backends = backend.Backends{
 	s3.NewBackend(),
 	gcs.NewBackend(),
 	azurerm.NewBackend(),
+	local.NewBackend(),
+	httpbackend.NewBackend(),
+	pg.NewBackend(),
 }
 
 // Options contains the subset of configuration needed by RemoteState operations.
@@ -71,6 +77,27 @@ func (remote *RemoteState) IsVersionControlEnabled(ctx context.Context, l log.Lo
 	return remote.backend.IsVersionControlEnabled(ctx, l, remote.BackendConfig, &opts.Options)
 }
 
+// Audit checks, without changing anything, whether the remote state resources still match the config.
+func (remote *RemoteState) Audit(ctx context.Context, l log.Logger, opts *Options) ([]backend.Drift, error) {
+	l.Debugf("Auditing remote state for the %s backend", remote.BackendName)
+
+	return remote.backend.Audit(ctx, l, remote.BackendConfig, &opts.Options)
+}
+
+// Locks returns the state locks currently held in the backend.
+func (remote *RemoteState) Locks(ctx context.Context, l log.Logger, opts *Options) ([]*backend.Lock, error) {
+	l.Debugf("Listing state locks for the %s backend", remote.BackendName)
+
+	return remote.backend.Locks(ctx, l, remote.BackendConfig, &opts.Options)
+}
+
+// ForceUnlock releases the state lock with the given ID.
+func (remote *RemoteState) ForceUnlock(ctx context.Context, l log.Logger, lockID string, opts *Options) error {
+	l.Debugf("Force-unlocking state lock %s for the %s backend", lockID, remote.BackendName)
+
+	return remote.backend.ForceUnlock(ctx, l, remote.BackendConfig, lockID, &opts.Options)
+}
+
 // Delete deletes the remote state.
 func (remote *RemoteState) Delete(ctx context.Context, l log.Logger, opts *Options) error {
 	l.Debugf("Deleting remote state for the %s backend", remote.BackendName)
@@ -93,15 +120,22 @@ func (remote *RemoteState) Bootstrap(ctx context.Context, l log.Logger, opts *Op
 	return remote.backend.Bootstrap(ctx, l, remote.BackendConfig, &opts.Options)
 }
 
-// Migrate determines where the remote state resources exist for source backend config and migrate them to dest backend config.
-func (remote *RemoteState) Migrate(ctx context.Context, l log.Logger, exec vexec.Exec, opts, dstOpts *Options, dstRemote *RemoteState) error {
+// Migrate moves the source state described by the given plan, as returned by PlanMigration, to the destination
+// remote state. The caller is expected to have checked the plan with MigrationPlan.Check. When both remote states use
+// the same backend, the backend moves the state itself; otherwise the state is copied with `state push`.
+func (remote *RemoteState) Migrate(ctx context.Context, l log.Logger, exec vexec.Exec, opts, dstOpts *Options, dstRemote *RemoteState, plan *MigrationPlan, force bool) error {
 	l.Debugf("Migrate remote state for the %s backend", remote.BackendName)
 
+	if !plan.Src.Exists {
+		l.Infof("Source %s backend has no state, nothing to migrate", remote.BackendName)
+		return nil
+	}
+
 	if remote.BackendName == dstRemote.BackendName {
 		return remote.backend.Migrate(ctx, l, remote.BackendConfig, dstRemote.BackendConfig, &opts.Options)
 	}
 
-	stateFile, err := remote.pullState(ctx, l, exec, opts.TFRunOpts)
+	stateFile, err := writeTempStateFile(l, plan.srcState)
 	if err != nil {
 		return err
 	}
@@ -112,7 +146,46 @@ func (remote *RemoteState) Migrate(ctx context.Context, l log.Logger, exec vexec
 		}
 	}()
 
-	return dstRemote.pushState(ctx, l, exec, dstOpts.TFRunOpts, stateFile)
+	// Lineage and serial have already been checked by the caller, an empty destination state
+	// would otherwise be rejected by OpenTofu/Terraform as an unrelated lineage.
+	return dstRemote.pushState(ctx, l, exec, dstOpts.TFRunOpts, stateFile, force || plan.Dst.IsEmpty())
+}
+
+// PlanMigration reads the source and destination states and describes what `Migrate` would move, without changing
+// anything. The states are read directly from the backends that support it, and with `state pull` otherwise.
+func (remote *RemoteState) PlanMigration(ctx context.Context, l log.Logger, exec vexec.Exec, opts, dstOpts *Options, dstRemote *RemoteState) (*MigrationPlan, error) {
+	srcState, err := remote.readState(ctx, l, exec, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dstState, err := dstRemote.readState(ctx, l, exec, dstOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	srcSummary, err := NewStateSummary(remote.BackendName, srcState)
+	if err != nil {
+		return nil, fmt.Errorf("parsing source state: %w", err)
+	}
+
+	dstSummary, err := NewStateSummary(dstRemote.BackendName, dstState)
+	if err != nil {
+		return nil, fmt.Errorf("parsing destination state: %w", err)
+	}
+
+	return &MigrationPlan{Src: srcSummary, Dst: dstSummary, srcState: srcState}, nil
+}
+
+// readState returns the raw state of the remote state, read directly from the backend if it supports it.
+func (remote *RemoteState) readState(ctx context.Context, l log.Logger, exec vexec.Exec, opts *Options) ([]byte, error) {
+	if reader, ok := remote.backend.(backend.StateReader); ok {
+		l.Debugf("Reading state from %s backend", remote.BackendName)
+
+		return reader.ReadState(ctx, l, remote.BackendConfig, &opts.Options)
+	}
+
+	return remote.pullState(ctx, l, exec, opts.TFRunOpts)
 }
 
 // NeedsBootstrap returns true if remote state needs to be configured. This will be the case when:
@@ -176,16 +249,34 @@ func (remote *RemoteState) GenerateOpenTofuCode(l log.Logger, workingDir string)
 	return remote.Config.GenerateOpenTofuCode(l, workingDir, backendConfig)
 }
 
-func (remote *RemoteState) pullState(ctx context.Context, l log.Logger, exec vexec.Exec, tfOpts *tf.TFOptions) (string, error) {
+func (remote *RemoteState) pullState(ctx context.Context, l log.Logger, exec vexec.Exec, tfOpts *tf.TFOptions) ([]byte, error) {
 	l.Debugf("Pulling state from %s backend", remote.BackendName)
 
 	args := []string{tf.CommandNameState, tf.CommandNamePull}
 
 	output, err := tf.RunCommandWithOutput(ctx, l, exec, tfOpts, args...)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return output.Stdout.Bytes(), nil
+}
+
+func (remote *RemoteState) pushState(ctx context.Context, l log.Logger, exec vexec.Exec, tfOpts *tf.TFOptions, stateFile string, force bool) error {
+	l.Debugf("Pushing state to %s backend", remote.BackendName)
+
+	args := []string{tf.CommandNameState, tf.CommandNamePush}
+
+	if force {
+		args = append(args, "-force")
 	}
 
+	args = append(args, stateFile)
+
+	return tf.RunCommand(ctx, l, exec, tfOpts, args...)
+}
+
+func writeTempStateFile(l log.Logger, state []byte) (string, error) {
 	l.Debugf("Creating temporary state file for migration")
 
 	file, err := os.CreateTemp("", "*.tfstate")
@@ -197,17 +288,9 @@ func (remote *RemoteState) pullState(ctx context.Context, l log.Logger, exec vex
 		file.Close() // nolint: errcheck
 	}()
 
-	if _, err := file.Write(output.Stdout.Bytes()); err != nil {
+	if _, err := file.Write(state); err != nil {
 		return file.Name(), err
 	}
 
 	return file.Name(), nil
 }
-
-func (remote *RemoteState) pushState(ctx context.Context, l log.Logger, exec vexec.Exec, tfOpts *tf.TFOptions, stateFile string) error {
-	l.Debugf("Pushing state to %s backend", remote.BackendName)
-
-	args := []string{tf.CommandNameState, tf.CommandNamePush, stateFile}
-
-	return tf.RunCommand(ctx, l, exec, tfOpts, args...)
-}
@@ -23,10 +23,13 @@ const DefaultPathToRemoteStateFile = "terraform.tfstate"
 
 // TerraformState - represents the structure of the Terraform .tfstate file.
 type TerraformState struct {
-	Backend *TerraformBackend      `json:"Backend"`
-	Modules []TerraformStateModule `json:"Modules"`
-	Version int                    `json:"Version"`
-	Serial  int                    `json:"Serial"`
+	Backend   *TerraformBackend      `json:"Backend"`
+	Lineage   string                 `json:"Lineage"`
+	Modules   []TerraformStateModule `json:"Modules"`
+	Resources []any                  `json:"Resources"`
+	Outputs   map[string]any         `json:"Outputs"`
+	Version   int                    `json:"Version"`
+	Serial    int                    `json:"Serial"`
 }
 
 // TerraformBackend represents the structure of the "backend" section in the Terraform .tfstate file.
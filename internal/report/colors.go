@@ -115,3 +115,12 @@ func (c *Colorizer) colorDuration(duration time.Duration) string {
 
 	return c.minuteColorizer(fmt.Sprintf("%dm", int(duration.Minutes())))
 }
+
+// colorChanges returns the changes as a string, with additions, changes and destructions colored.
+func (c *Colorizer) colorChanges(changes Changes) string {
+	return fmt.Sprintf("%s %s %s",
+		c.successUnitColorizer(fmt.Sprintf("+%d", changes.Add)),
+		c.exitUnitColorizer(fmt.Sprintf("~%d", changes.Change)),
+		c.failureUnitColorizer(fmt.Sprintf("-%d", changes.Destroy)),
+	)
+}
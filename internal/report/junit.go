@@ -0,0 +1,196 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// junitSuiteName is the name of the test suite the runs of a report are written in.
+const junitSuiteName = "terragrunt"
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Time      string          `xml:"time,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+}
+
+type junitTestCase struct {
+	Failure    *junitResult    `xml:"failure,omitempty"`
+	Skipped    *junitResult    `xml:"skipped,omitempty"`
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// WriteJUnit writes the report to a writer in JUnit XML format.
+//
+// Every run is written as a test case of a single test suite, named after the unit and classified by the command
+// run in it. Failed runs are written as failures and runs that exited early or were excluded as skipped, with the
+// reason of the result as message and its cause as text.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	suite := junitTestSuite{
+		Name:  junitSuiteName,
+		Cases: make([]junitTestCase, 0, len(r.Runs)),
+	}
+
+	var (
+		started time.Time
+		ended   time.Time
+	)
+
+	for _, run := range r.Runs {
+		testCase, runStarted, runEnded := run.junitTestCase(r.workingDir)
+
+		if started.IsZero() || runStarted.Before(started) {
+			started = runStarted
+		}
+
+		if runEnded.After(ended) {
+			ended = runEnded
+		}
+
+		switch {
+		case testCase.Failure != nil:
+			suite.Failures++
+		case testCase.Skipped != nil:
+			suite.Skipped++
+		}
+
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	suite.Tests = len(suite.Cases)
+	suite.Time = junitDuration(started, ended)
+
+	if !started.IsZero() {
+		suite.Timestamp = started.Format(time.RFC3339)
+	}
+
+	suites := junitTestSuites{
+		Name:     junitSuiteName,
+		Time:     suite.Time,
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Suites:   []junitTestSuite{suite},
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+// junitTestCase returns the run as a JUnit test case, along with when it started and ended, handling its own locking.
+func (r *Run) junitTestCase(reportWorkingDir string) (junitTestCase, time.Time, time.Time) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	workingDir := effectiveWorkingDir(r, reportWorkingDir)
+
+	className := r.Cmd
+	if className == "" {
+		className = junitSuiteName
+	}
+
+	testCase := junitTestCase{
+		Name:      nameOfPath(r.Path, workingDir),
+		ClassName: className,
+		Time:      junitDuration(r.Started, r.Ended),
+		Properties: []junitProperty{
+			{Name: "result", Value: string(r.Result)},
+		},
+	}
+
+	reason := ""
+	if r.Reason != nil {
+		reason = string(*r.Reason)
+
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "reason", Value: reason})
+	}
+
+	cause := formatCause(r, workingDir)
+	if cause != "" {
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "cause", Value: cause})
+	}
+
+	if r.Ref != "" {
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "ref", Value: r.Ref})
+	}
+
+	switch r.Result {
+	case ResultFailed:
+		lines := make([]string, 0, len(r.Diagnostics)+1)
+		if cause != "" {
+			lines = append(lines, cause)
+		}
+
+		for _, diag := range r.Diagnostics {
+			lines = append(lines, diag.String())
+		}
+
+		testCase.Failure = &junitResult{Message: reason, Type: reason, Text: strings.Join(lines, "\n")}
+	case ResultEarlyExit, ResultExcluded:
+		message := reason
+		if cause != "" {
+			message = fmt.Sprintf("%s: %s", reason, cause)
+		}
+
+		testCase.Skipped = &junitResult{Message: message}
+	case ResultSucceeded:
+	}
+
+	return testCase, r.Started, r.Ended
+}
+
+// junitDuration returns the duration between started and ended in seconds, as written in JUnit reports.
+func junitDuration(started, ended time.Time) string {
+	if started.IsZero() || ended.Before(started) {
+		return "0.000"
+	}
+
+	return fmt.Sprintf("%.3f", ended.Sub(started).Seconds())
+}
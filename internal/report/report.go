@@ -28,7 +28,9 @@ type Run struct {
 	Ended               time.Time
 	Reason              *Reason
 	Cause               *Cause
+	Changes             *Changes
 	Path                string
+	Diagnostics         []Diagnostic
 	Result              Result
 	DiscoveryWorkingDir string
 	Ref                 string
@@ -46,12 +48,62 @@ type Reason string
 // Cause captures the cause of a run.
 type Cause string
 
+// Changes captures the number of resources a plan adds, changes and destroys.
+type Changes struct {
+	Add     int
+	Change  int
+	Destroy int
+}
+
+// Diagnostic captures a problem found in a file during a run, such as an HCL error in the unit configuration or an
+// issue reported by tflint.
+type Diagnostic struct {
+	// Source is the tool that reported the problem, e.g. hcl or tflint.
+	Source string
+	// Rule identifies the check that failed, if the source has named rules.
+	Rule string
+	// HelpURI links to the documentation of the rule, if any.
+	HelpURI     string
+	Severity    Severity
+	Message     string
+	File        string
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+}
+
+// String returns the diagnostic as a single line, prefixed by its location.
+func (d Diagnostic) String() string {
+	source := d.Source
+	if d.Rule != "" {
+		source += "/" + d.Rule
+	}
+
+	if d.File == "" {
+		return fmt.Sprintf("[%s] %s", source, d.Message)
+	}
+
+	return fmt.Sprintf("%s:%d,%d: [%s] %s", d.File, d.StartLine, d.StartColumn, source, d.Message)
+}
+
+// Severity captures the severity of a diagnostic.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+)
+
 // Format captures the format of a report.
 type Format string
 
 const (
-	FormatCSV  Format = "csv"
-	FormatJSON Format = "json"
+	FormatCSV   Format = "csv"
+	FormatJSON  Format = "json"
+	FormatJUnit Format = "junit"
+	FormatSARIF Format = "sarif"
 )
 
 const (
@@ -62,11 +114,12 @@ const (
 )
 
 const (
-	ReasonRetrySucceeded Reason = "retry succeeded"
-	ReasonErrorIgnored   Reason = "error ignored"
-	ReasonRunError       Reason = "run error"
-	ReasonExcludeBlock   Reason = "exclude block"
-	ReasonAncestorError  Reason = "ancestor error"
+	ReasonRetrySucceeded  Reason = "retry succeeded"
+	ReasonErrorIgnored    Reason = "error ignored"
+	ReasonRunError        Reason = "run error"
+	ReasonExcludeBlock    Reason = "exclude block"
+	ReasonAncestorError   Reason = "ancestor error"
+	ReasonPolicyViolation Reason = "policy violation"
 )
 
 // NewReport creates a new report.
@@ -257,6 +310,37 @@ func (r *Report) EndRun(l log.Logger, path string, endOptions ...EndOption) erro
 	return nil
 }
 
+// CopyRuns returns copies of the runs, which are safe to read while the runs are still in progress.
+func (r *Report) CopyRuns() []*Run {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	runs := make([]*Run, 0, len(r.Runs))
+
+	for _, run := range r.Runs {
+		run.mu.RLock()
+
+		runs = append(runs, &Run{
+			Started:             run.Started,
+			Ended:               run.Ended,
+			Reason:              run.Reason,
+			Cause:               run.Cause,
+			Changes:             run.Changes,
+			Path:                run.Path,
+			Diagnostics:         slices.Clone(run.Diagnostics),
+			Result:              run.Result,
+			DiscoveryWorkingDir: run.DiscoveryWorkingDir,
+			Ref:                 run.Ref,
+			Cmd:                 run.Cmd,
+			Args:                slices.Clone(run.Args),
+		})
+
+		run.mu.RUnlock()
+	}
+
+	return runs
+}
+
 func (r *Report) SortRuns() {
 	slices.SortFunc(r.Runs, func(a, b *Run) int {
 		return a.Started.Compare(b.Started)
@@ -280,6 +364,20 @@ func WithReason(reason Reason) EndOption {
 	}
 }
 
+// WithChanges sets the planned changes of a run.
+func WithChanges(changes Changes) EndOption {
+	return func(run *Run) {
+		run.Changes = &changes
+	}
+}
+
+// WithDiagnostics adds diagnostics explaining the failure of a run.
+func WithDiagnostics(diags ...Diagnostic) EndOption {
+	return func(run *Run) {
+		run.Diagnostics = append(run.Diagnostics, diags...)
+	}
+}
+
 // WithCauseRetryBlock sets the cause of a run to the name of a particular retry block.
 //
 // This function is a wrapper around withCause, just to make sure that authors always use consistent
@@ -320,6 +418,14 @@ func WithCauseRunError(name string) EndOption {
 	return withCause(name)
 }
 
+// WithCausePolicy sets the cause of a run to the name of a particular policy.
+//
+// This function is a wrapper around withCause, just to make sure that authors always use consistent
+// reasons for causes.
+func WithCausePolicy(name string) EndOption {
+	return withCause(name)
+}
+
 // WithDiscoveryWorkingDir sets the discovery working directory for a run.
 // This is used to compute relative paths for units discovered in worktrees.
 func WithDiscoveryWorkingDir(workingDir string) EndOption {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/csv"
 	"encoding/json"
+	"encoding/xml"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -783,7 +784,8 @@ const ExpectedSchema = `{
           "error ignored",
           "run error",
           "exclude block",
-          "ancestor error"
+          "ancestor error",
+          "policy violation"
         ]
       },
       "Cause": {
@@ -993,6 +995,49 @@ func TestWriteSummary(t *testing.T) {
    Failed       2
    Early Exits  2
    Excluded     2
+`,
+		},
+		{
+			name: "plan with changes",
+			setup: func(l log.Logger, r *report.Report) {
+				firstRun := newRun(t, filepath.Join(tmp, "first-plan-run"))
+				r.AddRun(l, firstRun)
+				r.EndRun(l, firstRun.Path, report.WithChanges(report.Changes{Add: 2, Change: 1}))
+
+				secondRun := newRun(t, filepath.Join(tmp, "second-plan-run"))
+				r.AddRun(l, secondRun)
+				r.EndRun(l, secondRun.Path, report.WithChanges(report.Changes{Add: 1, Destroy: 3}))
+			},
+			expected: `
+❯❯ Run Summary  2 units  x
+   ────────────────────────────
+   Succeeded    2
+   Changes      +3 ~1 -3
+`,
+		},
+		{
+			name: "policy violation",
+			setup: func(l log.Logger, r *report.Report) {
+				firstRun := newRun(t, filepath.Join(tmp, "first-policy-run"))
+				r.AddRun(l, firstRun)
+				r.EndRun(l, firstRun.Path)
+
+				secondRun := newRun(t, filepath.Join(tmp, "second-policy-run"))
+				r.AddRun(l, secondRun)
+				r.EndRun(
+					l,
+					secondRun.Path,
+					report.WithResult(report.ResultFailed),
+					report.WithReason(report.ReasonPolicyViolation),
+					report.WithCausePolicy("no-db-deletes"),
+				)
+			},
+			expected: `
+❯❯ Run Summary  2 units  x
+   ────────────────────────────
+   Succeeded    1
+   Failed       1
+   Policy Violations  1
 `,
 		},
 	}
@@ -1461,6 +1506,32 @@ func TestWriteUnitLevelSummary(t *testing.T) {
 	}
 }
 
+func TestWriteUnitLevelSummaryWithChanges(t *testing.T) {
+	t.Parallel()
+
+	tmp := helpers.TmpDirWOSymlinks(t)
+
+	l := logger.CreateLogger()
+
+	r := report.NewReport().
+		WithDisableColor().
+		WithShowUnitLevelSummary().
+		WithWorkingDir(tmp)
+
+	run := newRun(t, filepath.Join(tmp, "plan-run"))
+	require.NoError(t, r.AddRun(l, run))
+	require.NoError(t, r.EndRun(l, run.Path, report.WithChanges(report.Changes{Add: 1, Change: 2})))
+
+	var buf bytes.Buffer
+
+	require.NoError(t, r.WriteSummary(&buf))
+
+	re := regexp.MustCompile(`plan-run \.+ \S+  (.+)\n`)
+	matches := re.FindStringSubmatch(buf.String())
+	require.Len(t, matches, 2, buf.String())
+	assert.Equal(t, "+1 ~2 -0", matches[1])
+}
+
 // TestWriteJSONWithDiscoveryWorkingDir verifies that when a run has a DiscoveryWorkingDir set,
 // the report writer uses it instead of the report's workingDir for path computation.
 // This is critical for worktree scenarios where units are discovered in temporary worktree directories.
@@ -2084,6 +2155,220 @@ func TestSchemaValidationError(t *testing.T) {
 }
 
 // newRun creates a new run, and asserts that it doesn't error.
+func TestWriteJUnit(t *testing.T) {
+	t.Parallel()
+
+	l := logger.CreateLogger()
+	dir := t.TempDir()
+
+	r := report.NewReport().WithWorkingDir(dir)
+
+	succeeded := newRun(t, filepath.Join(dir, "succeeded"))
+	require.NoError(t, r.AddRun(l, succeeded))
+	require.NoError(t, r.EndRun(l, succeeded.Path, report.WithCmd("plan")))
+
+	failed := newRun(t, filepath.Join(dir, "failed"))
+	require.NoError(t, r.AddRun(l, failed))
+	require.NoError(t, r.EndRun(
+		l,
+		failed.Path,
+		report.WithResult(report.ResultFailed),
+		report.WithReason(report.ReasonRunError),
+		report.WithCauseRunError("exit status 1"),
+		report.WithDiagnostics(report.Diagnostic{
+			Source:      "tflint",
+			Rule:        "terraform_unused_declarations",
+			Message:     "variable \"foo\" is declared but not used",
+			File:        filepath.Join(dir, "failed", "main.tf"),
+			StartLine:   3,
+			StartColumn: 1,
+		}),
+	))
+
+	earlyExit := newRun(t, filepath.Join(dir, "early-exit"))
+	require.NoError(t, r.AddRun(l, earlyExit))
+	require.NoError(t, r.EndRun(
+		l,
+		earlyExit.Path,
+		report.WithResult(report.ResultEarlyExit),
+		report.WithReason(report.ReasonAncestorError),
+		report.WithCauseAncestorExit("failed"),
+	))
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteJUnit(&buf))
+
+	type result struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Text    string `xml:",chardata"`
+	}
+
+	var suites struct {
+		Suites []struct {
+			Name  string `xml:"name,attr"`
+			Cases []struct {
+				Failure    *result `xml:"failure"`
+				Skipped    *result `xml:"skipped"`
+				Name       string  `xml:"name,attr"`
+				ClassName  string  `xml:"classname,attr"`
+				Time       string  `xml:"time,attr"`
+				Properties []struct {
+					Name  string `xml:"name,attr"`
+					Value string `xml:"value,attr"`
+				} `xml:"properties>property"`
+			} `xml:"testcase"`
+			Tests    int `xml:"tests,attr"`
+			Failures int `xml:"failures,attr"`
+			Skipped  int `xml:"skipped,attr"`
+		} `xml:"testsuite"`
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Skipped  int `xml:"skipped,attr"`
+	}
+
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &suites))
+
+	assert.Equal(t, 3, suites.Tests)
+	assert.Equal(t, 1, suites.Failures)
+	assert.Equal(t, 1, suites.Skipped)
+	require.Len(t, suites.Suites, 1)
+
+	cases := suites.Suites[0].Cases
+	require.Len(t, cases, 3)
+
+	assert.Equal(t, "succeeded", cases[0].Name)
+	assert.Equal(t, "plan", cases[0].ClassName)
+	assert.Nil(t, cases[0].Failure)
+	assert.Nil(t, cases[0].Skipped)
+	assert.Regexp(t, `^\d+\.\d{3}$`, cases[0].Time)
+
+	assert.Equal(t, "failed", cases[1].Name)
+	assert.Equal(t, "terragrunt", cases[1].ClassName)
+	require.NotNil(t, cases[1].Failure)
+	assert.Equal(t, "run error", cases[1].Failure.Message)
+	assert.Contains(t, cases[1].Failure.Text, "exit status 1")
+	assert.Contains(t, cases[1].Failure.Text, "main.tf:3,1: [tflint/terraform_unused_declarations]")
+	require.Len(t, cases[1].Properties, 3)
+	assert.Equal(t, "cause", cases[1].Properties[2].Name)
+	assert.Equal(t, "exit status 1", cases[1].Properties[2].Value)
+
+	assert.Equal(t, "early-exit", cases[2].Name)
+	require.NotNil(t, cases[2].Skipped)
+	assert.Equal(t, "ancestor error: failed", cases[2].Skipped.Message)
+}
+
+func TestWriteSARIF(t *testing.T) {
+	t.Parallel()
+
+	l := logger.CreateLogger()
+	dir := t.TempDir()
+
+	r := report.NewReport().WithWorkingDir(dir)
+
+	succeeded := newRun(t, filepath.Join(dir, "succeeded"))
+	require.NoError(t, r.AddRun(l, succeeded))
+	require.NoError(t, r.EndRun(l, succeeded.Path))
+
+	invalid := newRun(t, filepath.Join(dir, "invalid"))
+	require.NoError(t, r.AddRun(l, invalid))
+	require.NoError(t, r.EndRun(
+		l,
+		invalid.Path,
+		report.WithResult(report.ResultFailed),
+		report.WithReason(report.ReasonRunError),
+		report.WithDiagnostics(report.Diagnostic{
+			Source:      "hcl",
+			Severity:    report.SeverityError,
+			Message:     "Unsupported argument; An argument named \"foo\" is not expected here.",
+			File:        filepath.Join(dir, "invalid", "terragrunt.hcl"),
+			StartLine:   2,
+			StartColumn: 3,
+			EndLine:     2,
+			EndColumn:   6,
+		}),
+	))
+
+	failed := newRun(t, filepath.Join(dir, "failed"))
+	require.NoError(t, r.AddRun(l, failed))
+	require.NoError(t, r.EndRun(
+		l,
+		failed.Path,
+		report.WithResult(report.ResultFailed),
+		report.WithReason(report.ReasonRunError),
+		report.WithCauseRunError("exit status 1"),
+	))
+
+	var buf bytes.Buffer
+	require.NoError(t, r.WriteSARIF(&buf))
+
+	type location struct {
+		PhysicalLocation struct {
+			Region *struct {
+				StartLine   int `json:"startLine"`
+				StartColumn int `json:"startColumn"`
+				EndLine     int `json:"endLine"`
+				EndColumn   int `json:"endColumn"`
+			} `json:"region"`
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+		} `json:"physicalLocation"`
+	}
+
+	var sarif struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID  string `json:"ruleId"`
+				Level   string `json:"level"`
+				Message struct {
+					Text string `json:"text"`
+				} `json:"message"`
+				Locations []location `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &sarif))
+
+	assert.Equal(t, "2.1.0", sarif.Version)
+	require.Len(t, sarif.Runs, 1)
+
+	run := sarif.Runs[0]
+	assert.Equal(t, "terragrunt", run.Tool.Driver.Name)
+	require.Len(t, run.Tool.Driver.Rules, 2)
+	assert.Equal(t, "hcl", run.Tool.Driver.Rules[0].ID)
+	assert.Equal(t, "terragrunt/run-error", run.Tool.Driver.Rules[1].ID)
+
+	require.Len(t, run.Results, 2)
+
+	hclResult := run.Results[0]
+	assert.Equal(t, "hcl", hclResult.RuleID)
+	assert.Equal(t, "error", hclResult.Level)
+	assert.Contains(t, hclResult.Message.Text, "Unsupported argument")
+	require.Len(t, hclResult.Locations, 1)
+	assert.Equal(t, "invalid/terragrunt.hcl", hclResult.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.NotNil(t, hclResult.Locations[0].PhysicalLocation.Region)
+	assert.Equal(t, 2, hclResult.Locations[0].PhysicalLocation.Region.StartLine)
+	assert.Equal(t, 6, hclResult.Locations[0].PhysicalLocation.Region.EndColumn)
+
+	runResult := run.Results[1]
+	assert.Equal(t, "terragrunt/run-error", runResult.RuleID)
+	assert.Equal(t, "Unit failed failed: exit status 1", runResult.Message.Text)
+	require.Len(t, runResult.Locations, 1)
+	assert.Equal(t, "failed/terragrunt.hcl", runResult.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Nil(t, runResult.Locations[0].PhysicalLocation.Region)
+}
+
 func newRun(t *testing.T, name string) *report.Run {
 	t.Helper()
 
@@ -0,0 +1,239 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	sarifToolName = "terragrunt"
+	sarifToolURI  = "https://terragrunt.gruntwork.io"
+
+	// sarifDefaultConfigFile is the file failures are located in when they aren't attributed to any particular
+	// file, relative to the unit they occurred in.
+	sarifDefaultConfigFile = "terragrunt.hcl"
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID      string `json:"id"`
+	HelpURI string `json:"helpUri,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	Region           *sarifRegion          `json:"region,omitempty"`
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// WriteSARIF writes the report to a writer in SARIF format.
+//
+// Only failed runs produce results. Every diagnostic of a failed run is written as a result located at the range of
+// the file it was found in, and failed runs without any diagnostics are written as a single result located in the
+// configuration of the unit. File locations are relative to the working directory of the report when they are in it.
+func (r *Report) WriteSARIF(w io.Writer) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           sarifToolName,
+				InformationURI: sarifToolURI,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	rules := make(map[string]struct{})
+
+	for _, reportRun := range r.Runs {
+		for _, result := range reportRun.sarifResults(r.workingDir) {
+			if _, ok := rules[result.rule.ID]; !ok {
+				rules[result.rule.ID] = struct{}{}
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, result.rule)
+			}
+
+			run.Results = append(run.Results, result.sarifResult)
+		}
+	}
+
+	jsonBytes, err := json.MarshalIndent(sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{run},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	jsonBytes = append(jsonBytes, '\n')
+
+	_, err = w.Write(jsonBytes)
+
+	return err
+}
+
+// sarifRuleResult is a SARIF result along with the rule it was reported by.
+type sarifRuleResult struct {
+	rule sarifRule
+	sarifResult
+}
+
+// sarifResults returns the SARIF results of the run, handling its own locking.
+func (r *Run) sarifResults(reportWorkingDir string) []sarifRuleResult {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.Result != ResultFailed {
+		return nil
+	}
+
+	workingDir := effectiveWorkingDir(r, reportWorkingDir)
+	name := nameOfPath(r.Path, workingDir)
+
+	if len(r.Diagnostics) == 0 {
+		reason := ReasonRunError
+		if r.Reason != nil {
+			reason = *r.Reason
+		}
+
+		message := "Unit " + name + " failed: " + string(reason)
+		if cause := formatCause(r, workingDir); cause != "" {
+			message = "Unit " + name + " failed: " + cause
+		}
+
+		ruleID := sarifToolName + "/" + strings.ReplaceAll(string(reason), " ", "-")
+
+		return []sarifRuleResult{{
+			rule: sarifRule{ID: ruleID},
+			sarifResult: sarifResult{
+				RuleID:  ruleID,
+				Level:   string(SeverityError),
+				Message: sarifMessage{Text: message},
+				Locations: []sarifLocation{{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{
+							URI: sarifURI(filepath.Join(r.Path, sarifDefaultConfigFile), workingDir),
+						},
+					},
+				}},
+			},
+		}}
+	}
+
+	results := make([]sarifRuleResult, 0, len(r.Diagnostics))
+
+	for _, diag := range r.Diagnostics {
+		ruleID := diag.Source
+		if diag.Rule != "" {
+			ruleID += "/" + diag.Rule
+		}
+
+		file := diag.File
+		if file == "" {
+			file = filepath.Join(r.Path, sarifDefaultConfigFile)
+		}
+
+		var region *sarifRegion
+		if diag.StartLine > 0 {
+			region = &sarifRegion{
+				StartLine:   diag.StartLine,
+				StartColumn: diag.StartColumn,
+				EndLine:     diag.EndLine,
+				EndColumn:   diag.EndColumn,
+			}
+		}
+
+		level := diag.Severity
+		if level == "" {
+			level = SeverityError
+		}
+
+		results = append(results, sarifRuleResult{
+			rule: sarifRule{ID: ruleID, HelpURI: diag.HelpURI},
+			sarifResult: sarifResult{
+				RuleID:  ruleID,
+				Level:   string(level),
+				Message: sarifMessage{Text: diag.Message},
+				Locations: []sarifLocation{{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: sarifURI(file, workingDir)},
+						Region:           region,
+					},
+				}},
+			},
+		})
+	}
+
+	return results
+}
+
+// sarifURI returns the URI of a file in a SARIF report: its slash-separated path relative to the working directory
+// if it is in it, or a file URI otherwise.
+func sarifURI(path, workingDir string) string {
+	if !filepath.IsAbs(path) {
+		return filepath.ToSlash(path)
+	}
+
+	if workingDir != "" {
+		if rel, err := filepath.Rel(workingDir, path); err == nil && rel != ".." &&
+			!strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(rel)
+		}
+	}
+
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
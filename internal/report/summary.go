@@ -15,6 +15,7 @@ import (
 type Summary struct {
 	firstRunStart        *time.Time
 	lastRunEnd           *time.Time
+	Changes              *Changes
 	padder               string
 	workingDir           string
 	runs                 []*Run
@@ -22,6 +23,7 @@ type Summary struct {
 	UnitsFailed          int
 	EarlyExits           int
 	Excluded             int
+	PolicyViolations     int
 	shouldColor          bool
 	showUnitLevelSummary bool
 }
@@ -66,6 +68,20 @@ func (s *Summary) Update(run *Run) {
 		s.Excluded++
 	}
 
+	if run.Reason != nil && *run.Reason == ReasonPolicyViolation {
+		s.PolicyViolations++
+	}
+
+	if run.Changes != nil {
+		if s.Changes == nil {
+			s.Changes = &Changes{}
+		}
+
+		s.Changes.Add += run.Changes.Add
+		s.Changes.Change += run.Changes.Change
+		s.Changes.Destroy += run.Changes.Destroy
+	}
+
 	if s.firstRunStart == nil || run.Started.Before(*s.firstRunStart) {
 		s.firstRunStart = &run.Started
 	}
@@ -181,6 +197,26 @@ func (s *Summary) Write(w io.Writer) error {
 		}
 	}
 
+	if s.PolicyViolations > 0 {
+		if err := s.writeSummaryEntry(
+			w,
+			colorizer.failureColorizer(policyViolationsLabel),
+			colorizer.failureUnitColorizer(strconv.Itoa(s.PolicyViolations)),
+		); err != nil {
+			return err
+		}
+	}
+
+	if s.Changes != nil {
+		if err := s.writeSummaryEntry(
+			w,
+			colorizer.headingUnitColorizer(changesLabel),
+			colorizer.colorChanges(*s.Changes),
+		); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -192,6 +228,8 @@ const (
 	failureLabel               = "Failed"
 	earlyExitLabel             = "Early Exits"
 	excludeLabel               = "Excluded"
+	changesLabel               = "Changes"
+	policyViolationsLabel      = "Policy Violations"
 	separatorLineLength        = 28
 	durationAlignmentOffset    = 4
 	headerUnitCountSpacing     = 2
@@ -349,12 +387,18 @@ func (s *Summary) writeUnitDuration(
 
 	padding := s.unitDurationPadding(name, colorizer)
 
+	changes := ""
+	if run.Changes != nil {
+		changes = "  " + colorizer.colorChanges(*run.Changes)
+	}
+
 	_, err := fmt.Fprintf(
-		w, "%s%s%s%s\n",
+		w, "%s%s%s%s%s\n",
 		strings.Repeat(prefix, unitPrefixMultiplier),
 		unitColorizer(name),
 		padding,
 		colorizer.colorDuration(duration),
+		changes,
 	)
 	if err != nil {
 		return err
@@ -33,7 +33,7 @@ type JSONRun struct {
 	Ended time.Time `json:"Ended" jsonschema:"required"`
 	// Reason is the reason for the run result, if any.
 	//nolint:lll
-	Reason *string `json:"Reason,omitempty" jsonschema:"enum=retry succeeded,enum=error ignored,enum=run error,enum=exclude block,enum=ancestor error"`
+	Reason *string `json:"Reason,omitempty" jsonschema:"enum=retry succeeded,enum=error ignored,enum=run error,enum=exclude block,enum=ancestor error,enum=policy violation"`
 	// Cause is the cause of the run result, if any.
 	Cause *string `json:"Cause,omitempty"`
 	// Name is the name of the run.
@@ -238,6 +238,10 @@ func (r *Report) WriteToFile(fsys vfs.FS, path string) error {
 		writeBody = r.WriteCSV
 	case FormatJSON:
 		writeBody = r.WriteJSON
+	case FormatJUnit:
+		writeBody = r.WriteJUnit
+	case FormatSARIF:
+		writeBody = r.WriteSARIF
 	default:
 		return fmt.Errorf("unsupported format: %s", r.format)
 	}
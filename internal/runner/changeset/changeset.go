@@ -0,0 +1,189 @@
+// Package changeset aggregates the plans of a `run --all plan` into a single change set.
+//
+// Each unit's plan is read from the `show -json` output written to `--json-out-dir`, and summarized as
+// the number of resources to add, change and destroy, the resources to replace, and the output changes,
+// the same way `terraform plan` summarizes them.
+package changeset
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+)
+
+const (
+	// DefaultFileName is the name of the change set file written in the `--json-out-dir` directory.
+	DefaultFileName = "changes.json"
+
+	changeSetFilePerm = 0644
+	changeSetDirPerm  = 0755
+)
+
+// Plan actions, as reported by `show -json`.
+const (
+	actionNoOp   = "no-op"
+	actionCreate = "create"
+	actionRead   = "read"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+// ChangeSet is the change set of a run across all units.
+type ChangeSet struct {
+	Units   []*UnitChanges `json:"units"`
+	Add     int            `json:"add"`
+	Change  int            `json:"change"`
+	Destroy int            `json:"destroy"`
+}
+
+// UnitChanges are the changes planned for a unit.
+type UnitChanges struct {
+	Path     string          `json:"path"`
+	Replaced []string        `json:"replaced,omitempty"`
+	Outputs  []*OutputChange `json:"outputs,omitempty"`
+	Add      int             `json:"add"`
+	Change   int             `json:"change"`
+	Destroy  int             `json:"destroy"`
+}
+
+// OutputChange is a change planned for an output of a unit.
+type OutputChange struct {
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+// ResourceChange is a change planned for a resource, as reported by `show -json`.
+type ResourceChange struct {
+	Change  *Change `json:"change"`
+	Address string  `json:"address"`
+	Type    string  `json:"type"`
+}
+
+// Change holds the actions planned for a resource or an output.
+type Change struct {
+	Actions []string `json:"actions"`
+}
+
+// plan is the subset of the `show -json` plan representation used to compute the changes.
+type plan struct {
+	OutputChanges   map[string]*Change `json:"output_changes"`
+	ResourceChanges []*ResourceChange  `json:"resource_changes"`
+}
+
+// New creates a change set from the changes of the units, sorted by unit path.
+func New(units []*UnitChanges) *ChangeSet {
+	changeSet := &ChangeSet{
+		Units: slices.Clone(units),
+	}
+
+	slices.SortFunc(changeSet.Units, func(a, b *UnitChanges) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	for _, unit := range changeSet.Units {
+		changeSet.Add += unit.Add
+		changeSet.Change += unit.Change
+		changeSet.Destroy += unit.Destroy
+	}
+
+	return changeSet
+}
+
+// IsReplace returns true if the resource is destroyed and re-created.
+func (resource *ResourceChange) IsReplace() bool {
+	return resource.has(actionCreate) && resource.has(actionDelete)
+}
+
+// IsCreate returns true if the resource is created, without replacing an existing one.
+func (resource *ResourceChange) IsCreate() bool {
+	return resource.has(actionCreate) && !resource.has(actionDelete)
+}
+
+// IsUpdate returns true if the resource is updated in-place.
+func (resource *ResourceChange) IsUpdate() bool {
+	return resource.has(actionUpdate)
+}
+
+// IsDelete returns true if the resource is destroyed, without being re-created.
+func (resource *ResourceChange) IsDelete() bool {
+	return resource.has(actionDelete) && !resource.has(actionCreate)
+}
+
+func (resource *ResourceChange) has(action string) bool {
+	return resource.Change != nil && slices.Contains(resource.Change.Actions, action)
+}
+
+// ParseResourceChanges returns the resource changes of a `show -json` plan output.
+func ParseResourceChanges(data []byte) ([]*ResourceChange, error) {
+	var p plan
+
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+
+	return p.ResourceChanges, nil
+}
+
+// ParsePlan computes the changes of a unit from its `show -json` plan output.
+// Replacing a resource counts as both adding and destroying it, as in the `terraform plan` summary.
+func ParsePlan(path string, data []byte) (*UnitChanges, error) {
+	var p plan
+
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse plan of %s: %w", path, err)
+	}
+
+	unit := &UnitChanges{Path: path}
+
+	for _, resource := range p.ResourceChanges {
+		switch {
+		case resource.IsReplace():
+			unit.Add++
+			unit.Destroy++
+			unit.Replaced = append(unit.Replaced, resource.Address)
+		case resource.IsCreate():
+			unit.Add++
+		case resource.IsUpdate():
+			unit.Change++
+		case resource.IsDelete():
+			unit.Destroy++
+		}
+	}
+
+	for name, output := range p.OutputChanges {
+		if output == nil || len(output.Actions) == 0 {
+			continue
+		}
+
+		action := output.Actions[0]
+		if action == actionNoOp || action == actionRead {
+			continue
+		}
+
+		unit.Outputs = append(unit.Outputs, &OutputChange{Name: name, Action: action})
+	}
+
+	slices.SortFunc(unit.Outputs, func(a, b *OutputChange) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return unit, nil
+}
+
+// Write writes the change set as JSON to the given path.
+func (changeSet *ChangeSet) Write(fsys vfs.FS, path string) error {
+	data, err := json.MarshalIndent(changeSet, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := fsys.MkdirAll(filepath.Dir(path), changeSetDirPerm); err != nil {
+		return err
+	}
+
+	return vfs.WriteFile(fsys, path, append(data, '\n'), changeSetFilePerm)
+}
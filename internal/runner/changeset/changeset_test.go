@@ -0,0 +1,82 @@
+package changeset_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/changeset"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPlan = `{
+  "format_version": "1.2",
+  "resource_changes": [
+    {"address": "aws_vpc.main", "change": {"actions": ["no-op"]}},
+    {"address": "aws_subnet.a", "change": {"actions": ["create"]}},
+    {"address": "aws_subnet.b", "change": {"actions": ["create"]}},
+    {"address": "aws_security_group.web", "change": {"actions": ["update"]}},
+    {"address": "aws_instance.web", "change": {"actions": ["delete", "create"]}},
+    {"address": "aws_instance.old", "change": {"actions": ["delete"]}},
+    {"address": "data.aws_ami.ubuntu", "change": {"actions": ["read"]}}
+  ],
+  "output_changes": {
+    "vpc_id": {"actions": ["no-op"]},
+    "subnet_ids": {"actions": ["create"]},
+    "instance_ip": {"actions": ["update"]}
+  }
+}`
+
+func TestParsePlan(t *testing.T) {
+	t.Parallel()
+
+	changes, err := changeset.ParsePlan("network", []byte(testPlan))
+	require.NoError(t, err)
+
+	assert.Equal(t, &changeset.UnitChanges{
+		Path:     "network",
+		Add:      3,
+		Change:   1,
+		Destroy:  2,
+		Replaced: []string{"aws_instance.web"},
+		Outputs: []*changeset.OutputChange{
+			{Name: "instance_ip", Action: "update"},
+			{Name: "subnet_ids", Action: "create"},
+		},
+	}, changes)
+}
+
+func TestParsePlanInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := changeset.ParsePlan("network", []byte(`not json`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse plan of network")
+}
+
+func TestChangeSetWrite(t *testing.T) {
+	t.Parallel()
+
+	fs := vfs.NewMemMapFS()
+
+	changeSet := changeset.New([]*changeset.UnitChanges{
+		{Path: "service", Add: 1},
+		{Path: "network", Add: 2, Change: 1, Destroy: 3},
+	})
+
+	assert.Equal(t, 3, changeSet.Add)
+	assert.Equal(t, 1, changeSet.Change)
+	assert.Equal(t, 3, changeSet.Destroy)
+
+	require.NoError(t, changeSet.Write(fs, "/out/"+changeset.DefaultFileName))
+
+	data, err := vfs.ReadFile(fs, "/out/"+changeset.DefaultFileName)
+	require.NoError(t, err)
+
+	written := &changeset.ChangeSet{}
+	require.NoError(t, json.Unmarshal(data, written))
+
+	assert.Equal(t, changeSet, written)
+	assert.Equal(t, "network", written.Units[0].Path)
+}
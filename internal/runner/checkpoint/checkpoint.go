@@ -0,0 +1,352 @@
+// Package checkpoint persists the state of a `run --all`, so that a run that stopped part way through
+// can be resumed with `--resume`, without rerunning the units that already succeeded.
+//
+// A checkpoint records the status of every unit in the run queue, the runs of the run report,
+// and a fingerprint of every unit's configuration and code. Resuming is rejected if the command,
+// the set of units, or any unit's fingerprint changed since the checkpoint was written.
+package checkpoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/internal/tf"
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+)
+
+const (
+	// DefaultFileName is the name of the checkpoint file written with --resume, in the Terragrunt cache directory
+	// of the working directory, unless --checkpoint-file is set.
+	DefaultFileName = "run-checkpoint.json"
+
+	// formatVersion is the version of the checkpoint file format.
+	formatVersion = 1
+
+	checkpointFilePerm = 0644
+	checkpointDirPerm  = 0755
+)
+
+// Status is the status of a unit in a checkpoint.
+type Status string
+
+const (
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusEarlyExit Status = "early exit"
+	StatusNotRun    Status = "not run"
+)
+
+// Checkpoint is the persisted state of a run.
+type Checkpoint struct {
+	Command string        `json:"command"`
+	Args    []string      `json:"args"`
+	Units   []*Unit       `json:"units"`
+	Runs    []*report.Run `json:"runs"`
+	Version int           `json:"version"`
+}
+
+// Unit is the persisted state of a unit in a run.
+type Unit struct {
+	Path        string `json:"path"`
+	Status      Status `json:"status"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// DefaultPath returns the default path of the checkpoint file for the given working directory.
+func DefaultPath(workingDir string) string {
+	return filepath.Join(workingDir, util.TerragruntCacheDir, DefaultFileName)
+}
+
+// New creates a checkpoint of the queue entry statuses and report runs of a run, which may still be in progress.
+// fingerprints maps unit paths to their fingerprints, see Fingerprint.
+func New(command string, args []string, q *queue.Queue, r *report.Report, fingerprints map[string]string) *Checkpoint {
+	checkpoint := &Checkpoint{
+		Version: formatVersion,
+		Command: command,
+		Args:    args,
+		Units:   make([]*Unit, 0, len(q.Entries)),
+	}
+
+	for _, entry := range q.Entries {
+		path := entry.Component.Path()
+
+		checkpoint.Units = append(checkpoint.Units, &Unit{
+			Path:        path,
+			Status:      statusFromQueue(q.EntryStatus(entry)),
+			Fingerprint: fingerprints[path],
+		})
+	}
+
+	sort.Slice(checkpoint.Units, func(i, j int) bool {
+		return checkpoint.Units[i].Path < checkpoint.Units[j].Path
+	})
+
+	if r != nil {
+		checkpoint.Runs = r.CopyRuns()
+	}
+
+	return checkpoint
+}
+
+// statusFromQueue converts a queue entry status to a checkpoint status.
+func statusFromQueue(status queue.Status) Status {
+	switch status {
+	case queue.StatusSucceeded:
+		return StatusSucceeded
+	case queue.StatusFailed:
+		return StatusFailed
+	case queue.StatusEarlyExit:
+		return StatusEarlyExit
+	case queue.StatusPending, queue.StatusBlocked, queue.StatusUnsorted, queue.StatusReady, queue.StatusRunning:
+		return StatusNotRun
+	}
+
+	return StatusNotRun
+}
+
+// Load reads a checkpoint from the given path.
+func Load(fsys vfs.FS, path string) (*Checkpoint, error) {
+	data, err := vfs.ReadFile(fsys, path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, NotFoundError{Path: path}
+		}
+
+		return nil, fmt.Errorf("failed to read checkpoint %s: %w", path, err)
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint %s: %w", path, err)
+	}
+
+	if checkpoint.Version != formatVersion {
+		return nil, UnsupportedVersionError{Path: path, Version: checkpoint.Version}
+	}
+
+	return checkpoint, nil
+}
+
+// Save writes the checkpoint to the given path. The file is replaced atomically, so that a run killed while saving
+// leaves the previous checkpoint intact.
+func (checkpoint *Checkpoint) Save(fsys vfs.FS, path string) error {
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := fsys.MkdirAll(filepath.Dir(path), checkpointDirPerm); err != nil {
+		return err
+	}
+
+	tempPath := path + ".tmp"
+
+	if err := vfs.WriteFile(fsys, tempPath, append(data, '\n'), checkpointFilePerm); err != nil {
+		return err
+	}
+
+	return fsys.Rename(tempPath, path)
+}
+
+// Remove deletes the checkpoint file at the given path, if there is one.
+func Remove(fsys vfs.FS, path string) error {
+	if err := fsys.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// Completed returns true if all units of the checkpoint succeeded.
+func (checkpoint *Checkpoint) Completed() bool {
+	for _, unit := range checkpoint.Units {
+		if unit.Status != StatusSucceeded {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Succeeded returns the paths of the units that succeeded.
+func (checkpoint *Checkpoint) Succeeded() []string {
+	var paths []string
+
+	for _, unit := range checkpoint.Units {
+		if unit.Status == StatusSucceeded {
+			paths = append(paths, unit.Path)
+		}
+	}
+
+	return paths
+}
+
+// Run returns the report run of the unit with the given path, or nil if there is none.
+func (checkpoint *Checkpoint) Run(path string) *report.Run {
+	for _, run := range checkpoint.Runs {
+		if run.Path == path {
+			return run
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the checkpoint was written for the same command and units,
+// and that the configuration of none of the units changed since.
+func (checkpoint *Checkpoint) Validate(command string, args []string, fingerprints map[string]string) error {
+	if checkpoint.Command != command || !slices.Equal(checkpoint.Args, args) {
+		return CommandMismatchError{
+			Expected: checkpoint.commandLine(),
+			Actual:   commandLine(command, args),
+		}
+	}
+
+	var mismatch FingerprintMismatchError
+
+	recorded := make(map[string]struct{}, len(checkpoint.Units))
+
+	for _, unit := range checkpoint.Units {
+		recorded[unit.Path] = struct{}{}
+
+		fingerprint, ok := fingerprints[unit.Path]
+		if !ok {
+			mismatch.Removed = append(mismatch.Removed, unit.Path)
+
+			continue
+		}
+
+		if fingerprint != unit.Fingerprint {
+			mismatch.Changed = append(mismatch.Changed, unit.Path)
+		}
+	}
+
+	for path := range fingerprints {
+		if _, ok := recorded[path]; !ok {
+			mismatch.Added = append(mismatch.Added, path)
+		}
+	}
+
+	if len(mismatch.Added) > 0 || len(mismatch.Removed) > 0 || len(mismatch.Changed) > 0 {
+		slices.Sort(mismatch.Added)
+
+		return mismatch
+	}
+
+	return nil
+}
+
+func (checkpoint *Checkpoint) commandLine() string {
+	return commandLine(checkpoint.Command, checkpoint.Args)
+}
+
+// Fingerprint returns a fingerprint of the configuration and code of a unit: its configuration file, the files read
+// while parsing it, such as included configurations, its own OpenTofu/Terraform files, and its module source. The
+// files of a local module source are fingerprinted too, while a remote source is fingerprinted by its URL.
+func Fingerprint(fsys vfs.FS, unit *component.Unit) (string, error) {
+	configFile := unit.ConfigFile()
+	if configFile == "" {
+		configFile = config.DefaultTerragruntConfigPath
+	}
+
+	files := append([]string{filepath.Join(unit.Path(), configFile)}, unit.Reading()...)
+
+	unitCode, err := codeFiles(fsys, unit.Path(), false)
+	if err != nil {
+		return "", err
+	}
+
+	files = append(files, unitCode...)
+
+	sources := unit.Sources()
+
+	for _, source := range sources {
+		sourceURL, err := tf.ToSourceURL(source, unit.Path())
+		if err != nil || !tf.IsLocalSource(sourceURL) {
+			continue
+		}
+
+		moduleCode, err := codeFiles(fsys, filepath.Clean(sourceURL.Path), true)
+		if err != nil {
+			return "", err
+		}
+
+		files = append(files, moduleCode...)
+	}
+
+	files = util.RemoveDuplicates(files)
+
+	hash := sha256.New()
+
+	for _, source := range sources {
+		fmt.Fprintf(hash, "source\x00%s\x00", source)
+	}
+
+	for _, file := range files {
+		data, err := vfs.ReadFile(fsys, file)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// Record missing files, so that creating them later changes the fingerprint.
+				data = nil
+			} else {
+				return "", fmt.Errorf("failed to fingerprint %s: %w", file, err)
+			}
+		}
+
+		fileHash := sha256.Sum256(data)
+
+		fmt.Fprintf(hash, "%s\x00%x\x00", file, fileHash)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// skippedCodeDirs are the directories whose files are not part of the code of a unit or module.
+var skippedCodeDirs = []string{util.TerragruntCacheDir, util.TerraformCacheDir, util.GitDir}
+
+// codeFiles returns the OpenTofu/Terraform files in dir, sorted, and those of its subdirectories if recursive.
+// The Terragrunt and OpenTofu/Terraform cache directories are skipped, and a missing dir has no files.
+func codeFiles(fsys vfs.FS, dir string, recursive bool) ([]string, error) {
+	var files []string
+
+	err := vfs.WalkDir(fsys, dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == dir && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+
+			return err
+		}
+
+		if entry.IsDir() {
+			if path != dir && (!recursive || slices.Contains(skippedCodeDirs, entry.Name())) {
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+
+		if util.IsTFFile(path) {
+			files = append(files, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint %s: %w", dir, err)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,215 @@
+package checkpoint_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/internal/runner/checkpoint"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointSaveLoad(t *testing.T) {
+	t.Parallel()
+
+	fs := vfs.NewMemMapFS()
+
+	q, err := queue.NewQueue(component.Components{
+		component.NewUnit("/stack/vpc"),
+		component.NewUnit("/stack/db"),
+		component.NewUnit("/stack/app"),
+	})
+	require.NoError(t, err)
+
+	q.SetEntryStatus(q.EntryByPath("/stack/vpc"), queue.StatusSucceeded)
+	q.SetEntryStatus(q.EntryByPath("/stack/db"), queue.StatusFailed)
+
+	r := report.NewReport()
+	r.Runs = append(r.Runs, &report.Run{Path: "/stack/vpc", Result: report.ResultSucceeded})
+
+	fingerprints := map[string]string{"/stack/vpc": "a", "/stack/db": "b", "/stack/app": "c"}
+
+	path := checkpoint.DefaultPath("/stack")
+	assert.Equal(t, filepath.Join("/stack", ".terragrunt-cache", "run-checkpoint.json"), path)
+
+	saved := checkpoint.New("apply", []string{"-auto-approve"}, q, r, fingerprints)
+	assert.False(t, saved.Completed())
+	require.NoError(t, saved.Save(fs, path))
+
+	loaded, err := checkpoint.Load(fs, path)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/stack/vpc"}, loaded.Succeeded())
+	assert.Equal(t, []*checkpoint.Unit{
+		{Path: "/stack/app", Status: checkpoint.StatusNotRun, Fingerprint: "c"},
+		{Path: "/stack/db", Status: checkpoint.StatusFailed, Fingerprint: "b"},
+		{Path: "/stack/vpc", Status: checkpoint.StatusSucceeded, Fingerprint: "a"},
+	}, loaded.Units)
+
+	run := loaded.Run("/stack/vpc")
+	require.NotNil(t, run)
+	assert.Equal(t, report.ResultSucceeded, run.Result)
+	assert.Nil(t, loaded.Run("/stack/db"))
+
+	require.NoError(t, loaded.Validate("apply", []string{"-auto-approve"}, fingerprints))
+
+	require.NoError(t, checkpoint.Remove(fs, path))
+	require.NoError(t, checkpoint.Remove(fs, path))
+
+	_, err = checkpoint.Load(fs, path)
+	require.ErrorAs(t, err, &checkpoint.NotFoundError{})
+}
+
+func TestCheckpointValidate(t *testing.T) {
+	t.Parallel()
+
+	saved := &checkpoint.Checkpoint{
+		Command: "apply",
+		Args:    []string{"-auto-approve"},
+		Units: []*checkpoint.Unit{
+			{Path: "/stack/app", Fingerprint: "a"},
+			{Path: "/stack/db", Fingerprint: "b"},
+		},
+	}
+
+	testCases := []struct {
+		expected     error
+		fingerprints map[string]string
+		name         string
+		command      string
+	}{
+		{
+			name:         "unchanged",
+			command:      "apply",
+			fingerprints: map[string]string{"/stack/app": "a", "/stack/db": "b"},
+		},
+		{
+			name:         "different command",
+			command:      "destroy",
+			fingerprints: map[string]string{"/stack/app": "a", "/stack/db": "b"},
+			expected:     checkpoint.CommandMismatchError{Expected: "apply -auto-approve", Actual: "destroy -auto-approve"},
+		},
+		{
+			name:         "changed units",
+			command:      "apply",
+			fingerprints: map[string]string{"/stack/app": "changed", "/stack/vpc": "c"},
+			expected: checkpoint.FingerprintMismatchError{
+				Added:   []string{"/stack/vpc"},
+				Removed: []string{"/stack/db"},
+				Changed: []string{"/stack/app"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := saved.Validate(tc.command, []string{"-auto-approve"}, tc.fingerprints)
+			if tc.expected == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	fs := vfs.NewMemMapFS()
+
+	require.NoError(t, vfs.WriteFile(fs, "/stack/app/terragrunt.hcl", []byte(`include "root" {}`), 0644))
+	require.NoError(t, vfs.WriteFile(fs, "/stack/root.hcl", []byte(`locals {}`), 0644))
+
+	unit := component.NewUnit("/stack/app").WithReading("/stack/root.hcl")
+
+	before, err := checkpoint.Fingerprint(fs, unit)
+	require.NoError(t, err)
+
+	again, err := checkpoint.Fingerprint(fs, unit)
+	require.NoError(t, err)
+	assert.Equal(t, before, again)
+
+	require.NoError(t, vfs.WriteFile(fs, "/stack/root.hcl", []byte(`locals { region = "us-east-1" }`), 0644))
+
+	after, err := checkpoint.Fingerprint(fs, unit)
+	require.NoError(t, err)
+	assert.NotEqual(t, before, after)
+}
+
+func TestFingerprintUnitCode(t *testing.T) {
+	t.Parallel()
+
+	fs := vfs.NewMemMapFS()
+
+	require.NoError(t, vfs.WriteFile(fs, "/stack/app/terragrunt.hcl", []byte(`terraform { source = "../modules//app" }`), 0644))
+	require.NoError(t, vfs.WriteFile(fs, "/stack/app/main.tf", []byte(`resource "null_resource" "app" {}`), 0644))
+	require.NoError(t, vfs.WriteFile(fs, "/stack/app/.terragrunt-cache/abc/main.tf", []byte(`# copy`), 0644))
+	require.NoError(t, vfs.WriteFile(fs, "/stack/modules/app/main.tf", []byte(`variable "name" {}`), 0644))
+	require.NoError(t, vfs.WriteFile(fs, "/stack/modules/app/nested/outputs.tf", []byte(`output "name" {}`), 0644))
+
+	source := "../modules//app"
+	unit := component.NewUnit("/stack/app").WithConfig(&config.TerragruntConfig{
+		Terraform: &config.TerraformConfig{Source: &source},
+	})
+
+	fingerprint := func() string {
+		t.Helper()
+
+		fingerprint, err := checkpoint.Fingerprint(fs, unit)
+		require.NoError(t, err)
+
+		return fingerprint
+	}
+
+	before := fingerprint()
+
+	// Cached copies of the code do not change the fingerprint.
+	require.NoError(t, vfs.WriteFile(fs, "/stack/app/.terragrunt-cache/abc/main.tf", []byte(`# other copy`), 0644))
+	assert.Equal(t, before, fingerprint())
+
+	// Changes to the code of the unit do.
+	require.NoError(t, vfs.WriteFile(fs, "/stack/app/main.tf", []byte(`resource "null_resource" "other" {}`), 0644))
+
+	afterUnitChange := fingerprint()
+	assert.NotEqual(t, before, afterUnitChange)
+
+	// And so do changes to the code of its local module source.
+	require.NoError(t, vfs.WriteFile(fs, "/stack/modules/app/nested/outputs.tf", []byte(`output "id" {}`), 0644))
+	assert.NotEqual(t, afterUnitChange, fingerprint())
+}
+
+func TestFingerprintRemoteSource(t *testing.T) {
+	t.Parallel()
+
+	fs := vfs.NewMemMapFS()
+
+	require.NoError(t, vfs.WriteFile(fs, "/stack/app/terragrunt.hcl", []byte(`terraform {}`), 0644))
+
+	fingerprint := func(source string) string {
+		t.Helper()
+
+		unit := component.NewUnit("/stack/app").WithConfig(&config.TerragruntConfig{
+			Terraform: &config.TerraformConfig{Source: &source},
+		})
+
+		fingerprint, err := checkpoint.Fingerprint(fs, unit)
+		require.NoError(t, err)
+
+		return fingerprint
+	}
+
+	assert.NotEqual(t,
+		fingerprint("git::https://github.com/acme/modules.git//app?ref=v1.0.0"),
+		fingerprint("git::https://github.com/acme/modules.git//app?ref=v1.1.0"),
+	)
+}
@@ -0,0 +1,64 @@
+package checkpoint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NotFoundError is returned when resuming a run without a checkpoint.
+type NotFoundError struct {
+	Path string
+}
+
+func (err NotFoundError) Error() string {
+	return fmt.Sprintf("no checkpoint to resume from found at %s", err.Path)
+}
+
+// UnsupportedVersionError is returned when a checkpoint was written by an incompatible version of Terragrunt.
+type UnsupportedVersionError struct {
+	Path    string
+	Version int
+}
+
+func (err UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("checkpoint %s has unsupported format version %d", err.Path, err.Version)
+}
+
+// CommandMismatchError is returned when resuming a run with a different command than the checkpointed run.
+type CommandMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+func (err CommandMismatchError) Error() string {
+	return fmt.Sprintf("cannot resume: the checkpoint was written for %q, not %q", err.Expected, err.Actual)
+}
+
+// FingerprintMismatchError is returned when units were added, removed or changed since the checkpoint was written.
+type FingerprintMismatchError struct {
+	Added   []string
+	Removed []string
+	Changed []string
+}
+
+func (err FingerprintMismatchError) Error() string {
+	var details []string
+
+	if len(err.Added) > 0 {
+		details = append(details, "added: "+strings.Join(err.Added, ", "))
+	}
+
+	if len(err.Removed) > 0 {
+		details = append(details, "removed: "+strings.Join(err.Removed, ", "))
+	}
+
+	if len(err.Changed) > 0 {
+		details = append(details, "changed: "+strings.Join(err.Changed, ", "))
+	}
+
+	return "cannot resume: units changed since the checkpoint was written (" + strings.Join(details, "; ") + ")"
+}
+
+func commandLine(command string, args []string) string {
+	return strings.TrimSpace(command + " " + strings.Join(args, " "))
+}
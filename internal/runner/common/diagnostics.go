@@ -0,0 +1,86 @@
+package common
+
+import (
+	"errors"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/internal/tflint"
+)
+
+const (
+	hclDiagnosticSource    = "hcl"
+	tflintDiagnosticSource = "tflint"
+)
+
+// ReportDiagnostics returns the report diagnostics locating the failure of a run, from the HCL diagnostics and the
+// tflint issues found in the chain of err.
+func ReportDiagnostics(err error) []report.Diagnostic {
+	var diags []report.Diagnostic
+
+	var hclDiags hcl.Diagnostics
+	if errors.As(err, &hclDiags) {
+		diags = append(diags, HCLReportDiagnostics(hclDiags)...)
+	}
+
+	var tflintErr tflint.ErrorRunningTflint
+	if errors.As(err, &tflintErr) {
+		for _, issue := range tflintErr.Issues {
+			severity := report.Severity(issue.Rule.Severity)
+			if severity != report.SeverityError && severity != report.SeverityWarning {
+				severity = report.SeverityNote
+			}
+
+			diags = append(diags, report.Diagnostic{
+				Source:      tflintDiagnosticSource,
+				Rule:        issue.Rule.Name,
+				HelpURI:     issue.Rule.Link,
+				Severity:    severity,
+				Message:     issue.Message,
+				File:        issue.Range.Filename,
+				StartLine:   issue.Range.Start.Line,
+				StartColumn: issue.Range.Start.Column,
+				EndLine:     issue.Range.End.Line,
+				EndColumn:   issue.Range.End.Column,
+			})
+		}
+	}
+
+	return diags
+}
+
+// HCLReportDiagnostics converts HCL diagnostics to report diagnostics.
+func HCLReportDiagnostics(hclDiags hcl.Diagnostics) []report.Diagnostic {
+	diags := make([]report.Diagnostic, 0, len(hclDiags))
+
+	for _, hclDiag := range hclDiags {
+		severity := report.SeverityError
+		if hclDiag.Severity == hcl.DiagWarning {
+			severity = report.SeverityWarning
+		}
+
+		message := hclDiag.Summary
+		if hclDiag.Detail != "" {
+			message += "; " + hclDiag.Detail
+		}
+
+		diag := report.Diagnostic{
+			Source:   hclDiagnosticSource,
+			Severity: severity,
+			Message:  message,
+		}
+
+		if hclDiag.Subject != nil {
+			diag.File = hclDiag.Subject.Filename
+			diag.StartLine = hclDiag.Subject.Start.Line
+			diag.StartColumn = hclDiag.Subject.Start.Column
+			diag.EndLine = hclDiag.Subject.End.Line
+			diag.EndColumn = hclDiag.Subject.End.Column
+		}
+
+		diags = append(diags, diag)
+	}
+
+	return diags
+}
@@ -3,8 +3,10 @@ package common
 import (
 	"bytes"
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/gruntwork-io/terragrunt/internal/component"
 	"github.com/gruntwork-io/terragrunt/internal/configbridge"
@@ -105,13 +107,26 @@ func (runner *UnitRunner) runTerragrunt(
 		unitPath := runner.Unit.Path()
 		unitPath = filepath.Clean(unitPath)
 
-		if runErr != nil {
+		var policyErr run.PolicyViolationError
+
+		if errors.As(runErr, &policyErr) {
+			if endErr := r.EndRun(
+				l,
+				unitPath,
+				report.WithResult(report.ResultFailed),
+				report.WithReason(report.ReasonPolicyViolation),
+				report.WithCausePolicy(strings.Join(policyErr.Policies(), ", ")),
+			); endErr != nil {
+				l.Errorf("Error ending run for unit %s: %v", unitPath, endErr)
+			}
+		} else if runErr != nil {
 			if endErr := r.EndRun(
 				l,
 				unitPath,
 				report.WithResult(report.ResultFailed),
 				report.WithReason(report.ReasonRunError),
 				report.WithCauseRunError(runErr.Error()),
+				report.WithDiagnostics(ReportDiagnostics(runErr)...),
 			); endErr != nil {
 				l.Errorf("Error ending run for unit %s: %v", unitPath, endErr)
 			}
@@ -150,7 +165,9 @@ func (runner *UnitRunner) Run(
 	}
 
 	// convert terragrunt output to json
-	if runner.Unit.OutputJSONFile(opts.RootWorkingDir, opts.JSONOutputFolder) != "" {
+	outputFile := runner.Unit.OutputJSONFile(opts.RootWorkingDir, PlanJSONOutputFolder(opts))
+
+	if outputFile != "" {
 		jsonLogger, jsonOptions, err := opts.CloneWithConfigPath(
 			l,
 			opts.TerragruntConfigPath,
@@ -178,7 +195,6 @@ func (runner *UnitRunner) Run(
 		}
 
 		// save the json output to the file plan file
-		outputFile := runner.Unit.OutputJSONFile(opts.RootWorkingDir, opts.JSONOutputFolder)
 		jsonDir := filepath.Dir(outputFile)
 
 		if err := os.MkdirAll(jsonDir, os.ModePerm); err != nil {
@@ -192,3 +208,18 @@ func (runner *UnitRunner) Run(
 
 	return nil
 }
+
+// PlanJSONOutputFolder returns the folder the plans of the units are converted to JSON in, or an empty string if they
+// aren't. Without --json-out-dir, binary plans saved to --out-dir are only converted to JSON next to them when the
+// changes of each unit are asked for, by --summary-per-unit or --report-file, since it costs an extra `show -json`.
+func PlanJSONOutputFolder(opts *options.TerragruntOptions) string {
+	if opts.JSONOutputFolder != "" {
+		return opts.JSONOutputFolder
+	}
+
+	if opts.TerraformCommand == tf.CommandNamePlan && (opts.SummaryPerUnit || opts.ReportFile != "") {
+		return opts.OutputFolder
+	}
+
+	return ""
+}
@@ -0,0 +1,86 @@
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	tea "charm.land/bubbletea/v2"
+	"golang.org/x/term"
+
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// ErrRunCancelled is the cause of the cancellation of a run that the user quit from the dashboard.
+var ErrRunCancelled = errors.New("run cancelled from the dashboard")
+
+// Available reports whether the dashboard can be displayed, which requires stdin and stdout to be terminals.
+func Available() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// Run displays the dashboard of the run of the given queue while running it with run, and returns the error of the
+// run once both the run completed and the user closed the dashboard. The context passed to run is cancelled if the
+// user quits the dashboard before the run completes, in which case the cause of the cancellation is returned, even if
+// the run itself returned no error.
+//
+// The output of the units must be written to their logs in the tracker, and any other output of the run to its run
+// log. After the dashboard is closed, the run log and the logs of the failed units are written to errWriter, so that
+// they remain available once the dashboard is gone.
+func Run(
+	ctx context.Context,
+	l log.Logger,
+	q *queue.Queue,
+	tracker *Tracker,
+	controls Controls,
+	errWriter io.Writer,
+	run func(ctx context.Context) error,
+) error {
+	runCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	cancelRun := func() { cancel(ErrRunCancelled) }
+
+	program := tea.NewProgram(NewModel(q, tracker, controls, cancelRun), tea.WithContext(ctx))
+
+	runErrCh := make(chan error, 1)
+
+	go func() {
+		err := run(runCtx)
+		runErrCh <- err
+
+		program.Send(RunFinishedMsg{Err: err})
+	}()
+
+	if _, err := program.Run(); err != nil {
+		l.Debugf("Run dashboard stopped: %v", err)
+	}
+
+	// The dashboard may be closed before the run completes if it failed, so wait for the run either way.
+	runErr := <-runErrCh
+
+	writeFailedLogs(errWriter, q, tracker)
+
+	// A cancelled run stops scheduling units without failing, so it must not be reported as a success.
+	if runErr == nil && runCtx.Err() != nil {
+		return context.Cause(runCtx)
+	}
+
+	return runErr
+}
+
+// writeFailedLogs writes the run log and the logs of the failed units of the queue.
+func writeFailedLogs(w io.Writer, q *queue.Queue, tracker *Tracker) {
+	fmt.Fprint(w, tracker.RunLog())
+
+	for _, entry := range q.Entries {
+		if q.EntryStatus(entry) != queue.StatusFailed {
+			continue
+		}
+
+		fmt.Fprintf(w, "Logs of failed unit %s:\n%s\n", entry.Component.DisplayPath(), tracker.Unit(entry.Component.Path()))
+	}
+}
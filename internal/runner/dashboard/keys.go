@@ -0,0 +1,65 @@
+package dashboard
+
+import (
+	"charm.land/bubbles/v2/key"
+)
+
+// KeyMap defines the keybindings of the dashboard.
+type KeyMap struct {
+	Up         key.Binding
+	Down       key.Binding
+	Logs       key.Binding
+	Back       key.Binding
+	Cancel     key.Binding
+	Skip       key.Binding
+	ToggleView key.Binding
+	Quit       key.Binding
+}
+
+// NewKeyMap returns the default keybindings of the dashboard.
+func NewKeyMap() KeyMap {
+	return KeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("k", "up"),
+			key.WithHelp("k/↑", "move up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("j", "down"),
+			key.WithHelp("j/↓", "move down"),
+		),
+		Logs: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "show logs"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "backspace"),
+			key.WithHelp("esc", "back"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "cancel unit"),
+		),
+		Skip: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "skip unit"),
+		),
+		ToggleView: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "toggle dag/list"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("q", "ctrl+c"),
+			key.WithHelp("q", "quit"),
+		),
+	}
+}
+
+// ShortHelp implements help.KeyMap.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Logs, k.Cancel, k.Skip, k.ToggleView, k.Quit}
+}
+
+// FullHelp implements help.KeyMap.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
@@ -0,0 +1,348 @@
+// Package dashboard implements a full-screen terminal dashboard for run --all, showing the run queue with the
+// status, elapsed time and last log line of each unit, and allowing to view the logs of a unit, and to cancel or
+// skip units while the run is in progress.
+package dashboard
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+)
+
+// refreshInterval is how often the dashboard polls the queue and the unit logs.
+const refreshInterval = 250 * time.Millisecond
+
+// Controls cancels and skips the units of a run.
+type Controls interface {
+	// Cancel cancels the unit at the given path if it is running, returning false otherwise.
+	Cancel(path string) bool
+	// Skip prevents the unit at the given path from running if it hasn't started, returning false otherwise.
+	Skip(path string) bool
+}
+
+type sessionState int
+
+const (
+	queueState sessionState = iota
+	logState
+)
+
+// RunFinishedMsg is sent to the dashboard when the run completes.
+type RunFinishedMsg struct {
+	Err error
+}
+
+type tickMsg time.Time
+
+// row is a unit displayed in the queue.
+type row struct {
+	entry        *queue.Entry
+	name         string
+	dependencies []string
+	depth        int
+}
+
+// Model is the bubbletea model of the dashboard.
+type Model struct {
+	now       func() time.Time
+	controls  Controls
+	tracker   *Tracker
+	queue     *queue.Queue
+	cancelRun context.CancelFunc
+	notice    string
+	listRows  []row
+	dagRows   []row
+	help      help.Model
+	keys      KeyMap
+	viewport  viewport.Model
+	runErr    error
+	cursor    int
+	width     int
+	height    int
+	state     sessionState
+	dagView   bool
+	finished  bool
+	quitting  bool
+}
+
+// NewModel creates a dashboard of the run of the given queue. cancelRun is called when the user quits the
+// dashboard while the run is in progress.
+func NewModel(q *queue.Queue, tracker *Tracker, controls Controls, cancelRun context.CancelFunc) Model {
+	return Model{
+		now:       time.Now,
+		controls:  controls,
+		tracker:   tracker,
+		queue:     q,
+		cancelRun: cancelRun,
+		listRows:  listRows(q),
+		dagRows:   dagRows(q),
+		help:      help.New(),
+		keys:      NewKeyMap(),
+		viewport:  viewport.New(viewport.WithWidth(0), viewport.WithHeight(0)),
+	}
+}
+
+// Init implements bubbletea.Model.Init.
+func (m Model) Init() tea.Cmd {
+	return tick()
+}
+
+// Update implements bubbletea.Model.Update.
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.help.SetWidth(msg.Width)
+		m.viewport.SetWidth(msg.Width)
+		m.viewport.SetHeight(max(msg.Height-logChromeHeight, 1))
+
+		return m, nil
+	case tickMsg:
+		if m.state == logState {
+			m.refreshLogs()
+		}
+
+		return m, tick()
+	case RunFinishedMsg:
+		m.finished = true
+		m.runErr = msg.Err
+
+		if m.quitting {
+			return m, tea.Quit
+		}
+
+		return m, nil
+	case tea.KeyPressMsg:
+		if key.Matches(msg, m.keys.Quit) {
+			return m.quit()
+		}
+
+		if m.state == logState {
+			return m.updateLogs(msg)
+		}
+
+		return m.updateQueue(msg)
+	}
+
+	return m, nil
+}
+
+func (m Model) updateQueue(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	rows := m.rows()
+
+	switch {
+	case key.Matches(msg, m.keys.Up):
+		m.cursor = max(m.cursor-1, 0)
+	case key.Matches(msg, m.keys.Down):
+		m.cursor = min(m.cursor+1, len(rows)-1)
+	case key.Matches(msg, m.keys.ToggleView):
+		// Keep the selected unit selected in the other view.
+		selected := m.selected()
+		m.dagView = !m.dagView
+		m.cursor = max(slices.IndexFunc(m.rows(), func(r row) bool { return r.entry == selected }), 0)
+	case key.Matches(msg, m.keys.Logs):
+		if m.selected() != nil {
+			m.state = logState
+			m.refreshLogs()
+			m.viewport.GotoBottom()
+		}
+	case key.Matches(msg, m.keys.Cancel):
+		m.notice = m.act(m.controls.Cancel, "Cancelling %s", "%s is not running")
+	case key.Matches(msg, m.keys.Skip):
+		m.notice = m.act(m.controls.Skip, "Skipped %s", "%s can't be skipped, it already started")
+	}
+
+	return m, nil
+}
+
+func (m Model) updateLogs(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		m.state = queueState
+
+		return m, nil
+	case key.Matches(msg, m.keys.Cancel):
+		m.notice = m.act(m.controls.Cancel, "Cancelling %s", "%s is not running")
+
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+
+	m.viewport, cmd = m.viewport.Update(msg)
+
+	return m, cmd
+}
+
+// quit quits the dashboard. While the run is in progress, the first quit asks for confirmation, and the second one
+// cancels the run and quits once it stopped.
+func (m Model) quit() (tea.Model, tea.Cmd) {
+	if m.finished {
+		return m, tea.Quit
+	}
+
+	if m.quitting {
+		return m, nil
+	}
+
+	if m.notice != quitConfirmNotice {
+		m.notice = quitConfirmNotice
+
+		return m, nil
+	}
+
+	m.quitting = true
+	m.notice = "Cancelling the run..."
+
+	if m.cancelRun != nil {
+		m.cancelRun()
+	}
+
+	return m, nil
+}
+
+const quitConfirmNotice = "The run is in progress, press q again to cancel it and quit"
+
+// act applies the action to the selected unit, and returns the notice to display.
+func (m Model) act(action func(path string) bool, doneFormat, failedFormat string) string {
+	entry := m.selected()
+	if entry == nil {
+		return ""
+	}
+
+	path := entry.Component.Path()
+	name := entry.Component.DisplayPath()
+
+	if m.finished || !action(path) {
+		return fmt.Sprintf(failedFormat, name)
+	}
+
+	return fmt.Sprintf(doneFormat, name)
+}
+
+// refreshLogs updates the log viewport with the logs of the selected unit, following new output when scrolled to
+// the bottom.
+func (m *Model) refreshLogs() {
+	entry := m.selected()
+	if entry == nil {
+		return
+	}
+
+	follow := m.viewport.AtBottom()
+
+	m.viewport.SetContent(m.tracker.Unit(entry.Component.Path()).String())
+
+	if follow {
+		m.viewport.GotoBottom()
+	}
+}
+
+func (m Model) rows() []row {
+	if m.dagView {
+		return m.dagRows
+	}
+
+	return m.listRows
+}
+
+func (m Model) selected() *queue.Entry {
+	rows := m.rows()
+	if m.cursor < 0 || m.cursor >= len(rows) {
+		return nil
+	}
+
+	return rows[m.cursor].entry
+}
+
+// Notice returns the notice displayed in the status line.
+func (m Model) Notice() string {
+	return m.notice
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(refreshInterval, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+// listRows returns the units in queue order.
+func listRows(q *queue.Queue) []row {
+	rows := make([]row, 0, len(q.Entries))
+
+	for _, entry := range q.Entries {
+		rows = append(rows, newRow(entry, 0))
+	}
+
+	return rows
+}
+
+// dagRows returns the units ordered by their level in the DAG: the units that can run first at level 0, and every
+// other unit one level below the last of the units it waits for. Units of the same level keep their queue order.
+func dagRows(q *queue.Queue) []row {
+	levels := make(map[string]int, len(q.Entries))
+
+	var level func(e *queue.Entry) int
+
+	level = func(e *queue.Entry) int {
+		path := e.Component.Path()
+		if l, ok := levels[path]; ok {
+			return l
+		}
+
+		// Up commands wait for the dependencies of a unit, down commands for its dependents.
+		waitsFor := e.Component.Dependencies()
+		if !e.IsUp() {
+			waitsFor = e.Component.Dependents()
+		}
+
+		l := 0
+
+		for _, c := range waitsFor {
+			if other := q.EntryByPath(c.Path()); other != nil {
+				l = max(l, level(other)+1)
+			}
+		}
+
+		levels[path] = l
+
+		return l
+	}
+
+	rows := make([]row, 0, len(q.Entries))
+
+	for _, entry := range q.Entries {
+		rows = append(rows, newRow(entry, level(entry)))
+	}
+
+	slices.SortStableFunc(rows, func(a, b row) int {
+		return cmp.Compare(a.depth, b.depth)
+	})
+
+	return rows
+}
+
+func newRow(entry *queue.Entry, depth int) row {
+	deps := entry.Component.Dependencies()
+	names := make([]string, 0, len(deps))
+
+	for _, dep := range deps {
+		names = append(names, dep.DisplayPath())
+	}
+
+	return row{
+		entry:        entry,
+		name:         entry.Component.DisplayPath(),
+		dependencies: names,
+		depth:        depth,
+	}
+}
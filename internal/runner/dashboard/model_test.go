@@ -0,0 +1,155 @@
+package dashboard_test
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/gruntwork-io/terragrunt/internal/runner/dashboard"
+)
+
+func press(r rune) tea.KeyPressMsg { return tea.KeyPressMsg{Code: r, Text: string(r)} }
+func pressEnter() tea.KeyPressMsg  { return tea.KeyPressMsg{Code: tea.KeyEnter} }
+func pressEsc() tea.KeyPressMsg    { return tea.KeyPressMsg{Code: tea.KeyEscape} }
+
+// fakeControls records the units cancelled and skipped, and allows any of them.
+type fakeControls struct {
+	cancelled []string
+	skipped   []string
+}
+
+func (c *fakeControls) Cancel(path string) bool {
+	c.cancelled = append(c.cancelled, path)
+	return true
+}
+
+func (c *fakeControls) Skip(path string) bool {
+	c.skipped = append(c.skipped, path)
+	return true
+}
+
+// newTestQueue builds the queue a -> b, c, which runs a and c first.
+func newTestQueue(t *testing.T) *queue.Queue {
+	t.Helper()
+
+	a := component.NewUnit("a")
+	b := component.NewUnit("b")
+	b.AddDependency(a)
+	c := component.NewUnit("c")
+
+	q, err := queue.NewQueue(component.Components{a, b, c})
+	require.NoError(t, err)
+
+	return q
+}
+
+func update(t *testing.T, m tea.Model, msgs ...tea.Msg) tea.Model {
+	t.Helper()
+
+	for _, msg := range msgs {
+		m, _ = m.Update(msg)
+	}
+
+	return m
+}
+
+func content(m tea.Model) string {
+	return ansi.Strip(m.View().Content)
+}
+
+func TestDashboardQueueView(t *testing.T) {
+	t.Parallel()
+
+	q := newTestQueue(t)
+	q.SetEntryStatus(q.EntryByPath("a"), queue.StatusRunning)
+
+	tracker := dashboard.NewTracker()
+	_, err := tracker.Unit("a").Write([]byte("Initializing the backend...\n\x1b[32mPlan: 1 to add\x1b[0m\n\n"))
+	require.NoError(t, err)
+
+	m := tea.Model(dashboard.NewModel(q, tracker, &fakeControls{}, nil))
+
+	view := content(m)
+	assert.Contains(t, view, "Running · 0/3 done")
+
+	lines := strings.Split(view, "\n")
+	require.Len(t, lines, 7)
+	assert.Regexp(t, `^> running\s+a\s+Plan: 1 to add$`, lines[2])
+	assert.Regexp(t, `^  pending\s+c\s*$`, lines[3])
+	assert.Regexp(t, `^  pending\s+b\s*$`, lines[4])
+
+	// The DAG view orders units by level, below the units they wait for.
+	m = update(t, m, press('d'))
+	lines = strings.Split(content(m), "\n")
+	assert.Contains(t, lines[0], "dag view")
+	assert.Regexp(t, `running\s+a`, lines[2])
+	assert.Regexp(t, `pending\s+c`, lines[3])
+	assert.Regexp(t, `pending\s+└ b\s+after a`, lines[4])
+}
+
+func TestDashboardControls(t *testing.T) {
+	t.Parallel()
+
+	q := newTestQueue(t)
+	controls := &fakeControls{}
+
+	m := tea.Model(dashboard.NewModel(q, dashboard.NewTracker(), controls, nil))
+
+	m = update(t, m, press('c'), press('j'), press('s'))
+	assert.Equal(t, []string{"a"}, controls.cancelled)
+	assert.Equal(t, []string{"c"}, controls.skipped)
+	assert.Equal(t, "Skipped c", m.(dashboard.Model).Notice())
+
+	// No unit can be cancelled or skipped once the run finished.
+	m = update(t, m, dashboard.RunFinishedMsg{}, press('s'))
+	assert.Equal(t, []string{"c"}, controls.skipped)
+	assert.Equal(t, "c can't be skipped, it already started", m.(dashboard.Model).Notice())
+}
+
+func TestDashboardLogView(t *testing.T) {
+	t.Parallel()
+
+	q := newTestQueue(t)
+	tracker := dashboard.NewTracker()
+
+	_, err := tracker.Unit("b").Write([]byte("first line\nsecond line\n"))
+	require.NoError(t, err)
+
+	m := tea.Model(dashboard.NewModel(q, tracker, &fakeControls{}, nil))
+	m = update(t, m, tea.WindowSizeMsg{Width: 80, Height: 20}, press('j'), press('j'), pressEnter())
+
+	view := content(m)
+	assert.Contains(t, view, "Logs of b · pending")
+	assert.Contains(t, view, "first line")
+	assert.Contains(t, view, "second line")
+
+	m = update(t, m, pressEsc())
+	assert.Contains(t, content(m), "0/3 done")
+}
+
+func TestDashboardQuit(t *testing.T) {
+	t.Parallel()
+
+	cancelled := false
+
+	m := tea.Model(dashboard.NewModel(newTestQueue(t), dashboard.NewTracker(), &fakeControls{}, func() { cancelled = true }))
+
+	// Quitting during the run asks for confirmation first, then cancels the run and waits for it to stop.
+	m, cmd := m.Update(press('q'))
+	assert.Nil(t, cmd)
+	assert.False(t, cancelled)
+
+	m, cmd = m.Update(press('q'))
+	assert.Nil(t, cmd)
+	assert.True(t, cancelled)
+
+	_, cmd = m.Update(dashboard.RunFinishedMsg{})
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
@@ -0,0 +1,140 @@
+package dashboard
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// MaxUnitLogSize is the maximum size of the output kept for each unit. Once it is reached, the oldest lines are
+// dropped, so that a long run with verbose units doesn't grow without limit.
+const MaxUnitLogSize = 1 << 20
+
+// droppedOutputNotice is shown in place of the output dropped from a unit log.
+const droppedOutputNotice = "[earlier output dropped]\n"
+
+// Tracker records the output and timing of the units of a run, for the dashboard to display.
+// It is safe for concurrent use.
+type Tracker struct {
+	units  map[string]*UnitLog
+	runLog *UnitLog
+	mu     sync.Mutex
+}
+
+// NewTracker creates a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		units:  make(map[string]*UnitLog),
+		runLog: &UnitLog{},
+	}
+}
+
+// RunLog returns the log of the output of the run that isn't specific to any unit.
+func (t *Tracker) RunLog() *UnitLog {
+	return t.runLog
+}
+
+// Unit returns the log of the unit at the given path, creating it if needed.
+func (t *Tracker) Unit(path string) *UnitLog {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	unit, ok := t.units[path]
+	if !ok {
+		unit = &UnitLog{}
+		t.units[path] = unit
+	}
+
+	return unit
+}
+
+// UnitLog is an io.Writer capturing the last MaxUnitLogSize bytes of output of a unit, along with when the unit
+// started and finished running.
+type UnitLog struct {
+	started  time.Time
+	finished time.Time
+	lastLine string
+	buf      bytes.Buffer
+	mu       sync.Mutex
+	dropped  bool
+}
+
+// Write implements io.Writer, keeping track of the last non-empty line written.
+func (u *UnitLog) Write(p []byte) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for line := range strings.SplitSeq(string(p), "\n") {
+		if line = strings.TrimSpace(log.RemoveAllASCISeq(line)); line != "" {
+			u.lastLine = line
+		}
+	}
+
+	n, err := u.buf.Write(p)
+
+	if excess := u.buf.Len() - MaxUnitLogSize; excess > 0 {
+		// Drop whole lines, so that the kept output doesn't start part way through a line.
+		if i := bytes.IndexByte(u.buf.Bytes()[excess:], '\n'); i >= 0 {
+			excess += i + 1
+		}
+
+		u.buf.Next(excess)
+		u.dropped = true
+	}
+
+	return n, err
+}
+
+// Start records that the unit started running.
+func (u *UnitLog) Start() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.started = time.Now()
+}
+
+// Finish records that the unit finished running.
+func (u *UnitLog) Finish() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.finished = time.Now()
+}
+
+// Elapsed returns for how long the unit has been running, or ran. Returns zero if it hasn't started.
+func (u *UnitLog) Elapsed(now time.Time) time.Duration {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	switch {
+	case u.started.IsZero():
+		return 0
+	case u.finished.IsZero():
+		return now.Sub(u.started)
+	default:
+		return u.finished.Sub(u.started)
+	}
+}
+
+// LastLine returns the last non-empty line of output, stripped of ANSI escape sequences.
+func (u *UnitLog) LastLine() string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.lastLine
+}
+
+// String returns the output of the unit, starting with a notice if earlier output was dropped.
+func (u *UnitLog) String() string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.dropped {
+		return droppedOutputNotice + u.buf.String()
+	}
+
+	return u.buf.String()
+}
@@ -0,0 +1,59 @@
+package dashboard_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/dashboard"
+)
+
+func TestUnitLog(t *testing.T) {
+	t.Parallel()
+
+	tracker := dashboard.NewTracker()
+	unitLog := tracker.Unit("unit")
+
+	assert.Same(t, unitLog, tracker.Unit("unit"))
+	assert.Zero(t, unitLog.Elapsed(time.Now()))
+
+	_, err := unitLog.Write([]byte("\x1b[1mApply complete!\x1b[0m Resources: 1 added.\n  \n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Apply complete! Resources: 1 added.", unitLog.LastLine())
+	assert.Equal(t, "\x1b[1mApply complete!\x1b[0m Resources: 1 added.\n  \n", unitLog.String())
+
+	unitLog.Start()
+	assert.Positive(t, unitLog.Elapsed(time.Now().Add(time.Minute)))
+
+	unitLog.Finish()
+
+	elapsed := unitLog.Elapsed(time.Now())
+	assert.Equal(t, elapsed, unitLog.Elapsed(time.Now().Add(time.Hour)), "a finished unit no longer ages")
+}
+
+func TestUnitLogDropsOldestOutput(t *testing.T) {
+	t.Parallel()
+
+	unitLog := dashboard.NewTracker().Unit("unit")
+
+	for i := 0; i < 2*dashboard.MaxUnitLogSize/64; i++ {
+		_, err := fmt.Fprintf(unitLog, "%-63d\n", i)
+		require.NoError(t, err)
+	}
+
+	output := unitLog.String()
+
+	assert.LessOrEqual(t, len(output), dashboard.MaxUnitLogSize+len("[earlier output dropped]\n"))
+	assert.True(t, strings.HasPrefix(output, "[earlier output dropped]\n"))
+	assert.True(t, strings.HasSuffix(output, fmt.Sprintf("%-63d\n", 2*dashboard.MaxUnitLogSize/64-1)))
+	assert.Equal(t, fmt.Sprintf("%d", 2*dashboard.MaxUnitLogSize/64-1), unitLog.LastLine())
+
+	// The kept output starts with a whole line.
+	firstLine, _, _ := strings.Cut(strings.TrimPrefix(output, "[earlier output dropped]\n"), "\n")
+	assert.Len(t, firstLine, 63)
+}
@@ -0,0 +1,187 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+)
+
+const (
+	// queueChromeHeight is the number of lines around the rows of the queue view: the header, a blank line, the
+	// notice line and the help line.
+	queueChromeHeight = 4
+	// logChromeHeight is the number of lines around the log viewport: the title, the notice line and the help line.
+	logChromeHeight = 3
+
+	statusColumnWidth  = 12
+	elapsedColumnWidth = 9
+)
+
+var (
+	headerStyle   = lipgloss.NewStyle().Bold(true)
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#A7D3FF"))
+	dimStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("#7D8590"))
+	noticeStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD866"))
+
+	statusStyles = map[string]lipgloss.Style{
+		"pending":    dimStyle,
+		"running":    lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD866")),
+		"succeeded":  lipgloss.NewStyle().Foreground(lipgloss.Color("#A9DC76")),
+		"failed":     lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6188")),
+		"early exit": lipgloss.NewStyle().Foreground(lipgloss.Color("#FC9867")),
+	}
+)
+
+// View implements bubbletea.Model.View.
+func (m Model) View() tea.View {
+	var s string
+
+	switch m.state {
+	case logState:
+		s = m.logView()
+	case queueState:
+		s = m.queueView()
+	}
+
+	v := tea.NewView(s)
+	v.AltScreen = true
+
+	return v
+}
+
+func (m Model) queueView() string {
+	rows := m.rows()
+	now := m.now()
+
+	// Only render the rows that fit, scrolling with the cursor.
+	visible := len(rows)
+	if m.height > 0 {
+		visible = max(m.height-queueChromeHeight, 1)
+	}
+
+	first := max(min(m.cursor-visible+1, len(rows)-visible), 0)
+	last := min(first+visible, len(rows))
+
+	lines := make([]string, 0, last-first+queueChromeHeight)
+	lines = append(lines, m.header(), "")
+
+	for i := first; i < last; i++ {
+		lines = append(lines, m.renderRow(rows[i], i == m.cursor, now))
+	}
+
+	keys := []key.Binding{m.keys.Up, m.keys.Down, m.keys.Logs, m.keys.Cancel, m.keys.Skip, m.keys.ToggleView, m.keys.Quit}
+
+	lines = append(lines, noticeStyle.Render(m.notice), m.help.ShortHelpView(keys))
+
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) logView() string {
+	entry := m.selected()
+	if entry == nil {
+		return ""
+	}
+
+	status := statusLabel(m.queue.EntryStatus(entry))
+	title := fmt.Sprintf("Logs of %s · %s", entry.Component.DisplayPath(), statusStyles[status].Render(status))
+
+	keys := []key.Binding{m.keys.Up, m.keys.Down, m.keys.Back, m.keys.Cancel, m.keys.Quit}
+
+	return strings.Join([]string{
+		headerStyle.Render(title),
+		m.viewport.View(),
+		noticeStyle.Render(m.notice),
+		m.help.ShortHelpView(keys),
+	}, "\n")
+}
+
+// header summarizes the progress of the run.
+func (m Model) header() string {
+	counts := make(map[string]int)
+
+	for _, entry := range m.queue.Entries {
+		counts[statusLabel(m.queue.EntryStatus(entry))]++
+	}
+
+	done := counts["succeeded"] + counts["failed"] + counts["early exit"]
+
+	state := "Running"
+	if m.finished {
+		state = "Finished"
+		if m.runErr != nil {
+			state = "Finished with errors"
+		}
+	}
+
+	view := "list"
+	if m.dagView {
+		view = "dag"
+	}
+
+	return headerStyle.Render(fmt.Sprintf("%s · %d/%d done", state, done, len(m.queue.Entries))) +
+		dimStyle.Render(fmt.Sprintf(" · %d running · %d succeeded · %d failed · %d early exit · %s view",
+			counts["running"], counts["succeeded"], counts["failed"], counts["early exit"], view))
+}
+
+func (m Model) renderRow(r row, selected bool, now time.Time) string {
+	status := statusLabel(m.queue.EntryStatus(r.entry))
+	unit := m.tracker.Unit(r.entry.Component.Path())
+
+	cursor := "  "
+	name := r.name
+
+	if m.dagView && r.depth > 0 {
+		name = strings.Repeat("  ", r.depth-1) + "└ " + name
+	}
+
+	if selected {
+		cursor = "> "
+		name = selectedStyle.Render(name)
+	}
+
+	elapsed := ""
+	if d := unit.Elapsed(now); d > 0 {
+		elapsed = d.Truncate(time.Second).String()
+	}
+
+	detail := unit.LastLine()
+	if m.dagView && len(r.dependencies) > 0 && detail == "" {
+		detail = "after " + strings.Join(r.dependencies, ", ")
+	}
+
+	line := cursor +
+		statusStyles[status].Render(fmt.Sprintf("%-*s", statusColumnWidth, status)) +
+		fmt.Sprintf("%-*s", elapsedColumnWidth, elapsed) +
+		name + "  " + dimStyle.Render(detail)
+
+	if m.width > 0 {
+		line = ansi.Truncate(line, m.width, "…")
+	}
+
+	return line
+}
+
+// statusLabel returns the label displayed for the status of an entry.
+func statusLabel(status queue.Status) string {
+	switch status {
+	case queue.StatusPending, queue.StatusBlocked, queue.StatusUnsorted, queue.StatusReady:
+		return "pending"
+	case queue.StatusRunning:
+		return "running"
+	case queue.StatusSucceeded:
+		return "succeeded"
+	case queue.StatusFailed:
+		return "failed"
+	case queue.StatusEarlyExit:
+		return "early exit"
+	}
+
+	return "unknown"
+}
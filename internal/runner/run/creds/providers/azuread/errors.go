@@ -0,0 +1,42 @@
+package azuread
+
+import "fmt"
+
+// IncompleteOptionsError is returned when only some of the attributes identifying the app registration are set.
+type IncompleteOptionsError struct {
+	TenantID string
+	ClientID string
+}
+
+func (err IncompleteOptionsError) Error() string {
+	missing := "azure_tenant_id"
+	if err.TenantID != "" {
+		missing = "azure_client_id"
+	}
+
+	return fmt.Sprintf("%s is required to obtain Azure credentials: both azure_tenant_id and azure_client_id must be set", missing)
+}
+
+// MissingClientCredentialError is returned when neither a federated token nor a client secret is available.
+type MissingClientCredentialError struct {
+	ClientID string
+}
+
+func (err MissingClientCredentialError) Error() string {
+	return fmt.Sprintf("no credential to authenticate Azure client %s: set azure_federated_token, or the AZURE_CLIENT_SECRET or ARM_CLIENT_SECRET environment variable", err.ClientID)
+}
+
+// TokenRequestError is returned when Microsoft Entra ID doesn't issue an access token.
+type TokenRequestError struct {
+	Err      error
+	TenantID string
+	ClientID string
+}
+
+func (err TokenRequestError) Error() string {
+	return fmt.Sprintf("failed to obtain Azure access token for client %s of tenant %s: %v", err.ClientID, err.TenantID, err.Err)
+}
+
+func (err TokenRequestError) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,282 @@
+// Package azuread provides a credentials provider that obtains Azure access tokens from Microsoft Entra ID, by
+// exchanging a federated token or a client secret of an app registration.
+package azuread
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/cache"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
+	"github.com/gruntwork-io/terragrunt/internal/telemetry"
+	"github.com/gruntwork-io/terragrunt/internal/vexec"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// DefaultAuthorityHost is the host of Microsoft Entra ID in the Azure public cloud.
+	DefaultAuthorityHost = "https://login.microsoftonline.com"
+
+	// AuthorityHostEnvVar is the environment variable overriding the authority host, e.g. for sovereign clouds.
+	AuthorityHostEnvVar = "AZURE_AUTHORITY_HOST"
+
+	managementScope = "https://management.azure.com/.default"
+
+	// expiryMargin is how long before their expiry cached tokens are considered expired, so that a token is never
+	// handed out right before it expires.
+	expiryMargin = 5 * time.Minute
+
+	clientCredentialsGrantType = "client_credentials"
+	jwtBearerAssertionType     = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+)
+
+// clientSecretEnvVars are the environment variables the client secret is read from when no federated token is
+// configured, in order of precedence.
+var clientSecretEnvVars = []string{"AZURE_CLIENT_SECRET", "ARM_CLIENT_SECRET"}
+
+// Options configures how the access token is obtained.
+type Options struct {
+	// TenantID is the ID of the Microsoft Entra tenant of the app registration.
+	TenantID string
+	// ClientID is the client ID of the app registration.
+	ClientID string
+	// FederatedToken is the federated token exchanged for the access token, or the path to a file containing it.
+	// When not set, the client secret is read from the environment.
+	FederatedToken string
+}
+
+// IsSet reports whether any Azure credentials are configured.
+func (opts Options) IsSet() bool {
+	return opts.TenantID != "" || opts.ClientID != "" || opts.FederatedToken != ""
+}
+
+// Provider obtains Azure access tokens from Microsoft Entra ID with the OAuth 2.0 client credentials flow.
+type Provider struct {
+	fs            vfs.FS
+	env           map[string]string
+	httpClient    *http.Client
+	authorityHost string
+	opts          Options
+}
+
+// Option configures a Provider.
+type Option func(*Provider)
+
+// WithAuthorityHost sets the host of Microsoft Entra ID, taking precedence over AZURE_AUTHORITY_HOST.
+func WithAuthorityHost(authorityHost string) Option {
+	return func(provider *Provider) {
+		provider.authorityHost = authorityHost
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Microsoft Entra ID.
+func WithHTTPClient(client *http.Client) Option {
+	return func(provider *Provider) {
+		provider.httpClient = client
+	}
+}
+
+// WithFS sets the filesystem the federated token is read from when it is a file path. A nil fs keeps the OS filesystem.
+func WithFS(fs vfs.FS) Option {
+	return func(provider *Provider) {
+		if fs != nil {
+			provider.fs = fs
+		}
+	}
+}
+
+// NewProvider returns a new Provider instance. The environment is used to look up the client secret and the
+// authority host.
+func NewProvider(l log.Logger, opts Options, env map[string]string, options ...Option) providers.Provider {
+	provider := &Provider{
+		opts:          opts,
+		env:           env,
+		fs:            vfs.NewOSFS(),
+		httpClient:    http.DefaultClient,
+		authorityHost: DefaultAuthorityHost,
+	}
+
+	if authorityHost := env[AuthorityHostEnvVar]; authorityHost != "" {
+		provider.authorityHost = authorityHost
+	}
+
+	for _, option := range options {
+		option(provider)
+	}
+
+	return provider
+}
+
+// Name implements providers.Name
+func (provider *Provider) Name() string {
+	return "API calls to Microsoft Entra ID"
+}
+
+// GetCredentials implements providers.GetCredentials. exec is unused for azuread because it talks to Microsoft Entra
+// ID over HTTP, not a subprocess; it is accepted to satisfy the providers.Provider interface contract.
+func (provider *Provider) GetCredentials(ctx context.Context, l log.Logger, _ vexec.Exec) (*providers.Credentials, error) {
+	opts := provider.opts
+	if !opts.IsSet() {
+		return nil, nil
+	}
+
+	if opts.TenantID == "" || opts.ClientID == "" {
+		return nil, IncompleteOptionsError{TenantID: opts.TenantID, ClientID: opts.ClientID}
+	}
+
+	var creds *providers.Credentials
+
+	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "obtain_creds", map[string]any{
+		"provider":  "azure_ad",
+		"tenant_id": opts.TenantID,
+		"client_id": opts.ClientID,
+	}, func(ctx context.Context) error {
+		var credsErr error
+
+		creds, credsErr = provider.obtainCredentials(ctx, l)
+
+		return credsErr
+	})
+
+	return creds, err
+}
+
+// obtainCredentials returns the environment configuring the Azure providers to authenticate as the app registration.
+// With a federated token, it is first exchanged for an access token so that an invalid token fails before the Azure
+// providers run.
+func (provider *Provider) obtainCredentials(ctx context.Context, l log.Logger) (*providers.Credentials, error) {
+	opts := provider.opts
+
+	envs := map[string]string{
+		"ARM_TENANT_ID":   opts.TenantID,
+		"ARM_CLIENT_ID":   opts.ClientID,
+		"AZURE_TENANT_ID": opts.TenantID,
+		"AZURE_CLIENT_ID": opts.ClientID,
+	}
+
+	if opts.FederatedToken == "" {
+		secret := provider.clientSecret()
+		if secret == "" {
+			return nil, MissingClientCredentialError{ClientID: opts.ClientID}
+		}
+
+		// The Azure providers request their own access tokens with the client secret.
+		envs["ARM_CLIENT_SECRET"] = secret
+
+		return &providers.Credentials{
+			Name: providers.AzureCredentials,
+			Envs: envs,
+		}, nil
+	}
+
+	token, isFile, err := providers.ReadToken(provider.fs, opts.FederatedToken)
+	if err != nil {
+		return nil, TokenRequestError{TenantID: opts.TenantID, ClientID: opts.ClientID, Err: err}
+	}
+
+	envs["ARM_USE_OIDC"] = "true"
+	envs["ARM_OIDC_TOKEN"] = token
+
+	if isFile {
+		envs["AZURE_FEDERATED_TOKEN_FILE"] = opts.FederatedToken
+	}
+
+	// Federated tokens are short-lived, so the exchange is cached per federated token.
+	cacheKey := opts.TenantID + "|" + opts.ClientID + "|" + token
+
+	if cached, hit := credentialsCache.Get(ctx, cacheKey); hit {
+		l.Debugf("Using cached Azure credentials for client %s.", opts.ClientID)
+		return cached, nil
+	}
+
+	l.Debugf("Requesting Azure access token for client %s of tenant %s.", opts.ClientID, opts.TenantID)
+
+	form := url.Values{
+		"client_id":             {opts.ClientID},
+		"scope":                 {managementScope},
+		"grant_type":            {clientCredentialsGrantType},
+		"client_assertion_type": {jwtBearerAssertionType},
+		"client_assertion":      {token},
+	}
+
+	expiry, err := provider.requestToken(ctx, form)
+	if err != nil {
+		return nil, TokenRequestError{TenantID: opts.TenantID, ClientID: opts.ClientID, Err: err}
+	}
+
+	creds := &providers.Credentials{
+		Name: providers.AzureCredentials,
+		Envs: envs,
+	}
+
+	credentialsCache.Put(ctx, cacheKey, creds, expiry.Add(-expiryMargin))
+
+	return creds, nil
+}
+
+// requestToken requests an access token from the token endpoint of the tenant, returning its expiry.
+func (provider *Provider) requestToken(ctx context.Context, form url.Values) (time.Time, error) {
+	endpoint := fmt.Sprintf("%s/%s/oauth2/v2.0/token",
+		strings.TrimSuffix(provider.authorityHost, "/"), url.PathEscape(provider.opts.TenantID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := provider.httpClient.Do(req)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var tokenResp struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+		ExpiresIn        int64  `json:"expires_in"`
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if err := json.Unmarshal(body, &tokenResp); err == nil && tokenResp.Error != "" {
+			return time.Time{}, fmt.Errorf("%s: %s", tokenResp.Error, tokenResp.ErrorDescription)
+		}
+
+		return time.Time{}, fmt.Errorf("POST %s returned %s: %s", req.URL.Redacted(), resp.Status, bytes.TrimSpace(body))
+	}
+
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second), nil
+}
+
+// clientSecret returns the client secret from the environment.
+func (provider *Provider) clientSecret() string {
+	for _, name := range clientSecretEnvVars {
+		if secret := provider.env[name]; secret != "" {
+			return secret
+		}
+	}
+
+	return ""
+}
+
+// credentialsCache is a cache of credentials.
+var credentialsCache = cache.NewExpiringCache[*providers.Credentials]("azureCredentialsCache")
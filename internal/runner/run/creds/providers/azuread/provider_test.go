@@ -0,0 +1,185 @@
+package azuread_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/azuread"
+	"github.com/gruntwork-io/terragrunt/internal/vexec"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+)
+
+// newFakeEntraID returns a fake Microsoft Entra ID token endpoint, issuing access tokens for the credentials of the
+// app registration "app" in tenant "tenant" federated with the token "valid-jwt".
+func newFakeEntraID(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /{tenant}/oauth2/v2.0/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "https://management.azure.com/.default", r.PostForm.Get("scope"))
+
+		form := r.PostForm
+
+		if r.PathValue("tenant") != "tenant" || form.Get("client_id") != "app" ||
+			form.Get("client_assertion") != "valid-jwt" ||
+			form.Get("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"error":             "invalid_client",
+				"error_description": "AADSTS7000215: Invalid client credentials.",
+			})
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "federated-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3599,
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGetCredentialsNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	l := logger.CreateLogger()
+
+	creds, err := azuread.NewProvider(l, azuread.Options{}, nil).GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	assert.Nil(t, creds)
+}
+
+func TestGetCredentialsFederatedToken(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeEntraID(t)
+	l := logger.CreateLogger()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("valid-jwt\n"), 0o600))
+
+	provider := azuread.NewProvider(l, azuread.Options{
+		TenantID:       "tenant",
+		ClientID:       "app",
+		FederatedToken: tokenFile,
+	}, map[string]string{azuread.AuthorityHostEnvVar: server.URL})
+
+	creds, err := provider.GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+
+	assert.Equal(t, providers.AzureCredentials, creds.Name)
+	assert.Equal(t, map[string]string{
+		"ARM_TENANT_ID":              "tenant",
+		"ARM_CLIENT_ID":              "app",
+		"AZURE_TENANT_ID":            "tenant",
+		"AZURE_CLIENT_ID":            "app",
+		"ARM_USE_OIDC":               "true",
+		"ARM_OIDC_TOKEN":             "valid-jwt",
+		"AZURE_FEDERATED_TOKEN_FILE": tokenFile,
+	}, creds.Envs)
+}
+
+func TestGetCredentialsClientSecret(t *testing.T) {
+	t.Parallel()
+
+	// The client secret is passed through to the Azure providers without requesting a token.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	l := logger.CreateLogger()
+
+	creds, err := azuread.NewProvider(l, azuread.Options{
+		TenantID: "tenant",
+		ClientID: "app",
+	}, map[string]string{"ARM_CLIENT_SECRET": "valid-secret"}, azuread.WithAuthorityHost(server.URL)).
+		GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+
+	assert.Equal(t, map[string]string{
+		"ARM_TENANT_ID":     "tenant",
+		"ARM_CLIENT_ID":     "app",
+		"AZURE_TENANT_ID":   "tenant",
+		"AZURE_CLIENT_ID":   "app",
+		"ARM_CLIENT_SECRET": "valid-secret",
+	}, creds.Envs)
+}
+
+func TestGetCredentialsErrors(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeEntraID(t)
+	l := logger.CreateLogger()
+
+	testCases := []struct {
+		check func(t *testing.T, err error)
+		name  string
+		opts  azuread.Options
+		env   map[string]string
+	}{
+		{
+			name: "missing client id",
+			opts: azuread.Options{TenantID: "tenant"},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+
+				var target azuread.IncompleteOptionsError
+				require.ErrorAs(t, err, &target)
+				assert.Contains(t, err.Error(), "azure_client_id is required")
+			},
+		},
+		{
+			name: "missing credential",
+			opts: azuread.Options{TenantID: "tenant", ClientID: "app"},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+
+				var target azuread.MissingClientCredentialError
+				require.ErrorAs(t, err, &target)
+			},
+		},
+		{
+			name: "rejected federated token",
+			opts: azuread.Options{TenantID: "tenant", ClientID: "app", FederatedToken: "invalid-jwt"},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+
+				var target azuread.TokenRequestError
+				require.ErrorAs(t, err, &target)
+				assert.Contains(t, err.Error(), "invalid_client: AADSTS7000215")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := azuread.NewProvider(l, tc.opts, tc.env, azuread.WithAuthorityHost(server.URL)).
+				GetCredentials(t.Context(), l, vexec.NewOSExec())
+			require.Error(t, err)
+			tc.check(t, err)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package externalcmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/cache"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// CacheScopeUnit caches the response of an auth provider command for the unit it was obtained for.
+	CacheScopeUnit = "unit"
+	// CacheScopeGlobal shares the response of an auth provider command across all units running the command.
+	CacheScopeGlobal = "global"
+
+	// RefreshWindow is how long before their expiration cached credentials are refreshed, so that a unit never
+	// starts running with credentials about to expire.
+	RefreshWindow = 5 * time.Minute
+)
+
+// credentialsCache is a cache of the credentials obtained from auth provider commands.
+var credentialsCache = cache.NewExpiringCache[*providers.Credentials]("authProviderCmdCredentialsCache")
+
+// cachePolicy is how long and in which scope the credentials obtained from an auth provider command are cached.
+type cachePolicy struct {
+	expiration time.Time
+	scope      string
+}
+
+// cachedCredentials returns the cached credentials of the command, shared across units first, then those of the
+// unit. Credentials are cached until RefreshWindow before they expire, so they are refreshed proactively.
+func (provider *Provider) cachedCredentials(ctx context.Context, l log.Logger) (*providers.Credentials, bool) {
+	for _, key := range []string{provider.globalCacheKey(), provider.unitCacheKey()} {
+		if creds, ok := credentialsCache.Get(ctx, key); ok {
+			l.Debugf("Using cached credentials from the %s.", provider.Name())
+			return creds, true
+		}
+	}
+
+	return nil, false
+}
+
+// cacheCredentials caches the credentials according to the policy of the response they were obtained from. Credentials
+// without a known expiration aren't cached, so the command runs again the next time they are needed.
+func (provider *Provider) cacheCredentials(
+	ctx context.Context,
+	l log.Logger,
+	creds *providers.Credentials,
+	policy cachePolicy,
+) {
+	if policy.expiration.IsZero() {
+		return
+	}
+
+	refreshAt := policy.expiration.Add(-RefreshWindow)
+	if !refreshAt.After(time.Now()) {
+		l.Debugf("Credentials from the %s expire at %s, not caching them.",
+			provider.Name(), policy.expiration.Format(time.RFC3339))
+
+		return
+	}
+
+	key := provider.unitCacheKey()
+	if policy.scope == CacheScopeGlobal {
+		key = provider.globalCacheKey()
+	}
+
+	credentialsCache.Put(ctx, key, creds, refreshAt)
+}
+
+// globalCacheKey is the key of the credentials shared across all units running the command.
+func (provider *Provider) globalCacheKey() string {
+	return provider.authProviderCmd
+}
+
+// unitCacheKey is the key of the credentials of the unit the command runs for, identified by its working directory.
+func (provider *Provider) unitCacheKey() string {
+	if provider.runOpts == nil {
+		return provider.authProviderCmd + "|"
+	}
+
+	return provider.authProviderCmd + "|" + provider.runOpts.WorkingDir
+}
@@ -8,6 +8,7 @@ import (
 	"maps"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/internal/iam"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
@@ -41,6 +42,8 @@ func (provider *Provider) Name() string {
 // GetCredentials implements providers.GetCredentials. When no auth provider command is
 // configured the call is a no-op short-circuit; we skip emitting the obtain_creds span
 // in that case so the trace isn't polluted with zero-duration spans.
+//
+// Credentials whose expiration is known are cached, see cachedCredentials.
 func (provider *Provider) GetCredentials(
 	ctx context.Context,
 	l log.Logger,
@@ -50,15 +53,25 @@ func (provider *Provider) GetCredentials(
 		return nil, nil
 	}
 
+	if creds, ok := provider.cachedCredentials(ctx, l); ok {
+		return creds, nil
+	}
+
 	var creds *providers.Credentials
 
 	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "obtain_creds", map[string]any{
 		"auth_provider_cmd": provider.authProviderCmd,
 		"provider":          "external_cmd",
 	}, func(credsCtx context.Context) error {
-		var fetchErr error
-
-		creds, fetchErr = provider.fetchCredentials(credsCtx, l, exec)
+		var (
+			policy   cachePolicy
+			fetchErr error
+		)
+
+		creds, policy, fetchErr = provider.fetchCredentials(credsCtx, l, exec)
+		if fetchErr == nil {
+			provider.cacheCredentials(credsCtx, l, creds, policy)
+		}
 
 		return fetchErr
 	})
@@ -67,19 +80,19 @@ func (provider *Provider) GetCredentials(
 }
 
 // fetchCredentials runs the configured auth-provider command and decodes its JSON
-// response into providers.Credentials. Callers go through GetCredentials, which adds
-// the obtain_creds telemetry span around this work.
+// response into providers.Credentials, along with how long they can be cached. Callers
+// go through GetCredentials, which adds the obtain_creds telemetry span around this work.
 func (provider *Provider) fetchCredentials(
 	ctx context.Context,
 	l log.Logger,
 	exec vexec.Exec,
-) (*providers.Credentials, error) {
+) (*providers.Credentials, cachePolicy, error) {
 	parser := shellwords.NewParser()
 
 	// Normalize Windows paths before parsing - shellwords treats backslashes as escape characters
 	parts, err := parser.Parse(filepath.ToSlash(provider.authProviderCmd))
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse auth provider command: %w", err)
+		return nil, cachePolicy{}, fmt.Errorf("failed to parse auth provider command: %w", err)
 	}
 
 	command := parts[0]
@@ -94,11 +107,11 @@ func (provider *Provider) fetchCredentials(
 		"", true, false, command, args...,
 	)
 	if err != nil {
-		return nil, err
+		return nil, cachePolicy{}, err
 	}
 
 	if output.Stdout.String() == "" {
-		return nil, fmt.Errorf(
+		return nil, cachePolicy{}, fmt.Errorf(
 			"command %s completed successfully, but the response does not contain JSON string",
 			provider.authProviderCmd)
 	}
@@ -106,7 +119,7 @@ func (provider *Provider) fetchCredentials(
 	resp := &Response{Envs: make(map[string]string)}
 
 	if err := json.Unmarshal(output.Stdout.Bytes(), resp); err != nil {
-		return nil, fmt.Errorf("command %s returned a response with invalid JSON format", command)
+		return nil, cachePolicy{}, fmt.Errorf("command %s returned a response with invalid JSON format", command)
 	}
 
 	creds := &providers.Credentials{
@@ -114,25 +127,35 @@ func (provider *Provider) fetchCredentials(
 		Envs: resp.Envs,
 	}
 
+	policy := cachePolicy{scope: resp.CacheScope}
+	if resp.Expiration != nil {
+		policy.expiration = *resp.Expiration
+	}
+
 	if resp.AWSCredentials != nil {
 		if envs := resp.AWSCredentials.Envs(ctx, l, provider.authProviderCmd); envs != nil {
 			l.Debugf("Obtaining AWS credentials from the %s.", provider.Name())
 			maps.Copy(creds.Envs, envs)
 		}
 
-		return creds, nil
+		return creds, policy, nil
 	}
 
 	if resp.AWSRole != nil {
 		if envs := resp.AWSRole.Envs(ctx, l, exec, provider.authProviderCmd); envs != nil {
 			l.Debugf("Assuming AWS role %s using the %s.", resp.AWSRole.RoleARN, provider.Name())
 			maps.Copy(creds.Envs, envs)
+
+			// Credentials of an assumed role expire at the end of its session.
+			if policy.expiration.IsZero() {
+				policy.expiration = time.Now().Add(time.Duration(resp.AWSRole.duration()) * time.Second)
+			}
 		}
 
-		return creds, nil
+		return creds, policy, nil
 	}
 
-	return creds, nil
+	return creds, policy, nil
 }
 
 // Response is the JSON response expected from an auth provider command.
@@ -143,6 +166,11 @@ type Response struct {
 	AWSRole *AWSRole `json:"awsRole,omitempty"`
 	// Envs contains additional environment variables to set.
 	Envs map[string]string `json:"envs,omitempty"`
+	// Expiration is when the credentials expire. The response is cached until shortly before then.
+	Expiration *time.Time `json:"expiration,omitempty"`
+	// CacheScope is the scope the response is cached in: "unit" (the default) caches it for the unit it was
+	// obtained for, "global" shares it across all units running the command.
+	CacheScope string `json:"cacheScope,omitempty" jsonschema:"enum=unit,enum=global"`
 }
 
 // AWSCredentials is the JSON schema for direct AWS credentials.
@@ -185,14 +213,9 @@ func (role *AWSRole) Envs(
 		sessionName = iam.GetDefaultAssumeRoleSessionName()
 	}
 
-	duration := role.Duration
-	if duration == 0 {
-		duration = iam.DefaultAssumeRoleDuration
-	}
-
 	iamRoleOpts := iam.RoleOptions{
 		RoleARN:               role.RoleARN,
-		AssumeRoleDuration:    duration,
+		AssumeRoleDuration:    role.duration(),
 		AssumeRoleSessionName: sessionName,
 	}
 
@@ -226,6 +249,15 @@ func (role *AWSRole) Envs(
 	return envs
 }
 
+// duration returns the duration in seconds of the session of the assumed role.
+func (role *AWSRole) duration() int64 {
+	if role.Duration == 0 {
+		return iam.DefaultAssumeRoleDuration
+	}
+
+	return role.Duration
+}
+
 func (creds *AWSCredentials) Envs(_ context.Context, l log.Logger, authProviderCmd string) map[string]string {
 	var emptyFields []string
 
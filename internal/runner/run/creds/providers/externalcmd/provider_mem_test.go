@@ -3,7 +3,9 @@ package externalcmd_test
 import (
 	"context"
 	"io"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/externalcmd"
@@ -152,3 +154,116 @@ func newRunOpts() *shell.ShellOptions {
 	return shell.NewShellOptions().
 		WithWriters(writer.Writers{Writer: io.Discard, ErrWriter: io.Discard})
 }
+
+// TestProviderCachesCredentialsUntilExpiration pins that a response with an
+// expiration is reused for the same unit, while other units run the command
+// again.
+func TestProviderCachesCredentialsUntilExpiration(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	exec := vexec.NewMemExec(func(_ context.Context, _ vexec.Invocation) vexec.Result {
+		calls.Add(1)
+
+		return vexec.Result{Stdout: []byte(`{"envs": {"TOKEN": "unit"}, "expiration": "` + expiration + `"}`)}
+	})
+
+	l := logger.CreateLogger()
+	cmd := "auth-cmd-" + t.Name()
+
+	for range 3 {
+		creds, err := externalcmd.NewProvider(l, cmd, newRunOpts().WithWorkingDir("/units/a")).
+			GetCredentials(t.Context(), l, exec)
+		require.NoError(t, err)
+		assert.Equal(t, "unit", creds.Envs["TOKEN"])
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+
+	_, err := externalcmd.NewProvider(l, cmd, newRunOpts().WithWorkingDir("/units/b")).
+		GetCredentials(t.Context(), l, exec)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), calls.Load(), "expected the command to run again for another unit")
+}
+
+// TestProviderSharesGlobalCredentialsAcrossUnits pins that a response with the
+// global cache scope is reused by all units running the command.
+func TestProviderSharesGlobalCredentialsAcrossUnits(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	exec := vexec.NewMemExec(func(_ context.Context, _ vexec.Invocation) vexec.Result {
+		calls.Add(1)
+
+		return vexec.Result{Stdout: []byte(`{
+            "awsCredentials": {"ACCESS_KEY_ID": "AKIA222", "SECRET_ACCESS_KEY": "secret"},
+            "expiration": "` + expiration + `",
+            "cacheScope": "global"
+        }`)}
+	})
+
+	l := logger.CreateLogger()
+	cmd := "auth-cmd-" + t.Name()
+
+	for _, unit := range []string{"/units/a", "/units/b", "/units/c"} {
+		creds, err := externalcmd.NewProvider(l, cmd, newRunOpts().WithWorkingDir(unit)).
+			GetCredentials(t.Context(), l, exec)
+		require.NoError(t, err)
+		assert.Equal(t, "AKIA222", creds.Envs["AWS_ACCESS_KEY_ID"])
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// TestProviderRefreshesCredentialsBeforeExpiration pins that credentials
+// expiring within the refresh window aren't reused, and that responses
+// without an expiration keep running the command every time.
+func TestProviderRefreshesCredentialsBeforeExpiration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		response string
+	}{
+		{
+			name: "expiring",
+			response: `{"envs": {"TOKEN": "x"}, "expiration": "` +
+				time.Now().Add(externalcmd.RefreshWindow/2).UTC().Format(time.RFC3339) + `"}`,
+		},
+		{
+			name:     "no expiration",
+			response: `{"envs": {"TOKEN": "x"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			exec := vexec.NewMemExec(func(_ context.Context, _ vexec.Invocation) vexec.Result {
+				calls.Add(1)
+
+				return vexec.Result{Stdout: []byte(tc.response)}
+			})
+
+			l := logger.CreateLogger()
+			p := externalcmd.NewProvider(l, "auth-cmd-"+t.Name(), newRunOpts())
+
+			for range 2 {
+				_, err := p.GetCredentials(t.Context(), l, exec)
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, int32(2), calls.Load())
+		})
+	}
+}
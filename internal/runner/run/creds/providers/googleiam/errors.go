@@ -0,0 +1,41 @@
+package googleiam
+
+import "fmt"
+
+// MissingWebIdentityTokenError is returned when a workload identity provider is configured without a web identity
+// token to exchange.
+type MissingWebIdentityTokenError struct {
+	WorkloadIdentityProvider string
+}
+
+func (err MissingWebIdentityTokenError) Error() string {
+	return fmt.Sprintf("gcp_workload_identity_provider %q is set, but gcp_web_identity_token is not: a web identity token is required for workload identity federation", err.WorkloadIdentityProvider)
+}
+
+// TokenExchangeError is returned when the web identity token can't be exchanged with the workload identity provider.
+type TokenExchangeError struct {
+	Err                      error
+	WorkloadIdentityProvider string
+}
+
+func (err TokenExchangeError) Error() string {
+	return fmt.Sprintf("failed to exchange web identity token with workload identity provider %s: %v", err.WorkloadIdentityProvider, err.Err)
+}
+
+func (err TokenExchangeError) Unwrap() error {
+	return err.Err
+}
+
+// ImpersonationError is returned when an access token of the impersonated service account can't be generated.
+type ImpersonationError struct {
+	Err            error
+	ServiceAccount string
+}
+
+func (err ImpersonationError) Error() string {
+	return fmt.Sprintf("failed to impersonate GCP service account %s: %v", err.ServiceAccount, err.Err)
+}
+
+func (err ImpersonationError) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,361 @@
+// Package googleiam provides a credentials provider that obtains short-lived GCP access tokens, by impersonating a
+// service account and/or exchanging a web identity token through workload identity federation.
+package googleiam
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/gruntwork-io/terragrunt/internal/cache"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
+	"github.com/gruntwork-io/terragrunt/internal/telemetry"
+	"github.com/gruntwork-io/terragrunt/internal/vexec"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	// DefaultSTSEndpoint is the endpoint of the Google Security Token Service, exchanging web identity tokens.
+	DefaultSTSEndpoint = "https://sts.googleapis.com/v1/token"
+	// DefaultIAMCredentialsEndpoint is the endpoint of the Google IAM Service Account Credentials API, generating
+	// access tokens of impersonated service accounts.
+	DefaultIAMCredentialsEndpoint = "https://iamcredentials.googleapis.com"
+
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+	tokenLifetime      = time.Hour
+
+	// expiryMargin is how long before their expiry cached tokens are considered expired, so that a token is never
+	// handed out right before it expires.
+	expiryMargin = 5 * time.Minute
+
+	workloadIdentityAudiencePrefix = "//iam.googleapis.com/"
+
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	accessTokenType        = "urn:ietf:params:oauth:token-type:access_token"
+	jwtTokenType           = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// Options configures how the access token is obtained.
+type Options struct {
+	// ImpersonateServiceAccount is the email of the service account to impersonate.
+	ImpersonateServiceAccount string
+	// WorkloadIdentityProvider is the resource name of the workload identity pool provider to exchange the web
+	// identity token with, e.g. projects/123/locations/global/workloadIdentityPools/my-pool/providers/my-provider.
+	WorkloadIdentityProvider string
+	// WebIdentityToken is the web identity token exchanged through workload identity federation, or the path to a
+	// file containing it.
+	WebIdentityToken string
+}
+
+// IsSet reports whether any GCP credentials are configured.
+func (opts Options) IsSet() bool {
+	return opts.ImpersonateServiceAccount != "" || opts.WorkloadIdentityProvider != ""
+}
+
+// Provider obtains GCP access tokens from the Google STS and IAM Service Account Credentials APIs.
+type Provider struct {
+	fs                     vfs.FS
+	tokenSource            oauth2.TokenSource
+	httpClient             *http.Client
+	stsEndpoint            string
+	iamCredentialsEndpoint string
+	opts                   Options
+}
+
+// Option configures a Provider.
+type Option func(*Provider)
+
+// WithEndpoints sets the endpoints of the Google STS and IAM Service Account Credentials APIs.
+func WithEndpoints(stsEndpoint, iamCredentialsEndpoint string) Option {
+	return func(provider *Provider) {
+		provider.stsEndpoint = stsEndpoint
+		provider.iamCredentialsEndpoint = iamCredentialsEndpoint
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call the Google APIs.
+func WithHTTPClient(client *http.Client) Option {
+	return func(provider *Provider) {
+		provider.httpClient = client
+	}
+}
+
+// WithTokenSource sets the source of the token used to impersonate the service account when workload identity
+// federation isn't configured. Defaults to the Application Default Credentials.
+func WithTokenSource(tokenSource oauth2.TokenSource) Option {
+	return func(provider *Provider) {
+		provider.tokenSource = tokenSource
+	}
+}
+
+// WithFS sets the filesystem the web identity token is read from when it is a file path. A nil fs keeps the OS filesystem.
+func WithFS(fs vfs.FS) Option {
+	return func(provider *Provider) {
+		if fs != nil {
+			provider.fs = fs
+		}
+	}
+}
+
+// NewProvider returns a new Provider instance.
+func NewProvider(l log.Logger, opts Options, options ...Option) providers.Provider {
+	provider := &Provider{
+		opts:                   opts,
+		fs:                     vfs.NewOSFS(),
+		httpClient:             http.DefaultClient,
+		stsEndpoint:            DefaultSTSEndpoint,
+		iamCredentialsEndpoint: DefaultIAMCredentialsEndpoint,
+	}
+
+	for _, option := range options {
+		option(provider)
+	}
+
+	return provider
+}
+
+// Name implements providers.Name
+func (provider *Provider) Name() string {
+	return "API calls to Google IAM"
+}
+
+// GetCredentials implements providers.GetCredentials. exec is unused for googleiam because it talks to the Google
+// APIs over HTTP, not a subprocess; it is accepted to satisfy the providers.Provider interface contract.
+func (provider *Provider) GetCredentials(ctx context.Context, l log.Logger, _ vexec.Exec) (*providers.Credentials, error) {
+	opts := provider.opts
+	if !opts.IsSet() {
+		return nil, nil
+	}
+
+	cacheKey := opts.WorkloadIdentityProvider + "|" + opts.ImpersonateServiceAccount
+
+	var subjectToken string
+
+	if opts.WorkloadIdentityProvider != "" {
+		var err error
+
+		if subjectToken, err = provider.webIdentityToken(); err != nil {
+			return nil, err
+		}
+
+		// Web identity tokens are short-lived, so the access token is cached per web identity token.
+		sum := sha256.Sum256([]byte(subjectToken))
+		cacheKey += "|" + hex.EncodeToString(sum[:])
+	}
+
+	if cached, hit := credentialsCache.Get(ctx, cacheKey); hit {
+		l.Debugf("Using cached GCP credentials for %s.", provider.identity())
+		return cached, nil
+	}
+
+	var token *oauth2.Token
+
+	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "obtain_creds", map[string]any{
+		"provider":                   "google_iam",
+		"service_account":            opts.ImpersonateServiceAccount,
+		"workload_identity_provider": opts.WorkloadIdentityProvider,
+	}, func(ctx context.Context) error {
+		var tokenErr error
+
+		token, tokenErr = provider.accessToken(ctx, l, subjectToken)
+
+		return tokenErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	creds := &providers.Credentials{
+		Name: providers.GCPCredentials,
+		Envs: map[string]string{
+			"GOOGLE_OAUTH_ACCESS_TOKEN":  token.AccessToken,
+			"CLOUDSDK_AUTH_ACCESS_TOKEN": token.AccessToken,
+		},
+	}
+
+	credentialsCache.Put(ctx, cacheKey, creds, token.Expiry.Add(-expiryMargin))
+
+	return creds, nil
+}
+
+// accessToken obtains the access token of the configured identity, exchanging subjectToken when a workload identity
+// provider is configured.
+func (provider *Provider) accessToken(ctx context.Context, l log.Logger, subjectToken string) (*oauth2.Token, error) {
+	opts := provider.opts
+
+	var (
+		source *oauth2.Token
+		err    error
+	)
+
+	if opts.WorkloadIdentityProvider != "" {
+		l.Debugf("Exchanging web identity token with workload identity provider %s.", opts.WorkloadIdentityProvider)
+
+		if source, err = provider.exchangeToken(ctx, subjectToken); err != nil {
+			return nil, err
+		}
+
+		if opts.ImpersonateServiceAccount == "" {
+			return source, nil
+		}
+	} else {
+		tokenSource := provider.tokenSource
+		if tokenSource == nil {
+			defaultCreds, err := google.FindDefaultCredentials(ctx, cloudPlatformScope)
+			if err != nil {
+				return nil, ImpersonationError{ServiceAccount: opts.ImpersonateServiceAccount, Err: err}
+			}
+
+			tokenSource = defaultCreds.TokenSource
+		}
+
+		if source, err = tokenSource.Token(); err != nil {
+			return nil, ImpersonationError{ServiceAccount: opts.ImpersonateServiceAccount, Err: err}
+		}
+	}
+
+	l.Debugf("Impersonating GCP service account %s.", opts.ImpersonateServiceAccount)
+
+	return provider.generateAccessToken(ctx, source)
+}
+
+// webIdentityToken reads the web identity token, either given directly or as a path to a file containing it.
+func (provider *Provider) webIdentityToken() (string, error) {
+	opts := provider.opts
+
+	if opts.WebIdentityToken == "" {
+		return "", MissingWebIdentityTokenError{WorkloadIdentityProvider: opts.WorkloadIdentityProvider}
+	}
+
+	token, _, err := providers.ReadToken(provider.fs, opts.WebIdentityToken)
+	if err != nil {
+		return "", TokenExchangeError{WorkloadIdentityProvider: opts.WorkloadIdentityProvider, Err: err}
+	}
+
+	return token, nil
+}
+
+// exchangeToken exchanges the web identity token for a federated access token with the Google STS.
+func (provider *Provider) exchangeToken(ctx context.Context, subjectToken string) (*oauth2.Token, error) {
+	opts := provider.opts
+
+	form := url.Values{
+		"grant_type":           {tokenExchangeGrantType},
+		"audience":             {workloadIdentityAudience(opts.WorkloadIdentityProvider)},
+		"scope":                {cloudPlatformScope},
+		"requested_token_type": {accessTokenType},
+		"subject_token":        {subjectToken},
+		"subject_token_type":   {jwtTokenType},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, provider.stsEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, TokenExchangeError{WorkloadIdentityProvider: opts.WorkloadIdentityProvider, Err: err}
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+
+	if err := provider.do(req, &resp); err != nil {
+		return nil, TokenExchangeError{WorkloadIdentityProvider: opts.WorkloadIdentityProvider, Err: err}
+	}
+
+	return &oauth2.Token{
+		AccessToken: resp.AccessToken,
+		Expiry:      time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// generateAccessToken generates an access token of the impersonated service account, authenticated with source.
+func (provider *Provider) generateAccessToken(ctx context.Context, source *oauth2.Token) (*oauth2.Token, error) {
+	serviceAccount := provider.opts.ImpersonateServiceAccount
+
+	body, err := json.Marshal(map[string]any{
+		"scope":    []string{cloudPlatformScope},
+		"lifetime": fmt.Sprintf("%ds", int(tokenLifetime.Seconds())),
+	})
+	if err != nil {
+		return nil, ImpersonationError{ServiceAccount: serviceAccount, Err: err}
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/projects/-/serviceAccounts/%s:generateAccessToken",
+		strings.TrimSuffix(provider.iamCredentialsEndpoint, "/"), url.PathEscape(serviceAccount))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, ImpersonationError{ServiceAccount: serviceAccount, Err: err}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+source.AccessToken)
+
+	var resp struct {
+		ExpireTime  time.Time `json:"expireTime"`
+		AccessToken string    `json:"accessToken"`
+	}
+
+	if err := provider.do(req, &resp); err != nil {
+		return nil, ImpersonationError{ServiceAccount: serviceAccount, Err: err}
+	}
+
+	return &oauth2.Token{AccessToken: resp.AccessToken, Expiry: resp.ExpireTime}, nil
+}
+
+// do sends the request and decodes its JSON response into out, returning an error for unsuccessful responses.
+func (provider *Provider) do(req *http.Request, out any) error {
+	resp, err := provider.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s returned %s: %s", req.Method, req.URL.Redacted(), resp.Status, bytes.TrimSpace(body))
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// identity returns the identity the credentials are obtained for, for logging.
+func (provider *Provider) identity() string {
+	if provider.opts.ImpersonateServiceAccount != "" {
+		return provider.opts.ImpersonateServiceAccount
+	}
+
+	return provider.opts.WorkloadIdentityProvider
+}
+
+// workloadIdentityAudience returns the audience of the token exchange for the workload identity provider, which is
+// its full resource name.
+func workloadIdentityAudience(workloadIdentityProvider string) string {
+	if strings.HasPrefix(workloadIdentityProvider, workloadIdentityAudiencePrefix) {
+		return workloadIdentityProvider
+	}
+
+	return workloadIdentityAudiencePrefix + strings.TrimPrefix(workloadIdentityProvider, "/")
+}
+
+// credentialsCache is a cache of credentials.
+var credentialsCache = cache.NewExpiringCache[*providers.Credentials]("gcpCredentialsCache")
@@ -0,0 +1,208 @@
+package googleiam_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/googleiam"
+	"github.com/gruntwork-io/terragrunt/internal/vexec"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+)
+
+// fakeGoogle is a fake of the Google STS and IAM Service Account Credentials APIs.
+type fakeGoogle struct {
+	*httptest.Server
+	stsRequests         int
+	impersonateRequests int
+}
+
+func newFakeGoogle(t *testing.T) *fakeGoogle {
+	t.Helper()
+
+	fake := &fakeGoogle{}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /v1/token", func(w http.ResponseWriter, r *http.Request) {
+		fake.stsRequests++
+
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:token-exchange", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "urn:ietf:params:oauth:token-type:jwt", r.PostForm.Get("subject_token_type"))
+
+		if r.PostForm.Get("subject_token") != "valid-jwt" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "federated:" + r.PostForm.Get("audience"),
+			"expires_in":   3600,
+		})
+	})
+
+	mux.HandleFunc("POST /v1/projects/-/serviceAccounts/{sa}", func(w http.ResponseWriter, r *http.Request) {
+		fake.impersonateRequests++
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"accessToken": r.PathValue("sa") + "|" + r.Header.Get("Authorization"),
+			"expireTime":  time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		})
+	})
+
+	fake.Server = httptest.NewServer(mux)
+	t.Cleanup(fake.Close)
+
+	return fake
+}
+
+func (fake *fakeGoogle) provider(opts googleiam.Options, options ...googleiam.Option) providers.Provider {
+	options = append([]googleiam.Option{googleiam.WithEndpoints(fake.URL+"/v1/token", fake.URL)}, options...)
+
+	return googleiam.NewProvider(logger.CreateLogger(), opts, options...)
+}
+
+func TestGetCredentialsNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	l := logger.CreateLogger()
+
+	creds, err := googleiam.NewProvider(l, googleiam.Options{}).GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	assert.Nil(t, creds)
+}
+
+func TestGetCredentialsImpersonation(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeGoogle(t)
+	l := logger.CreateLogger()
+
+	provider := fake.provider(googleiam.Options{
+		ImpersonateServiceAccount: "deployer@impersonation.iam.gserviceaccount.com",
+	}, googleiam.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "adc-token"})))
+
+	creds, err := provider.GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+
+	expected := "deployer@impersonation.iam.gserviceaccount.com:generateAccessToken|Bearer adc-token"
+
+	assert.Equal(t, providers.GCPCredentials, creds.Name)
+	assert.Equal(t, expected, creds.Envs["GOOGLE_OAUTH_ACCESS_TOKEN"])
+	assert.Equal(t, expected, creds.Envs["CLOUDSDK_AUTH_ACCESS_TOKEN"])
+
+	// The token is cached until it expires.
+	_, err = provider.GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.impersonateRequests)
+}
+
+func TestGetCredentialsWorkloadIdentityFederation(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeGoogle(t)
+	l := logger.CreateLogger()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("valid-jwt\n"), 0o600))
+
+	creds, err := fake.provider(googleiam.Options{
+		WorkloadIdentityProvider: "projects/123/locations/global/workloadIdentityPools/wif/providers/direct",
+		WebIdentityToken:         tokenFile,
+	}).GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+
+	assert.Equal(t,
+		"federated://iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/wif/providers/direct",
+		creds.Envs["GOOGLE_OAUTH_ACCESS_TOKEN"])
+	assert.Equal(t, 1, fake.stsRequests)
+	assert.Zero(t, fake.impersonateRequests)
+}
+
+func TestGetCredentialsWorkloadIdentityFederationCachedPerToken(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeGoogle(t)
+	l := logger.CreateLogger()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("valid-jwt"), 0o600))
+
+	provider := fake.provider(googleiam.Options{
+		WorkloadIdentityProvider: "projects/123/locations/global/workloadIdentityPools/wif/providers/rotated",
+		WebIdentityToken:         tokenFile,
+	})
+
+	_, err := provider.GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+
+	_, err = provider.GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.stsRequests)
+
+	// A rotated web identity token is exchanged again instead of reusing the access token of the previous one.
+	require.NoError(t, os.WriteFile(tokenFile, []byte("rotated-jwt"), 0o600))
+
+	_, err = provider.GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.ErrorAs(t, err, new(googleiam.TokenExchangeError))
+	assert.Equal(t, 2, fake.stsRequests)
+}
+
+func TestGetCredentialsWorkloadIdentityFederationWithImpersonation(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeGoogle(t)
+	l := logger.CreateLogger()
+
+	creds, err := fake.provider(googleiam.Options{
+		ImpersonateServiceAccount: "deployer@wif.iam.gserviceaccount.com",
+		WorkloadIdentityProvider:  "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/wif/providers/sa",
+		WebIdentityToken:          "valid-jwt",
+	}).GetCredentials(t.Context(), l, vexec.NewOSExec())
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+
+	assert.Equal(t,
+		"deployer@wif.iam.gserviceaccount.com:generateAccessToken|Bearer federated:"+
+			"//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/wif/providers/sa",
+		creds.Envs["GOOGLE_OAUTH_ACCESS_TOKEN"])
+	assert.Equal(t, 1, fake.stsRequests)
+	assert.Equal(t, 1, fake.impersonateRequests)
+}
+
+func TestGetCredentialsErrors(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeGoogle(t)
+	l := logger.CreateLogger()
+
+	_, err := fake.provider(googleiam.Options{
+		WorkloadIdentityProvider: "projects/123/locations/global/workloadIdentityPools/wif/providers/missing",
+	}).GetCredentials(t.Context(), l, vexec.NewOSExec())
+
+	var missingErr googleiam.MissingWebIdentityTokenError
+	require.ErrorAs(t, err, &missingErr)
+
+	_, err = fake.provider(googleiam.Options{
+		WorkloadIdentityProvider: "projects/123/locations/global/workloadIdentityPools/wif/providers/invalid",
+		WebIdentityToken:         "invalid-jwt",
+	}).GetCredentials(t.Context(), l, vexec.NewOSExec())
+
+	var exchangeErr googleiam.TokenExchangeError
+	require.ErrorAs(t, err, &exchangeErr)
+	assert.Contains(t, err.Error(), "invalid_grant")
+}
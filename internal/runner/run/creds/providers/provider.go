@@ -9,7 +9,9 @@ import (
 )
 
 const (
-	AWSCredentials CredentialsName = "AWS"
+	AWSCredentials   CredentialsName = "AWS"
+	GCPCredentials   CredentialsName = "GCP"
+	AzureCredentials CredentialsName = "Azure"
 )
 
 type CredentialsName string
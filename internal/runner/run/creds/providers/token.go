@@ -0,0 +1,22 @@
+package providers
+
+import (
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+)
+
+// ReadToken returns the token, or the content of the file at its path if it is one, and whether it was read from a
+// file.
+func ReadToken(fsys vfs.FS, token string) (string, bool, error) {
+	if _, err := fsys.Stat(token); err != nil {
+		return token, false, nil //nolint:nilerr
+	}
+
+	content, err := vfs.ReadFile(fsys, token)
+	if err != nil {
+		return "", true, err
+	}
+
+	return strings.TrimSpace(string(content)), true, nil
+}
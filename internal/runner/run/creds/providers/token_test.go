@@ -0,0 +1,27 @@
+package providers_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadToken(t *testing.T) {
+	t.Parallel()
+
+	fs := vfs.NewMemMapFS()
+	require.NoError(t, vfs.WriteFile(fs, "/var/run/token", []byte("file-token\n"), 0o600))
+
+	token, isFile, err := providers.ReadToken(fs, "/var/run/token")
+	require.NoError(t, err)
+	assert.Equal(t, "file-token", token)
+	assert.True(t, isFile)
+
+	token, isFile, err = providers.ReadToken(fs, "inline-token")
+	require.NoError(t, err)
+	assert.Equal(t, "inline-token", token)
+	assert.False(t, isFile)
+}
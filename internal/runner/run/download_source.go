@@ -406,7 +406,7 @@ func tryCASDownload(ctx context.Context, l log.Logger, src *tf.Source, opts *Opt
 		return false, err
 	}
 
-	c, err := cas.New(cas.WithCloneDepth(opts.CASCloneDepth))
+	c, err := cas.New(cas.WithCloneDepth(opts.CASCloneDepth), cas.WithRemote(opts.CASRemote))
 	if err != nil {
 		l.Warnf("Failed to initialize CAS: %v. Falling back to standard getter.", err)
 		cas.RecordFallback(ctx, l, cas.FallbackReasonInitError, map[string]any{"url": canonicalSourceURL})
@@ -422,6 +422,14 @@ func tryCASDownload(ctx context.Context, l log.Logger, src *tf.Source, opts *Opt
 		return false, nil
 	}
 
+	// Sources imported from an offline bundle resolve from the local store without reaching the network.
+	if index, err := c.ReadSourceIndex(venv); err != nil {
+		l.Warnf("Failed to read CAS source index: %v", err)
+	} else if ref, ok := index.Resolve(canonicalSourceURL); ok {
+		l.Debugf("Resolved source %s to %s from the CAS source index", canonicalSourceURL, ref)
+		canonicalSourceURL = ref
+	}
+
 	cloneOpts := cas.CloneOptions{
 		Dir:              src.DownloadDir,
 		IncludedGitFiles: []string{"HEAD", "config"},
@@ -2,6 +2,9 @@ package run
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/runcfg"
 )
 
 // Custom error types
@@ -48,6 +51,40 @@ func (err ModuleIsProtected) Error() string {
 	return fmt.Sprintf("Unit is protected by the prevent_destroy flag in %s. Set it to false or remove it to allow destruction of the unit.", err.ConfigPath)
 }
 
+// PolicyViolationError is returned when the plan of a unit violates its policies.
+type PolicyViolationError struct {
+	ConfigPath string
+	Violations []runcfg.PolicyViolation
+}
+
+func (err PolicyViolationError) Error() string {
+	messages := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		messages = append(messages, fmt.Sprintf("policy %q: %s", violation.Policy, violation.Message))
+	}
+
+	return fmt.Sprintf("Plan of the unit in %s violates %d policies: %s", err.ConfigPath, len(err.Violations), strings.Join(messages, "; "))
+}
+
+// Policies returns the names of the violated policies.
+func (err PolicyViolationError) Policies() []string {
+	policies := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		policies = append(policies, violation.Policy)
+	}
+
+	return policies
+}
+
+// PlanNotApprovedError is returned when the user doesn't confirm the plan that passed the policies of a unit.
+type PlanNotApprovedError struct {
+	ConfigPath string
+}
+
+func (err PlanNotApprovedError) Error() string {
+	return fmt.Sprintf("Plan of the unit in %s was not approved, no changes were applied", err.ConfigPath)
+}
+
 // Legacy retry error removed in favor of error handling via options.Errors
 
 type RunAllDisabledErr struct {
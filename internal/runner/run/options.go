@@ -11,6 +11,7 @@ import (
 
 	"errors"
 
+	"github.com/gruntwork-io/terragrunt/internal/cas"
 	"github.com/gruntwork-io/terragrunt/internal/cloner"
 	"github.com/gruntwork-io/terragrunt/internal/engine"
 	"github.com/gruntwork-io/terragrunt/internal/errorconfig"
@@ -72,6 +73,7 @@ type Options struct {
 	AuthProviderCmd              string
 	OriginalIAMRoleOptions       iam.RoleOptions
 	IAMRoleOptions               iam.RoleOptions
+	CASRemote                    cas.RemoteOptions
 	Experiments                  experiment.Experiments
 	StrictControls               strict.Controls
 	MaxFoldersToCheck            int
@@ -232,6 +234,7 @@ func (o *Options) remoteStateOpts() *remotestate.Options {
 		Options: backend.Options{
 			Writers:                      o.Writers,
 			Env:                          o.Env,
+			WorkingDir:                   o.CacheDir,
 			IAMRoleOptions:               o.IAMRoleOptions,
 			NonInteractive:               o.NonInteractive,
 			FailIfBucketCreationRequired: o.FailIfBucketCreationRequired,
@@ -280,18 +283,24 @@ func (o *Options) RunWithErrorHandling(
 			return nil
 		}
 
+		// Policy violations and declined plans are decisions, not failures: the errors rules must neither retry
+		// nor ignore them.
+		if errors.As(err, new(PolicyViolationError)) || errors.As(err, new(PlanNotApprovedError)) {
+			return err
+		}
+
 		action, recoveryErr := o.Errors.AttemptErrorRecovery(l, err, currentAttempt)
 		if recoveryErr != nil {
 			var maxAttemptsReachedError *errorconfig.MaxAttemptsReachedError
 			if errors.As(recoveryErr, &maxAttemptsReachedError) {
-				return maxAttemptsReachedError
+				return o.Errors.ExplainError(maxAttemptsReachedError)
 			}
 
 			return fmt.Errorf("encountered error while attempting error recovery: %w", recoveryErr)
 		}
 
 		if action == nil {
-			return err
+			return o.Errors.ExplainError(err)
 		}
 
 		if action.ShouldIgnore {
@@ -323,7 +332,7 @@ func (o *Options) RunWithErrorHandling(
 
 		if action.ShouldRetry {
 			if !o.AutoRetry {
-				return err
+				return o.Errors.ExplainError(err)
 			}
 
 			l.Warnf(
@@ -360,7 +369,7 @@ func (o *Options) RunWithErrorHandling(
 			continue
 		}
 
-		return err
+		return o.Errors.ExplainError(err)
 	}
 }
 
@@ -0,0 +1,137 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/iacargs"
+	"github.com/gruntwork-io/terragrunt/internal/runner/changeset"
+	"github.com/gruntwork-io/terragrunt/internal/runner/runcfg"
+	"github.com/gruntwork-io/terragrunt/internal/shell"
+	"github.com/gruntwork-io/terragrunt/internal/tf"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// PolicyPlanFile is the name of the plan file written in the working directory of a unit to evaluate its policies.
+const PolicyPlanFile = "terragrunt-policy.tfplan"
+
+// applyOnlyFlags are the flags of `apply` and `destroy` that `plan` does not accept.
+var applyOnlyFlags = []string{
+	"auto-approve",
+	"backup",
+	"state-out",
+}
+
+// planOnlyFlags are the flags of `apply` that configure the plan, and cannot be passed when applying a saved plan.
+var planOnlyFlags = []string{
+	"var",
+	"var-file",
+	"target",
+	"exclude",
+	"replace",
+	"destroy",
+	"refresh-only",
+	"refresh",
+}
+
+// evaluatePolicies evaluates the policies of the unit against the plan of an `apply` or `destroy`, before it is applied.
+//
+// Unless the `apply` is given a saved plan, the plan is saved to PolicyPlanFile first, and the returned args apply that
+// plan, so that what is applied is exactly what was evaluated. A `destroy` is planned with `-destroy` and applied the
+// same way. Since a saved plan is applied without asking, the user is asked to confirm the plan once it passed the
+// policies, unless `-auto-approve` or --non-interactive is set. The returned cleanup function removes the saved plan,
+// and must be called even if an error is returned.
+func evaluatePolicies(
+	ctx context.Context,
+	l log.Logger,
+	v Venv,
+	opts *Options,
+	cfg *runcfg.RunConfig,
+) (*iacargs.IacArgs, func(), error) {
+	args := opts.TerraformCliArgs
+	cleanup := func() {}
+
+	if len(cfg.Policies) == 0 || !changesState(args) {
+		return args, cleanup, nil
+	}
+
+	planFile := args.Last()
+	confirm := false
+
+	if args.First() == tf.CommandNameDestroy || !args.HasPlanFile() {
+		confirm = !args.HasFlag("auto-approve") && !opts.NonInteractive
+
+		planFile = filepath.Join(opts.CacheDir, PolicyPlanFile)
+
+		planArgs := args.Clone().SetCommand(tf.CommandNamePlan)
+		for _, flag := range applyOnlyFlags {
+			planArgs.RemoveFlag(flag)
+		}
+
+		if args.First() == tf.CommandNameDestroy {
+			planArgs.AddFlagIfNotPresent("-destroy")
+		}
+
+		planArgs.AppendFlag("-out=" + planFile)
+
+		l.Debugf("Planning %s to evaluate %d policies", opts.TerragruntConfigPath, len(cfg.Policies))
+
+		cleanup = func() {
+			if err := os.Remove(planFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+				l.Debugf("Failed to remove policy plan file %s: %v", planFile, err)
+			}
+		}
+
+		if _, err := tf.RunCommandWithOutput(ctx, l, v.Exec, opts.tfRunOptions(), planArgs.Slice()...); err != nil {
+			return nil, cleanup, err
+		}
+
+		args = args.Clone().SetCommand(tf.CommandNameApply)
+		for _, flag := range planOnlyFlags {
+			args.RemoveFlag(flag)
+		}
+
+		args.AppendArgument(planFile)
+	}
+
+	showOpts := opts.tfRunOptions()
+	showOpts.JSONLogFormat = false
+	showOpts.ShellOptions.Writers.Writer = io.Discard
+	showOpts.ShellOptions.ForwardTFStdout = true
+
+	out, err := tf.RunCommandWithOutput(ctx, l, v.Exec, showOpts, tf.CommandNameShow, "-json", planFile)
+	if err != nil {
+		return nil, cleanup, err
+	}
+
+	changes, err := changeset.ParseResourceChanges(out.Stdout.Bytes())
+	if err != nil {
+		return nil, cleanup, err
+	}
+
+	if violations := runcfg.EvaluatePolicies(cfg.Policies, changes, time.Now()); len(violations) > 0 {
+		return nil, cleanup, PolicyViolationError{ConfigPath: opts.TerragruntConfigPath, Violations: violations}
+	}
+
+	l.Debugf("Plan of %s passed %d policies", opts.TerragruntConfigPath, len(cfg.Policies))
+
+	if !confirm {
+		return args, cleanup, nil
+	}
+
+	prompt := "The plan passed all policies. Do you want to perform these actions?"
+	if yes, err := shell.PromptUserForYesNo(ctx, l, prompt, opts.NonInteractive, opts.Writers.ErrWriter); err != nil || !yes {
+		return nil, cleanup, errors.Join(PlanNotApprovedError{ConfigPath: opts.TerragruntConfigPath}, err)
+	}
+
+	return args, cleanup, nil
+}
+
+// changesState returns true if the command applies changes to the state, and its plan is subject to policies.
+func changesState(args *iacargs.IacArgs) bool {
+	return args.First() == tf.CommandNameApply || args.First() == tf.CommandNameDestroy
+}
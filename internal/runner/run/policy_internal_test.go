@@ -0,0 +1,279 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/clihelper"
+	"github.com/gruntwork-io/terragrunt/internal/errorconfig"
+	"github.com/gruntwork-io/terragrunt/internal/iacargs"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/internal/runner/runcfg"
+	"github.com/gruntwork-io/terragrunt/internal/tf"
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolicyPlan = `{
+  "resource_changes": [
+    {"address": "aws_db_instance.main", "type": "aws_db_instance", "change": {"actions": ["delete"]}},
+    {"address": "aws_instance.web", "type": "aws_instance", "change": {"actions": ["create"]}}
+  ]
+}`
+
+// fakePolicyCommands returns a context that records the terraform commands instead of running them,
+// and answers `show -json` with testPolicyPlan.
+func fakePolicyCommands(t *testing.T) (context.Context, func() [][]string) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		commands [][]string
+	)
+
+	ctx := tf.ContextWithTerraformCommandHook(t.Context(), func(_ context.Context, _ log.Logger, _ *tf.TFOptions, args clihelper.Args) (*util.CmdOutput, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		commands = append(commands, args.Slice())
+
+		out := &util.CmdOutput{}
+		if args.CommandName() == tf.CommandNameShow {
+			out.Stdout.WriteString(testPolicyPlan)
+		}
+
+		return out, nil
+	})
+
+	return ctx, func() [][]string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return commands
+	}
+}
+
+func TestEvaluatePoliciesAppliesEvaluatedPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx, commands := fakePolicyCommands(t)
+
+	opts := NewOptions()
+	opts.CacheDir = t.TempDir()
+	opts.TerraformCliArgs = iacargs.New(tf.CommandNameApply, "-auto-approve", "-backup=state.bak", "-var", "env=prod", "-input=false")
+
+	cfg := &runcfg.RunConfig{
+		Policies: []runcfg.Policy{{Name: "no-db-replacements", DenyActions: []string{runcfg.PolicyActionReplace}}},
+	}
+
+	args, cleanup, err := evaluatePolicies(ctx, logger.CreateLogger(), Venv{}, opts, cfg)
+	defer cleanup()
+
+	require.NoError(t, err)
+
+	planFile := filepath.Join(opts.CacheDir, PolicyPlanFile)
+
+	assert.Equal(t, [][]string{
+		{tf.CommandNamePlan, "-var", "env=prod", "-input=false", "-out=" + planFile},
+		{tf.CommandNameShow, "-json", planFile},
+	}, commands())
+	assert.Equal(t, []string{tf.CommandNameApply, "-auto-approve", "-backup=state.bak", "-input=false", planFile}, args.Slice())
+	assert.Equal(t, []string{tf.CommandNameApply, "-auto-approve", "-backup=state.bak", "-var", "env=prod", "-input=false"}, opts.TerraformCliArgs.Slice())
+}
+
+func TestEvaluatePoliciesConfirmsEvaluatedPlan(t *testing.T) {
+	t.Parallel()
+
+	fakeCtx, commands := fakePolicyCommands(t)
+	hook := tf.TerraformCommandHookFromContext(fakeCtx)
+
+	// The prompt is answered by cancelling the context once the plan is shown, so that it fails without reading stdin.
+	ctx, cancel := context.WithCancel(fakeCtx)
+	defer cancel()
+
+	ctx = tf.ContextWithTerraformCommandHook(ctx, func(ctx context.Context, l log.Logger, opts *tf.TFOptions, args clihelper.Args) (*util.CmdOutput, error) {
+		if args.CommandName() == tf.CommandNameShow {
+			defer cancel()
+		}
+
+		return hook(ctx, l, opts, args)
+	})
+
+	var prompts bytes.Buffer
+
+	opts := NewOptions()
+	opts.CacheDir = t.TempDir()
+	opts.Writers.ErrWriter = &prompts
+	opts.TerraformCliArgs = iacargs.New(tf.CommandNameApply, "-var", "env=prod")
+
+	cfg := &runcfg.RunConfig{
+		Policies: []runcfg.Policy{{Name: "no-db-replacements", DenyActions: []string{runcfg.PolicyActionReplace}}},
+	}
+
+	_, cleanup, err := evaluatePolicies(ctx, logger.CreateLogger(), Venv{}, opts, cfg)
+	defer cleanup()
+
+	planFile := filepath.Join(opts.CacheDir, PolicyPlanFile)
+
+	// Without -auto-approve, the saved plan is not applied until the user confirms it.
+	assert.Equal(t, [][]string{
+		{tf.CommandNamePlan, "-var", "env=prod", "-out=" + planFile},
+		{tf.CommandNameShow, "-json", planFile},
+	}, commands())
+	assert.Contains(t, prompts.String(), "Do you want to perform these actions?")
+	require.ErrorAs(t, err, new(PlanNotApprovedError))
+}
+
+func TestEvaluatePoliciesNonInteractive(t *testing.T) {
+	t.Parallel()
+
+	ctx, _ := fakePolicyCommands(t)
+
+	var prompts bytes.Buffer
+
+	opts := NewOptions()
+	opts.CacheDir = t.TempDir()
+	opts.NonInteractive = true
+	opts.Writers.ErrWriter = &prompts
+	opts.TerraformCliArgs = iacargs.New(tf.CommandNameApply)
+
+	cfg := &runcfg.RunConfig{
+		Policies: []runcfg.Policy{{Name: "no-db-replacements", DenyActions: []string{runcfg.PolicyActionReplace}}},
+	}
+
+	args, cleanup, err := evaluatePolicies(ctx, logger.CreateLogger(), Venv{}, opts, cfg)
+	defer cleanup()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{tf.CommandNameApply, filepath.Join(opts.CacheDir, PolicyPlanFile)}, args.Slice())
+	assert.Empty(t, prompts.String())
+}
+
+func TestEvaluatePoliciesDestroy(t *testing.T) {
+	t.Parallel()
+
+	ctx, commands := fakePolicyCommands(t)
+
+	opts := NewOptions()
+	opts.CacheDir = t.TempDir()
+	opts.TerraformCliArgs = iacargs.New(tf.CommandNameDestroy, "-auto-approve", "-var", "env=prod")
+
+	cfg := &runcfg.RunConfig{
+		Policies: []runcfg.Policy{{Name: "no-db-deletes", DenyActions: []string{runcfg.PolicyActionDelete}, ResourceTypes: []string{"aws_db_*"}}},
+	}
+
+	_, cleanup, err := evaluatePolicies(ctx, logger.CreateLogger(), Venv{}, opts, cfg)
+	defer cleanup()
+
+	planFile := filepath.Join(opts.CacheDir, PolicyPlanFile)
+
+	assert.Equal(t, [][]string{
+		{tf.CommandNamePlan, "-var", "env=prod", "-destroy", "-out=" + planFile},
+		{tf.CommandNameShow, "-json", planFile},
+	}, commands())
+
+	var policyErr PolicyViolationError
+
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, []string{"no-db-deletes"}, policyErr.Policies())
+}
+
+func TestEvaluatePoliciesAppliesEvaluatedDestroyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx, _ := fakePolicyCommands(t)
+
+	opts := NewOptions()
+	opts.CacheDir = t.TempDir()
+	opts.TerraformCliArgs = iacargs.New(tf.CommandNameDestroy, "-auto-approve")
+
+	cfg := &runcfg.RunConfig{
+		Policies: []runcfg.Policy{{Name: "no-creates", DenyActions: []string{runcfg.PolicyActionCreate}, ResourceTypes: []string{"aws_s3_bucket"}}},
+	}
+
+	args, cleanup, err := evaluatePolicies(ctx, logger.CreateLogger(), Venv{}, opts, cfg)
+	defer cleanup()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{tf.CommandNameApply, "-auto-approve", filepath.Join(opts.CacheDir, PolicyPlanFile)}, args.Slice())
+}
+
+func TestRunWithErrorHandlingKeepsPolicyViolations(t *testing.T) {
+	t.Parallel()
+
+	opts := NewOptions()
+	opts.CacheDir = t.TempDir()
+	opts.Errors = &errorconfig.Config{
+		Ignore: map[string]*errorconfig.IgnoreConfig{
+			"all": {Name: "all", IgnorableErrors: []*errorconfig.Pattern{{Pattern: regexp.MustCompile(".*")}}},
+		},
+	}
+
+	attempts := 0
+	violation := PolicyViolationError{Violations: []runcfg.PolicyViolation{{Policy: "no-db-deletes", Message: "denied"}}}
+
+	err := opts.RunWithErrorHandling(t.Context(), logger.CreateLogger(), report.NewReport(), func() error {
+		attempts++
+		return violation
+	})
+
+	require.ErrorAs(t, err, new(PolicyViolationError))
+	assert.Equal(t, 1, attempts)
+}
+
+func TestEvaluatePoliciesViolation(t *testing.T) {
+	t.Parallel()
+
+	ctx, commands := fakePolicyCommands(t)
+
+	opts := NewOptions()
+	opts.CacheDir = t.TempDir()
+	opts.TerragruntConfigPath = filepath.Join(opts.CacheDir, "terragrunt.hcl")
+	opts.TerraformCliArgs = iacargs.New(tf.CommandNameApply, "saved.tfplan")
+
+	cfg := &runcfg.RunConfig{
+		Policies: []runcfg.Policy{
+			{Name: "no-db-deletes", DenyActions: []string{runcfg.PolicyActionDelete}, ResourceTypes: []string{"aws_db_*"}},
+			{Name: "no-creates", DenyActions: []string{runcfg.PolicyActionCreate}, ResourceTypes: []string{"aws_s3_bucket"}},
+		},
+	}
+
+	_, cleanup, err := evaluatePolicies(ctx, logger.CreateLogger(), Venv{}, opts, cfg)
+	defer cleanup()
+
+	// A saved plan is evaluated as is, without planning again
+	assert.Equal(t, [][]string{{tf.CommandNameShow, "-json", "saved.tfplan"}}, commands())
+
+	var policyErr PolicyViolationError
+
+	require.ErrorAs(t, err, &policyErr)
+	assert.Equal(t, []string{"no-db-deletes"}, policyErr.Policies())
+	assert.Equal(t, "delete of aws_db_instance.main is denied", policyErr.Violations[0].Message)
+}
+
+func TestEvaluatePoliciesSkipsOtherCommands(t *testing.T) {
+	t.Parallel()
+
+	ctx, commands := fakePolicyCommands(t)
+
+	opts := NewOptions()
+	opts.TerraformCliArgs = iacargs.New(tf.CommandNamePlan)
+
+	cfg := &runcfg.RunConfig{
+		Policies: []runcfg.Policy{{Name: "no-deletes", DenyActions: []string{runcfg.PolicyActionDelete}}},
+	}
+
+	args, cleanup, err := evaluatePolicies(ctx, logger.CreateLogger(), Venv{}, opts, cfg)
+	defer cleanup()
+
+	require.NoError(t, err)
+	assert.Same(t, opts.TerraformCliArgs, args)
+	assert.Empty(t, commands())
+}
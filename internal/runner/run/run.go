@@ -26,6 +26,8 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/amazonsts"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/azuread"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/googleiam"
 	"github.com/gruntwork-io/terragrunt/internal/runner/runcfg"
 	"github.com/gruntwork-io/terragrunt/internal/telemetry"
 	"github.com/gruntwork-io/terragrunt/internal/tf"
@@ -102,6 +104,12 @@ func Run(
 	// Only overwrite when the config actually defines error rules;
 	// otherwise preserve the built-in default retryable errors.
 	if errConfig != nil {
+		// Explain-only rules keep the built-in default retryable errors.
+		if !errConfig.HasRecoveryRules() && opts.Errors != nil {
+			errConfig.Retry = opts.Errors.Retry
+			errConfig.Ignore = opts.Errors.Ignore
+		}
+
 		opts.Errors = errConfig
 	}
 
@@ -131,7 +139,11 @@ func Run(
 	)
 
 	if err = opts.RunWithErrorHandling(ctx, l, r, func() error {
-		return credsGetter.ObtainAndUpdateEnvIfNecessary(ctx, l, v.Exec, opts.Env, amazonsts.NewProvider(l, opts.IAMRoleOptions, opts.Env))
+		return credsGetter.ObtainAndUpdateEnvIfNecessary(ctx, l, v.Exec, opts.Env,
+			amazonsts.NewProvider(l, opts.IAMRoleOptions, opts.Env),
+			googleiam.NewProvider(l, cfg.GetGCPCredentialsOptions(), googleiam.WithFS(v.FS)),
+			azuread.NewProvider(l, cfg.GetAzureCredentialsOptions(), opts.Env, azuread.WithFS(v.FS)),
+		)
 	}); err != nil {
 		return err
 	}
@@ -282,8 +294,16 @@ func runTerragruntWithConfig(
 	}
 
 	return RunActionWithHooks(ctx, l, v, "terraform", opts, cfg, r, func(childCtx context.Context) error {
+		// Evaluate the policies against the plan before applying it, and apply the evaluated plan
+		args, cleanupPolicyPlan, err := evaluatePolicies(childCtx, l, v, opts, cfg)
+		defer cleanupPolicyPlan()
+
+		if err != nil {
+			return err
+		}
+
 		// Execute the underlying command once; retries and ignores are handled by outer RunWithErrorHandling
-		out, runTerraformError := tf.RunCommandWithOutput(childCtx, l, v.Exec, opts.tfRunOptions(), opts.TerraformCliArgs.Slice()...)
+		out, runTerraformError := tf.RunCommandWithOutput(childCtx, l, v.Exec, opts.tfRunOptions(), args.Slice()...)
 
 		var lockFileError error
 		if ShouldCopyLockFile(opts.TerraformCliArgs, &cfg.Terraform) {
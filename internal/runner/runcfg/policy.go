@@ -0,0 +1,188 @@
+package runcfg
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/changeset"
+)
+
+// Actions a policy can deny.
+const (
+	PolicyActionCreate  = "create"
+	PolicyActionUpdate  = "update"
+	PolicyActionDelete  = "delete"
+	PolicyActionReplace = "replace"
+)
+
+// Policy is a guardrail evaluated against the plan of a unit before it is applied.
+type Policy struct {
+	// MaxReplacements is the maximum number of resources the plan may replace.
+	MaxReplacements *int
+	// MaxDestroys is the maximum number of resources the plan may destroy, including replacements.
+	MaxDestroys *int
+	// ChangeWindow restricts when the plan may change resources.
+	ChangeWindow *ChangeWindow
+	// Name is the name of the policy.
+	Name string
+	// Message is shown when the policy is violated.
+	Message string
+	// DenyActions are the actions the plan may not perform.
+	DenyActions []string
+	// ResourceTypes limits the policy to resources of the given types, which may contain glob patterns.
+	ResourceTypes []string
+}
+
+// ChangeWindow is the time window in which a plan may change resources.
+type ChangeWindow struct {
+	// Location is the time zone of the window.
+	Location *time.Location
+	// Days are the days of the week of the window, every day if empty.
+	Days []time.Weekday
+	// Start is the start of the window, as the time since midnight.
+	Start time.Duration
+	// End is the end of the window, as the time since midnight. If it is before Start, the window spans midnight.
+	End time.Duration
+}
+
+// PolicyViolation is a policy violated by a plan.
+type PolicyViolation struct {
+	// Policy is the name of the violated policy.
+	Policy string
+	// Message is the message of the policy, or a description of the violation if the policy has none.
+	Message string
+}
+
+// EvaluatePolicies evaluates the policies against the resource changes of a plan at the given time,
+// and returns the violated policies.
+func EvaluatePolicies(policies []Policy, changes []*changeset.ResourceChange, now time.Time) []PolicyViolation {
+	var violations []PolicyViolation
+
+	for _, policy := range policies {
+		reason := policy.Evaluate(changes, now)
+		if reason == "" {
+			continue
+		}
+
+		message := policy.Message
+		if message == "" {
+			message = reason
+		}
+
+		violations = append(violations, PolicyViolation{Policy: policy.Name, Message: message})
+	}
+
+	return violations
+}
+
+// Evaluate evaluates the policy against the resource changes of a plan at the given time,
+// and returns the reason the policy is violated, or an empty string if it is not.
+func (policy Policy) Evaluate(changes []*changeset.ResourceChange, now time.Time) string {
+	var replacements, destroys, changed int
+
+	for _, resource := range changes {
+		if !policy.matches(resource) {
+			continue
+		}
+
+		for _, action := range policy.DenyActions {
+			if resourceHasAction(resource, action) {
+				return fmt.Sprintf("%s of %s is denied", action, resource.Address)
+			}
+		}
+
+		switch {
+		case resource.IsReplace():
+			replacements++
+			destroys++
+			changed++
+		case resource.IsDelete():
+			destroys++
+			changed++
+		case resource.IsCreate(), resource.IsUpdate():
+			changed++
+		}
+	}
+
+	if policy.MaxReplacements != nil && replacements > *policy.MaxReplacements {
+		return fmt.Sprintf("%d resources to replace, at most %d allowed", replacements, *policy.MaxReplacements)
+	}
+
+	if policy.MaxDestroys != nil && destroys > *policy.MaxDestroys {
+		return fmt.Sprintf("%d resources to destroy, at most %d allowed", destroys, *policy.MaxDestroys)
+	}
+
+	if policy.ChangeWindow != nil && changed > 0 && !policy.ChangeWindow.Contains(now) {
+		return fmt.Sprintf("%d resources to change outside of the change window", changed)
+	}
+
+	return ""
+}
+
+// matches returns true if the policy applies to the resource.
+func (policy Policy) matches(resource *changeset.ResourceChange) bool {
+	if len(policy.ResourceTypes) == 0 {
+		return true
+	}
+
+	for _, pattern := range policy.ResourceTypes {
+		if matched, err := path.Match(pattern, resource.Type); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Contains returns true if the given time is within the change window.
+func (window *ChangeWindow) Contains(now time.Time) bool {
+	location := window.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	now = now.In(location)
+
+	sinceMidnight := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute +
+		time.Duration(now.Second())*time.Second
+
+	day := now.Weekday()
+
+	if window.Start <= window.End {
+		return window.hasDay(day) && sinceMidnight >= window.Start && sinceMidnight < window.End
+	}
+
+	// The window spans midnight, so the time after midnight belongs to the window that started the day before.
+	if sinceMidnight >= window.Start {
+		return window.hasDay(day)
+	}
+
+	if sinceMidnight < window.End {
+		return window.hasDay((day + 6) % 7) //nolint:mnd
+	}
+
+	return false
+}
+
+func (window *ChangeWindow) hasDay(day time.Weekday) bool {
+	return len(window.Days) == 0 || slices.Contains(window.Days, day)
+}
+
+// resourceHasAction returns true if the resource change performs the action. A replacement destroys the resource,
+// so denying `delete` denies replacements too.
+func resourceHasAction(resource *changeset.ResourceChange, action string) bool {
+	switch action {
+	case PolicyActionCreate:
+		return resource.IsCreate()
+	case PolicyActionUpdate:
+		return resource.IsUpdate()
+	case PolicyActionDelete:
+		return resource.IsDelete() || resource.IsReplace()
+	case PolicyActionReplace:
+		return resource.IsReplace()
+	}
+
+	return false
+}
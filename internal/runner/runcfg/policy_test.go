@@ -0,0 +1,141 @@
+package runcfg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/changeset"
+	"github.com/gruntwork-io/terragrunt/internal/runner/runcfg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyEvaluate(t *testing.T) {
+	t.Parallel()
+
+	changes := []*changeset.ResourceChange{
+		{Address: "aws_db_instance.main", Type: "aws_db_instance", Change: &changeset.Change{Actions: []string{"delete", "create"}}},
+		{Address: "aws_instance.web", Type: "aws_instance", Change: &changeset.Change{Actions: []string{"delete", "create"}}},
+		{Address: "aws_instance.old", Type: "aws_instance", Change: &changeset.Change{Actions: []string{"delete"}}},
+		{Address: "aws_s3_bucket.logs", Type: "aws_s3_bucket", Change: &changeset.Change{Actions: []string{"update"}}},
+		{Address: "aws_vpc.main", Type: "aws_vpc", Change: &changeset.Change{Actions: []string{"no-op"}}},
+	}
+
+	// Wednesday, 2024-03-20 12:00 UTC
+	now := time.Date(2024, time.March, 20, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		expected string
+		policy   runcfg.Policy
+	}{
+		{
+			name:     "denied action",
+			policy:   runcfg.Policy{DenyActions: []string{runcfg.PolicyActionReplace}, ResourceTypes: []string{"aws_db_*"}},
+			expected: "replace of aws_db_instance.main is denied",
+		},
+		{
+			name:     "denied delete of replaced resource",
+			policy:   runcfg.Policy{DenyActions: []string{runcfg.PolicyActionDelete}, ResourceTypes: []string{"aws_db_instance"}},
+			expected: "delete of aws_db_instance.main is denied",
+		},
+		{
+			name:   "denied action on other resource types",
+			policy: runcfg.Policy{DenyActions: []string{runcfg.PolicyActionDelete}, ResourceTypes: []string{"aws_s3_bucket"}},
+		},
+		{
+			name:     "too many replacements",
+			policy:   runcfg.Policy{MaxReplacements: new(1)},
+			expected: "2 resources to replace, at most 1 allowed",
+		},
+		{
+			name:     "destroys within limit",
+			policy:   runcfg.Policy{MaxDestroys: new(2), ResourceTypes: []string{"aws_instance"}},
+			expected: "",
+		},
+		{
+			name:     "too many destroys across types",
+			policy:   runcfg.Policy{MaxDestroys: new(2)},
+			expected: "3 resources to destroy, at most 2 allowed",
+		},
+		{
+			name: "inside change window",
+			policy: runcfg.Policy{ChangeWindow: &runcfg.ChangeWindow{
+				Days:  []time.Weekday{time.Wednesday},
+				Start: 9 * time.Hour,
+				End:   17 * time.Hour,
+			}},
+		},
+		{
+			name: "outside change window",
+			policy: runcfg.Policy{ChangeWindow: &runcfg.ChangeWindow{
+				Start: 18 * time.Hour,
+				End:   6 * time.Hour,
+			}},
+			expected: "4 resources to change outside of the change window",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, tc.policy.Evaluate(changes, now))
+		})
+	}
+}
+
+func TestEvaluatePolicies(t *testing.T) {
+	t.Parallel()
+
+	changes := []*changeset.ResourceChange{
+		{Address: "aws_db_instance.main", Type: "aws_db_instance", Change: &changeset.Change{Actions: []string{"delete"}}},
+	}
+
+	policies := []runcfg.Policy{
+		{Name: "no-db-deletes", DenyActions: []string{runcfg.PolicyActionDelete}, Message: "Databases must not be deleted"},
+		{Name: "no-creates", DenyActions: []string{runcfg.PolicyActionCreate}},
+		{Name: "no-destroys", MaxDestroys: new(0)},
+	}
+
+	assert.Equal(t, []runcfg.PolicyViolation{
+		{Policy: "no-db-deletes", Message: "Databases must not be deleted"},
+		{Policy: "no-destroys", Message: "1 resources to destroy, at most 0 allowed"},
+	}, runcfg.EvaluatePolicies(policies, changes, time.Now()))
+}
+
+func TestChangeWindowContains(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	// Friday 22:00 to Saturday 02:00 in New York.
+	window := &runcfg.ChangeWindow{
+		Days:     []time.Weekday{time.Friday},
+		Start:    22 * time.Hour,
+		End:      2 * time.Hour,
+		Location: newYork,
+	}
+
+	testCases := []struct {
+		now      time.Time
+		name     string
+		expected bool
+	}{
+		{name: "friday evening", now: time.Date(2024, time.March, 22, 23, 0, 0, 0, newYork), expected: true},
+		{name: "saturday early morning", now: time.Date(2024, time.March, 23, 1, 0, 0, 0, newYork), expected: true},
+		{name: "saturday evening", now: time.Date(2024, time.March, 23, 23, 0, 0, 0, newYork), expected: false},
+		{name: "friday early morning", now: time.Date(2024, time.March, 22, 1, 0, 0, 0, newYork), expected: false},
+		{name: "friday evening in utc", now: time.Date(2024, time.March, 23, 3, 0, 0, 0, time.UTC), expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, window.Contains(tc.now))
+		})
+	}
+}
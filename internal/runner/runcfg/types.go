@@ -12,6 +12,8 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/codegen"
 	"github.com/gruntwork-io/terragrunt/internal/iam"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/azuread"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/googleiam"
 )
 
 // RunConfig contains all configuration data needed to execute terragrunt commands.
@@ -37,10 +39,17 @@ type RunConfig struct {
 	TerraformBinary string
 	// IAMRole contains IAM role options for AWS authentication
 	IAMRole iam.RoleOptions
+	// GCPCredentials contains service account impersonation and workload identity federation options for GCP
+	// authentication
+	GCPCredentials googleiam.Options
+	// AzureCredentials contains app registration options for Azure authentication
+	AzureCredentials azuread.Options
 	// Errors contains error handling configuration
 	Errors ErrorsConfig
 	// Dependencies contains paths to dependent modules
 	Dependencies ModuleDependencies
+	// Policies are evaluated against the plan before apply
+	Policies []Policy
 	// Terraform contains terraform-specific settings
 	Terraform TerraformConfig
 	// Exclude contains exclusion rules
@@ -204,6 +213,8 @@ type ErrorsConfig struct {
 	Retry []*RetryBlock
 	// Ignore contains ignore block configurations
 	Ignore []*IgnoreBlock
+	// Explain contains explain block configurations
+	Explain []*ExplainBlock
 }
 
 // RetryBlock represents a labeled retry block.
@@ -218,6 +229,18 @@ type RetryBlock struct {
 	SleepIntervalSec int
 }
 
+// ExplainBlock represents a labeled explain block.
+type ExplainBlock struct {
+	// Label is the name of the explain block
+	Label string
+	// Message explains the error to the user
+	Message string
+	// Command is an optional command suggested to fix the error
+	Command string
+	// ExplainableErrors are error patterns the message explains
+	ExplainableErrors []string
+}
+
 // IgnoreBlock represents a labeled ignore block.
 type IgnoreBlock struct {
 	// Signals contains signal mappings
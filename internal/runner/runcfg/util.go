@@ -15,6 +15,8 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/errorconfig"
 	"github.com/gruntwork-io/terragrunt/internal/getter"
 	"github.com/gruntwork-io/terragrunt/internal/iam"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/azuread"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/googleiam"
 	"github.com/gruntwork-io/terragrunt/internal/tf"
 	"github.com/gruntwork-io/terragrunt/internal/util"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -229,17 +231,28 @@ func (cfg *RunConfig) GetIAMRoleOptions() iam.RoleOptions {
 	return cfg.IAMRole
 }
 
+// GetGCPCredentialsOptions returns the GCP credentials options from the RunConfig.
+func (cfg *RunConfig) GetGCPCredentialsOptions() googleiam.Options {
+	return cfg.GCPCredentials
+}
+
+// GetAzureCredentialsOptions returns the Azure credentials options from the RunConfig.
+func (cfg *RunConfig) GetAzureCredentialsOptions() azuread.Options {
+	return cfg.AzureCredentials
+}
+
 // ErrorsConfig fetches errors configuration from the RunConfig.
-// Returns nil when no retry or ignore blocks are defined, so callers
+// Returns nil when no retry, ignore or explain blocks are defined, so callers
 // can preserve default error handling (e.g. built-in retryable errors).
 func (cfg *RunConfig) ErrorsConfig() (*errorconfig.Config, error) {
-	if len(cfg.Errors.Retry) == 0 && len(cfg.Errors.Ignore) == 0 {
+	if len(cfg.Errors.Retry) == 0 && len(cfg.Errors.Ignore) == 0 && len(cfg.Errors.Explain) == 0 {
 		return nil, nil
 	}
 
 	result := &errorconfig.Config{
-		Retry:  make(map[string]*errorconfig.RetryConfig),
-		Ignore: make(map[string]*errorconfig.IgnoreConfig),
+		Retry:   make(map[string]*errorconfig.RetryConfig),
+		Ignore:  make(map[string]*errorconfig.IgnoreConfig),
+		Explain: make(map[string]*errorconfig.ExplainConfig),
 	}
 
 	for _, retryBlock := range cfg.Errors.Retry {
@@ -320,6 +333,31 @@ func (cfg *RunConfig) ErrorsConfig() (*errorconfig.Config, error) {
 		}
 	}
 
+	for _, explainBlock := range cfg.Errors.Explain {
+		if explainBlock == nil {
+			continue
+		}
+
+		compiledPatterns := make([]*errorconfig.Pattern, 0, len(explainBlock.ExplainableErrors))
+
+		for _, pattern := range explainBlock.ExplainableErrors {
+			value, err := errorsPattern(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid explain pattern %q in block %q: %w",
+					pattern, explainBlock.Label, err)
+			}
+
+			compiledPatterns = append(compiledPatterns, value)
+		}
+
+		result.Explain[explainBlock.Label] = &errorconfig.ExplainConfig{
+			Name:              explainBlock.Label,
+			ExplainableErrors: compiledPatterns,
+			Message:           explainBlock.Message,
+			Command:           explainBlock.Command,
+		}
+	}
+
 	return result, nil
 }
 
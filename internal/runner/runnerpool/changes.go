@@ -0,0 +1,81 @@
+package runnerpool
+
+import (
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/internal/runner/changeset"
+	"github.com/gruntwork-io/terragrunt/internal/runner/common"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+// aggregatePlanChanges reads the plan JSON files written to --json-out-dir, or converted from the binary plans saved to
+// --out-dir, by the units that planned successfully, records their change counts in the report, and writes the change
+// set of the run next to them.
+func (rnr *Runner) aggregatePlanChanges(l log.Logger, fs vfs.FS, opts *options.TerragruntOptions, r *report.Report) {
+	outputFolder := common.PlanJSONOutputFolder(opts)
+
+	units := make([]*changeset.UnitChanges, 0, len(rnr.queue.Entries))
+
+	for _, entry := range rnr.queue.Entries {
+		if entry.Status != queue.StatusSucceeded {
+			continue
+		}
+
+		unit := rnr.Stack.FindUnitByPath(entry.Component.Path())
+		if unit == nil || unit.Excluded() {
+			continue
+		}
+
+		planFile := unit.OutputJSONFile(opts.RootWorkingDir, outputFolder)
+
+		data, err := vfs.ReadFile(fs, planFile)
+		if err != nil {
+			l.Warnf("Failed to read plan of unit %s: %v", unit.DisplayPath(), err)
+
+			continue
+		}
+
+		relPath, err := filepath.Rel(opts.RootWorkingDir, unit.Path())
+		if err != nil {
+			relPath = unit.Path()
+		}
+
+		changes, err := changeset.ParsePlan(relPath, data)
+		if err != nil {
+			l.Warnf("Failed to parse plan of unit %s: %v", unit.DisplayPath(), err)
+
+			continue
+		}
+
+		units = append(units, changes)
+
+		if r == nil {
+			continue
+		}
+
+		if _, err := r.EnsureRun(l, unit.Path(), report.WithChanges(report.Changes{
+			Add:     changes.Add,
+			Change:  changes.Change,
+			Destroy: changes.Destroy,
+		})); err != nil {
+			l.Errorf("Error recording changes of unit %s: %v", unit.DisplayPath(), err)
+		}
+	}
+
+	changeSetFile := filepath.Join(outputFolder, changeset.DefaultFileName)
+	if !filepath.IsAbs(changeSetFile) {
+		changeSetFile = filepath.Join(opts.RootWorkingDir, changeSetFile)
+	}
+
+	if err := changeset.New(units).Write(fs, changeSetFile); err != nil {
+		l.Warnf("Failed to write change set to %s: %v", changeSetFile, err)
+
+		return
+	}
+
+	l.Debugf("Wrote change set of %d units to %s", len(units), changeSetFile)
+}
@@ -0,0 +1,146 @@
+package runnerpool
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+
+	"github.com/gruntwork-io/terragrunt/internal/queue"
+	"github.com/gruntwork-io/terragrunt/internal/report"
+	"github.com/gruntwork-io/terragrunt/internal/runner/checkpoint"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/gruntwork-io/terragrunt/pkg/options"
+)
+
+// runCheckpoint tracks the checkpoint of a run.
+type runCheckpoint struct {
+	fs           vfs.FS
+	fingerprints map[string]string
+	path         string
+	command      string
+	args         []string
+	mu           sync.Mutex
+}
+
+// newRunCheckpoint returns the checkpoint of the run, or nil if the run is not checkpointed. Runs are only
+// checkpointed with --checkpoint-file or --resume, so that other runs don't pay for fingerprinting every unit.
+func (rnr *Runner) newRunCheckpoint(fs vfs.FS, opts *options.TerragruntOptions) (*runCheckpoint, error) {
+	if fs == nil || rnr.queue == nil {
+		return nil, nil
+	}
+
+	if opts.CheckpointFile == "" && !opts.Resume {
+		return nil, nil
+	}
+
+	path := opts.CheckpointFile
+	if path == "" {
+		path = checkpoint.DefaultPath(opts.WorkingDir)
+	} else if !filepath.IsAbs(path) {
+		path = filepath.Join(opts.WorkingDir, path)
+	}
+
+	fingerprints := make(map[string]string, len(rnr.queue.Entries))
+
+	for _, entry := range rnr.queue.Entries {
+		unit := rnr.Stack.FindUnitByPath(entry.Component.Path())
+		if unit == nil {
+			continue
+		}
+
+		fingerprint, err := checkpoint.Fingerprint(fs, unit)
+		if err != nil {
+			return nil, err
+		}
+
+		fingerprints[unit.Path()] = fingerprint
+	}
+
+	return &runCheckpoint{
+		fs:           fs,
+		fingerprints: fingerprints,
+		path:         path,
+		command:      opts.TerraformCommand,
+		args:         opts.TerraformCliArgs.Tail(),
+	}, nil
+}
+
+// resume loads the checkpoint of the previous run, and marks the units that already succeeded as succeeded,
+// restoring their report runs, so that only the remaining units run. Without a checkpoint, all units run.
+func (rc *runCheckpoint) resume(l log.Logger, q *queue.Queue, r *report.Report) error {
+	previous, err := checkpoint.Load(rc.fs, rc.path)
+	if errors.As(err, new(checkpoint.NotFoundError)) {
+		l.Infof("No checkpoint found at %s, running all units", rc.path)
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := previous.Validate(rc.command, rc.args, rc.fingerprints); err != nil {
+		return err
+	}
+
+	succeeded := previous.Succeeded()
+
+	for _, path := range succeeded {
+		entry := q.EntryByPath(path)
+		if entry == nil {
+			continue
+		}
+
+		q.SetEntryStatus(entry, queue.StatusSucceeded)
+
+		if r == nil {
+			continue
+		}
+
+		if run := previous.Run(path); run != nil {
+			if err := r.AddRun(l, run); err != nil && !errors.Is(err, report.ErrRunAlreadyExists) {
+				return err
+			}
+		}
+	}
+
+	l.Infof("Resuming run from checkpoint %s, skipping %d of %d units that already succeeded", rc.path, len(succeeded), len(q.Entries))
+
+	return nil
+}
+
+// update writes the checkpoint of the run in progress, each time a unit is done, so that a run that is killed
+// before it completes can be resumed too.
+func (rc *runCheckpoint) update(l log.Logger, q *queue.Queue, r *report.Report) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if err := checkpoint.New(rc.command, rc.args, q, r, rc.fingerprints).Save(rc.fs, rc.path); err != nil {
+		l.Warnf("Failed to save checkpoint %s: %v", rc.path, err)
+	}
+}
+
+// save writes the checkpoint of the completed run, or removes it once all units succeeded.
+func (rc *runCheckpoint) save(l log.Logger, q *queue.Queue, r *report.Report) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	current := checkpoint.New(rc.command, rc.args, q, r, rc.fingerprints)
+
+	if current.Completed() {
+		if err := checkpoint.Remove(rc.fs, rc.path); err != nil {
+			l.Warnf("Failed to remove checkpoint %s: %v", rc.path, err)
+		}
+
+		return
+	}
+
+	if err := current.Save(rc.fs, rc.path); err != nil {
+		l.Warnf("Failed to save checkpoint %s: %v", rc.path, err)
+
+		return
+	}
+
+	l.Infof("Saved checkpoint to %s, rerun with --resume to continue from where the run stopped", rc.path)
+}
@@ -31,6 +31,9 @@ type Controller struct {
 	runner      UnitRunner
 	readyCh     chan struct{}
 	unitsMap    map[string]*component.Unit
+	results     *xsync.Map[string, error]
+	cancels     *xsync.Map[string, context.CancelCauseFunc]
+	onEntryDone func(*queue.Entry)
 	concurrency int
 }
 
@@ -55,11 +58,21 @@ func WithMaxConcurrency(concurrency int) ControllerOption {
 	}
 }
 
+// WithOnEntryDone sets a function called each time an entry is done running, once its status and the statuses of
+// the entries that exit early because of it are set.
+func WithOnEntryDone(onEntryDone func(*queue.Entry)) ControllerOption {
+	return func(dr *Controller) {
+		dr.onEntryDone = onEntryDone
+	}
+}
+
 // NewController creates a new Controller with the given options and a pre-built queue.
 func NewController(q *queue.Queue, units []*component.Unit, opts ...ControllerOption) *Controller {
 	dr := &Controller{
 		q:           q,
 		readyCh:     make(chan struct{}, 1), // buffered to avoid blocking
+		results:     xsync.NewMap[string, error](),
+		cancels:     xsync.NewMap[string, context.CancelCauseFunc](),
 		concurrency: options.DefaultParallelism,
 	}
 	// Map to link runner Units and Queue Entries
@@ -92,11 +105,7 @@ func (dr *Controller) Run(ctx context.Context, l log.Logger) error {
 		"fail_fast":               dr.q.FailFast,
 		"ignore_dependency_order": dr.q.IgnoreDependencyOrder,
 	}, func(childCtx context.Context) error {
-		var (
-			wg      sync.WaitGroup
-			sem     = make(chan struct{}, dr.concurrency)
-			results = xsync.NewMap[string, error]()
-		)
+		var wg sync.WaitGroup
 
 		if dr.runner == nil {
 			return errors.New("runner Pool Controller: runner is not set, cannot run")
@@ -115,21 +124,24 @@ func (dr *Controller) Run(ctx context.Context, l log.Logger) error {
 			readyEntries := dr.q.GetReadyWithDependencies(l)
 			l.Debugf("Runner Pool Controller: found %d readyEntries tasks", len(readyEntries))
 
-			for _, e := range readyEntries {
-				if !dr.q.ClaimForRunning(e) {
-					l.Debugf("Runner Pool Controller: skipping %s; fail-fast cancelled before dispatch", e.Component.Path())
-					continue
-				}
+			// Entries that don't fit within the concurrency limits stay ready,
+			// and are claimed on a later pass once running entries complete.
+			claimedEntries := dr.q.ClaimReadyWithinLimits(readyEntries, dr.concurrency)
+			if deferred := len(readyEntries) - len(claimedEntries); deferred > 0 {
+				l.Debugf("Runner Pool Controller: deferring %d tasks; concurrency limits reached or fail-fast cancelled before dispatch", deferred)
+			}
 
+			for _, e := range claimedEntries {
 				l.Debugf("Runner Pool Controller: running %s", e.Component.Path())
 
-				sem <- struct{}{}
-
 				wg.Add(1)
 
 				go func(ent *queue.Entry) {
 					defer func() {
-						<-sem
+						if dr.onEntryDone != nil {
+							dr.onEntryDone(ent)
+						}
+
 						wg.Done()
 
 						select {
@@ -143,13 +155,25 @@ func (dr *Controller) Run(ctx context.Context, l log.Logger) error {
 						err := fmt.Errorf("unit for path %s not found in discovered units", ent.Component.Path())
 						l.Errorf("Runner Pool Controller: unit for path %s not found in discovered units, skipping execution", ent.Component.Path())
 						dr.q.FailEntry(ent)
-						results.Store(ent.Component.Path(), err)
+						dr.results.Store(ent.Component.Path(), err)
 
 						return
 					}
 
-					err := dr.runner(childCtx, unit)
-					results.Store(ent.Component.Path(), err)
+					unitCtx, cancel := context.WithCancelCause(childCtx)
+					dr.cancels.Store(ent.Component.Path(), cancel)
+
+					err := dr.runner(unitCtx, unit)
+
+					dr.cancels.Delete(ent.Component.Path())
+
+					// Report units cancelled with Cancel as such, rather than with the error of the interrupted run.
+					if cause := context.Cause(unitCtx); err != nil && errors.As(cause, new(UnitCancelledError)) {
+						err = cause
+					}
+
+					cancel(nil)
+					dr.results.Store(ent.Component.Path(), err)
 
 					if err != nil {
 						l.Debugf("Runner Pool Controller: %s failed", ent.Component.Path())
@@ -193,7 +217,7 @@ func (dr *Controller) Run(ctx context.Context, l log.Logger) error {
 				// Non-terminal states are not counted in the summary.
 			}
 
-			if err, ok := results.Load(entry.Component.Path()); ok {
+			if err, ok := dr.results.Load(entry.Component.Path()); ok {
 				if err == nil {
 					continue
 				}
@@ -224,3 +248,35 @@ func (dr *Controller) Run(ctx context.Context, l log.Logger) error {
 		return multierror.Join(errCollector...)
 	})
 }
+
+// Cancel cancels the run of the unit at the given path, if it is running. The unit fails with a UnitCancelledError,
+// and its failure propagates like any other. Returns false if the unit isn't running.
+func (dr *Controller) Cancel(path string) bool {
+	cancel, ok := dr.cancels.Load(path)
+	if !ok {
+		return false
+	}
+
+	cancel(NewUnitCancelledError(path))
+
+	return true
+}
+
+// Skip prevents the unit at the given path from running, if it hasn't started yet. The unit exits early with a
+// UnitSkippedError, and so do the units that depend on it. Returns false if the unit is running or already done.
+func (dr *Controller) Skip(path string) bool {
+	entry := dr.q.EntryByPath(path)
+	if entry == nil || !dr.q.SkipEntry(entry) {
+		return false
+	}
+
+	dr.results.Store(path, NewUnitSkippedError(path))
+
+	// Wake up the scheduler, so that it notices if the queue is finished.
+	select {
+	case dr.readyCh <- struct{}{}:
+	default:
+	}
+
+	return true
+}
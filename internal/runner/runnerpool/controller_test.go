@@ -2,7 +2,10 @@ package runnerpool_test
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -287,3 +290,197 @@ func TestRunnerPool_ComplexDependency_BFails_FailFast(t *testing.T) {
 		assert.Contains(t, err.Error(), want, "Expected error message '%s' in errors", want)
 	}
 }
+
+func TestRunnerPool_WeightsAndConcurrencyGroups(t *testing.T) {
+	t.Parallel()
+
+	units := buildComponentUnits([]string{"eks-a", "eks-b", "eks-c", "vpc-a", "vpc-b", "vpc-c"}, nil)
+
+	components := make(component.Components, len(units))
+	for i, u := range units {
+		components[i] = u
+	}
+
+	q, err := queue.NewQueue(components)
+	require.NoError(t, err)
+
+	q.ConcurrencyGroupLimits = map[string]int{"aws-eks": 1}
+
+	for _, e := range q.Entries {
+		if strings.HasPrefix(e.Component.Path(), "eks") {
+			e.Weight = 2
+			e.ConcurrencyGroups = []string{"aws-eks"}
+		}
+	}
+
+	var (
+		mu                    sync.Mutex
+		slots, eks            int
+		maxSlots, maxParallel int
+	)
+
+	runner := func(ctx context.Context, u *component.Unit) error {
+		weight := 1
+		if strings.HasPrefix(u.Path(), "eks") {
+			weight = 2
+		}
+
+		mu.Lock()
+		slots += weight
+
+		if weight == 2 {
+			eks++
+			maxParallel = max(maxParallel, eks)
+		}
+
+		maxSlots = max(maxSlots, slots)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		slots -= weight
+
+		if weight == 2 {
+			eks--
+		}
+		mu.Unlock()
+
+		return nil
+	}
+
+	dagRunner := runnerpool.NewController(
+		q,
+		units,
+		runnerpool.WithRunner(runner),
+		runnerpool.WithMaxConcurrency(3),
+	)
+	require.NoError(t, dagRunner.Run(t.Context(), logger.CreateLogger()))
+
+	assert.LessOrEqual(t, maxSlots, 3, "running units must not occupy more than the max concurrency")
+	assert.Equal(t, 1, maxParallel, "at most one aws-eks unit must run at once")
+
+	for _, e := range q.Entries {
+		assert.Equal(t, queue.StatusSucceeded, e.Status)
+	}
+}
+
+func TestRunnerPool_CancelAndSkip(t *testing.T) {
+	t.Parallel()
+
+	// A -> B, C
+	units := buildComponentUnits(
+		[]string{"A", "B", "C"},
+		map[string][]string{
+			"B": {"A"},
+		},
+	)
+
+	components := make(component.Components, len(units))
+	for i, u := range units {
+		components[i] = u
+	}
+
+	started := make(chan struct{})
+
+	runner := func(ctx context.Context, u *component.Unit) error {
+		if u.Path() != "A" {
+			return nil
+		}
+
+		close(started)
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+
+	q, err := queue.NewQueue(components)
+	require.NoError(t, err)
+
+	dagRunner := runnerpool.NewController(
+		q,
+		units,
+		runnerpool.WithRunner(runner),
+		runnerpool.WithMaxConcurrency(2),
+	)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- dagRunner.Run(t.Context(), logger.CreateLogger())
+	}()
+
+	<-started
+
+	assert.False(t, dagRunner.Skip("A"), "a running unit can't be skipped")
+	assert.True(t, dagRunner.Skip("B"))
+	assert.False(t, dagRunner.Cancel("B"), "a unit that isn't running can't be cancelled")
+	assert.True(t, dagRunner.Cancel("A"))
+
+	err = <-errCh
+	require.ErrorAs(t, err, new(runnerpool.UnitCancelledError))
+	require.ErrorAs(t, err, new(runnerpool.UnitSkippedError))
+
+	assert.Equal(t, queue.StatusFailed, q.EntryByPath("A").Status)
+	assert.Equal(t, queue.StatusEarlyExit, q.EntryByPath("B").Status)
+	assert.Equal(t, queue.StatusSucceeded, q.EntryByPath("C").Status)
+}
+
+func TestRunnerPool_OnEntryDone(t *testing.T) {
+	t.Parallel()
+
+	// A -> B, C
+	units := buildComponentUnits(
+		[]string{"A", "B", "C"},
+		map[string][]string{
+			"B": {"A"},
+		},
+	)
+
+	components := make(component.Components, len(units))
+	for i, u := range units {
+		components[i] = u
+	}
+
+	runner := func(ctx context.Context, u *component.Unit) error {
+		if u.Path() == "A" {
+			return errors.New("unit A failed")
+		}
+
+		return nil
+	}
+
+	q, err := queue.NewQueue(components)
+	require.NoError(t, err)
+
+	var (
+		mu       sync.Mutex
+		statuses = make(map[string]map[string]queue.Status)
+	)
+
+	dagRunner := runnerpool.NewController(
+		q,
+		units,
+		runnerpool.WithRunner(runner),
+		runnerpool.WithMaxConcurrency(2),
+		runnerpool.WithOnEntryDone(func(entry *queue.Entry) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			snapshot := make(map[string]queue.Status, len(q.Entries))
+			for _, e := range q.Entries {
+				snapshot[e.Component.Path()] = q.EntryStatus(e)
+			}
+
+			statuses[entry.Component.Path()] = snapshot
+		}),
+	)
+
+	require.Error(t, dagRunner.Run(t.Context(), logger.CreateLogger()))
+
+	// Only the units that ran are done, once their status and those of the units that exit early are set.
+	require.Len(t, statuses, 2)
+	assert.Equal(t, queue.StatusFailed, statuses["A"]["A"])
+	assert.Equal(t, queue.StatusEarlyExit, statuses["A"]["B"])
+	assert.Equal(t, queue.StatusSucceeded, statuses["C"]["C"])
+}
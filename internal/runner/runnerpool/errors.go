@@ -43,6 +43,34 @@ func NewUnitFailedError(unitPath string) error {
 	return UnitFailedError{UnitPath: unitPath}
 }
 
+// UnitCancelledError is an error type for units whose run was cancelled by the user.
+type UnitCancelledError struct {
+	UnitPath string
+}
+
+func (e UnitCancelledError) Error() string {
+	return fmt.Sprintf("Unit '%s' was cancelled", e.UnitPath)
+}
+
+// NewUnitCancelledError creates a new UnitCancelledError.
+func NewUnitCancelledError(unitPath string) error {
+	return UnitCancelledError{UnitPath: unitPath}
+}
+
+// UnitSkippedError is an error type for units that were skipped by the user before they ran.
+type UnitSkippedError struct {
+	UnitPath string
+}
+
+func (e UnitSkippedError) Error() string {
+	return fmt.Sprintf("Unit '%s' was skipped", e.UnitPath)
+}
+
+// NewUnitSkippedError creates a new UnitSkippedError.
+func NewUnitSkippedError(unitPath string) error {
+	return UnitSkippedError{UnitPath: unitPath}
+}
+
 // findFailedDependency finds the first failed dependency for a given entry.
 func findFailedDependency(entry *queue.Entry, q *queue.Queue) string {
 	for _, dep := range entry.Component.Dependencies() {
@@ -25,6 +25,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/queue"
 	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/internal/runner/common"
+	"github.com/gruntwork-io/terragrunt/internal/runner/dashboard"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds"
 	"github.com/gruntwork-io/terragrunt/internal/telemetry"
@@ -289,6 +290,10 @@ func NewRunnerPoolStack(
 		return nil, queueErr
 	}
 
+	if err := applyConcurrencyLimits(ctx, l, opts, q, units); err != nil {
+		return nil, err
+	}
+
 	rnr.queue = q
 
 	return rnr, nil
@@ -430,16 +435,42 @@ func (rnr *Runner) Run(ctx context.Context, l log.Logger, v run.Venv, stackOpts
 
 	withDependents := UnitsWithDependents(rnr.queue)
 
+	// With the dashboard, the output of the run is captured for the dashboard to display, instead of being written
+	// to the terminal the dashboard occupies.
+	var tracker *dashboard.Tracker
+
+	runLogger := l
+
+	if stackOpts.Dashboard {
+		if dashboard.Available() {
+			tracker = dashboard.NewTracker()
+			runLogger = l.WithOptions(log.WithOutput(tracker.RunLog()))
+		} else {
+			l.Warnf("The run dashboard requires an interactive terminal, continuing without it.")
+		}
+	}
+
 	task := func(ctx context.Context, u *component.Unit) error {
 		// Build per-unit opts and logger on demand
-		unitOpts, unitLogger, err := BuildUnitOpts(l, stackOpts, u)
+		unitOpts, unitLogger, err := BuildUnitOpts(runLogger, stackOpts, u)
 		if err != nil {
 			return fmt.Errorf("failed to build opts for unit %s: %w", u.Path(), err)
 		}
 
+		if tracker != nil {
+			unitLog := tracker.Unit(u.Path())
+			unitLog.Start()
+
+			defer unitLog.Finish()
+
+			unitOpts.Writers.Writer = unitLog
+			unitOpts.Writers.ErrWriter = unitLog
+			unitLogger = unitLogger.WithOptions(log.WithOutput(unitLog))
+		}
+
 		// Sync CLI args from stackOpts into unit opts
 		if needsCliSync {
-			syncUnitCliArgs(l, stackOpts, unitOpts, u)
+			syncUnitCliArgs(runLogger, stackOpts, unitOpts, u)
 		}
 
 		// Wrap ErrWriter with plan error buffer for plan commands
@@ -460,7 +491,7 @@ func (rnr *Runner) Run(ctx context.Context, l log.Logger, v run.Venv, stackOpts
 			"working_dir":            unitOpts.WorkingDir,
 			"terragrunt_config_path": unitOpts.TerragruntConfigPath,
 		}, func(childCtx context.Context) error {
-			l.Debugf("Runner Pool Task: starting unit=%s command=%s", unitPath, unitOpts.TerraformCommand)
+			runLogger.Debugf("Runner Pool Task: starting unit=%s command=%s", unitPath, unitOpts.TerraformCommand)
 
 			// Wrap the writer to buffer unit-scoped output
 			unitWriter := NewUnitWriter(unitOpts.Writers.Writer)
@@ -476,7 +507,7 @@ func (rnr *Runner) Run(ctx context.Context, l log.Logger, v run.Venv, stackOpts
 			// only when an auth provider is configured, so no conditional is needed here.
 			credsGetter, err := creds.ObtainCredsForParsing(childCtx, unitLogger, v.Exec, unitOpts.AuthProviderCmd, unitOpts.Env, configbridge.ShellRunOptsFromOpts(unitOpts))
 			if err != nil {
-				logTaskOutcome(childCtx, l, unitPath, unitOpts.TerraformCommand, err)
+				logTaskOutcome(childCtx, runLogger, unitPath, unitOpts.TerraformCommand, err)
 
 				return err
 			}
@@ -503,7 +534,8 @@ func (rnr *Runner) Run(ctx context.Context, l log.Logger, v run.Venv, stackOpts
 				return readErr
 			})
 			if err != nil {
-				logTaskOutcome(childCtx, l, unitPath, unitOpts.TerraformCommand, err)
+				logTaskOutcome(childCtx, runLogger, unitPath, unitOpts.TerraformCommand, err)
+				reportConfigError(runLogger, r, u, err)
 
 				return err
 			}
@@ -546,7 +578,7 @@ func (rnr *Runner) Run(ctx context.Context, l log.Logger, v run.Venv, stackOpts
 				err = flushErr
 			}
 
-			logTaskOutcome(childCtx, l, unitPath, unitOpts.TerraformCommand, err)
+			logTaskOutcome(childCtx, runLogger, unitPath, unitOpts.TerraformCommand, err)
 
 			return err
 		})
@@ -556,14 +588,41 @@ func (rnr *Runner) Run(ctx context.Context, l log.Logger, v run.Venv, stackOpts
 	rnr.queue.IgnoreDependencyOrder = stackOpts.IgnoreDependencyOrder
 	// Allow continuing the queue when dependencies fail if requested via CLI
 	rnr.queue.IgnoreDependencyErrors = stackOpts.IgnoreDependencyErrors
-	controller := NewController(
-		rnr.queue,
-		rnr.Stack.Units,
+
+	// Checkpoint the run, so that it can be resumed with --resume if it stops part way through.
+	rc, checkpointErr := rnr.newRunCheckpoint(v.FS, stackOpts)
+	if checkpointErr != nil {
+		return checkpointErr
+	}
+
+	if rc != nil && stackOpts.Resume {
+		if resumeErr := rc.resume(l, rnr.queue, r); resumeErr != nil {
+			return resumeErr
+		}
+	}
+
+	controllerOpts := []ControllerOption{
 		WithRunner(task),
 		WithMaxConcurrency(stackOpts.Parallelism),
-	)
+	}
 
-	err := controller.Run(ctx, l)
+	if rc != nil {
+		controllerOpts = append(controllerOpts, WithOnEntryDone(func(*queue.Entry) {
+			rc.update(l, rnr.queue, r)
+		}))
+	}
+
+	controller := NewController(rnr.queue, rnr.Stack.Units, controllerOpts...)
+
+	var err error
+
+	if tracker != nil {
+		err = dashboard.Run(ctx, l, rnr.queue, tracker, controller, stackOpts.Writers.ErrWriter, func(ctx context.Context) error {
+			return controller.Run(ctx, runLogger)
+		})
+	} else {
+		err = controller.Run(ctx, l)
+	}
 
 	// Emit report entries for early exit and failed units after controller completes
 	if r != nil {
@@ -657,6 +716,14 @@ func (rnr *Runner) Run(ctx context.Context, l log.Logger, v run.Venv, stackOpts
 		}
 	}
 
+	if isPlan && common.PlanJSONOutputFolder(stackOpts) != "" && v.FS != nil {
+		rnr.aggregatePlanChanges(l, v.FS, stackOpts, r)
+	}
+
+	if rc != nil {
+		rc.save(l, rnr.queue, r)
+	}
+
 	return err
 }
 
@@ -1021,6 +1088,58 @@ func applyFilterAllowDestroyExclusions(l log.Logger, opts *options.TerragruntOpt
 	}
 }
 
+// applyConcurrencyLimits sets the weights and concurrency groups of queue entries from the `concurrency` blocks
+// of their units, and the group limits from the `concurrency_group` blocks of the root configuration.
+func applyConcurrencyLimits(
+	ctx context.Context,
+	l log.Logger,
+	opts *options.TerragruntOptions,
+	q *queue.Queue,
+	units []*component.Unit,
+) error {
+	unitsByPath := make(map[string]*component.Unit, len(units))
+	for _, unit := range units {
+		unitsByPath[unit.Path()] = unit
+	}
+
+	var groups []string
+
+	for _, entry := range q.Entries {
+		unit := unitsByPath[entry.Component.Path()]
+		if unit == nil || unit.Config() == nil || unit.Config().Concurrency == nil {
+			continue
+		}
+
+		entry.Weight = unit.Config().Concurrency.GetWeight()
+		entry.ConcurrencyGroups = unit.Config().Concurrency.GetGroups()
+		groups = append(groups, entry.ConcurrencyGroups...)
+	}
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	ctx, pctx := configbridge.NewParsingContext(ctx, l, opts)
+	if pctx.ScaffoldRootFileName == "" {
+		pctx.ScaffoldRootFileName = config.RecommendedParentConfigName
+	}
+
+	groupConfigs, err := config.ReadConcurrencyGroupConfigs(ctx, l, pctx)
+	if err != nil {
+		return err
+	}
+
+	q.ConcurrencyGroupLimits = groupConfigs.Limits()
+
+	for _, group := range util.RemoveDuplicates(groups) {
+		if _, ok := q.ConcurrencyGroupLimits[group]; !ok {
+			l.Warnf("Concurrency group %s is not declared with a concurrency_group block in the root configuration, its units are not limited", group)
+		}
+	}
+
+	return nil
+}
+
 // collectDependencies collects dependency paths for a unit with a bounded recursion depth.
 func collectDependencies(unit *component.Unit, paths map[string]bool) {
 	collectDependenciesBounded(unit, paths, 0)
@@ -1045,6 +1164,44 @@ func collectDependenciesBounded(unit *component.Unit, paths map[string]bool, dep
 	}
 }
 
+// reportConfigError ends the report run of a unit whose configuration couldn't be read, so that the report records
+// the error and the locations of the HCL diagnostics it carries.
+func reportConfigError(l log.Logger, r *report.Report, u *component.Unit, err error) {
+	if r == nil {
+		return
+	}
+
+	unitPath := filepath.Clean(u.Path())
+
+	var ensureOpts []report.EndOption
+
+	if discoveryCtx := u.DiscoveryContext(); discoveryCtx != nil {
+		ensureOpts = append(
+			ensureOpts,
+			report.WithDiscoveryWorkingDir(discoveryCtx.WorkingDir),
+			report.WithRef(discoveryCtx.Ref),
+			report.WithCmd(discoveryCtx.Cmd),
+			report.WithArgs(discoveryCtx.Args),
+		)
+	}
+
+	if _, ensureErr := r.EnsureRun(l, unitPath, ensureOpts...); ensureErr != nil {
+		l.Errorf("Error ensuring run for unit %s: %v", unitPath, ensureErr)
+		return
+	}
+
+	if endErr := r.EndRun(
+		l,
+		unitPath,
+		report.WithResult(report.ResultFailed),
+		report.WithReason(report.ReasonRunError),
+		report.WithCauseRunError(err.Error()),
+		report.WithDiagnostics(common.ReportDiagnostics(err)...),
+	); endErr != nil {
+		l.Errorf("Error ending run for unit %s: %v", unitPath, endErr)
+	}
+}
+
 // logTaskOutcome stamps the task outcome on the active span and emits a debug log,
 // so the runner_pool_task span carries succeeded/failed status without callers
 // needing to call SpanFromContext directly.
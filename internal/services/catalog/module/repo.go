@@ -53,6 +53,7 @@ type Repo struct {
 	BranchName string
 	LatestTag  string
 
+	casRemote     cas.RemoteOptions
 	casCloneDepth int
 
 	walkWithSymlinks bool
@@ -66,6 +67,7 @@ type RepoOpts struct {
 	CloneURL         string
 	Path             string
 	RootWorkingDir   string
+	CASRemote        cas.RemoteOptions
 	CASCloneDepth    int
 	WalkWithSymlinks bool
 	AllowCAS         bool
@@ -90,6 +92,7 @@ func NewRepo(ctx context.Context, l log.Logger, fsys vfs.FS, opts *RepoOpts) (*R
 		walkWithSymlinks: opts.WalkWithSymlinks,
 		allowCAS:         opts.AllowCAS,
 		casCloneDepth:    opts.CASCloneDepth,
+		casRemote:        opts.CASRemote,
 		slowReporting:    opts.SlowReporting,
 		rootWorkingDir:   opts.RootWorkingDir,
 	}
@@ -373,7 +376,7 @@ func (repo *Repo) performClone(ctx context.Context, l log.Logger, fsys vfs.FS, o
 			return err
 		}
 
-		c, err := cas.New(cas.WithCloneDepth(cloneDepth))
+		c, err := cas.New(cas.WithCloneDepth(cloneDepth), cas.WithRemote(repo.casRemote))
 		if err != nil {
 			return err
 		}
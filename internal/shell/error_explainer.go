@@ -8,34 +8,136 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/gruntwork-io/terragrunt/internal/errorconfig"
 	"github.com/gruntwork-io/terragrunt/internal/util"
 )
 
-// terraformErrorsMatcher List of errors that we know how to explain to the user. The key is a regex that matches the error message, and the value is the explanation.
-var terraformErrorsMatcher = map[string]string{
-	"(?s).*Error refreshing state: AccessDenied: Access Denied(?s).*":                     "You don't have access to the S3 bucket where the state is stored. Check your credentials and permissions.",
-	"(?s).*AllAccessDisabled: All access to this object has been disabled(?s).*":          "You don't have access to the S3 bucket where the state is stored. Check your credentials and permissions.",
-	"(?s).*operation error S3: ListObjectsV2, https response error StatusCode: 301(?s).*": "You don't have access to the S3 bucket where the state is stored. Check your credentials and permissions.",
-	"(?s).*The authorization header is malformed(?s).*":                                   "You don't have access to the S3 bucket where the state is stored. Check your credentials and permissions.",
-	"(?s).*Unable to list objects in S3 bucket(?s).*":                                     "You don't have access to the S3 bucket where the state is stored. Check your credentials and permissions.",
-	"(?s).*Error: Initialization required(?s).*":                                          "You need to run terragrunt (run --all) init to initialize working directory.",
-	"(?s).*Unit source has changed(?s).*":                                                 "You need to run terragrunt (run --all) init install all required modules.",
-	"(?s).*Error finding AWS credentials(?s).*":                                           "Missing AWS credentials. Provide credentials to proceed.",
-	"(?s).*Error: No valid credential sources found(?s).*":                                "Missing AWS credentials. Provide credentials to proceed.",
-	"(?s).*Error: validating provider credentials(?s).*":                                  "Missing AWS credentials. Provide credentials to proceed.",
-	"(?s).*NoCredentialProviders(?s).*":                                                   "Missing AWS credentials. Provide credentials to proceed.",
-	"(?s).*client: no valid credential sources(?s).*":                                     "Missing AWS credentials. Provide credentials to proceed.",
-	"(?s).*exec: \"(tofu|terraform)\": executable file not found(?s).*":                   "The executables 'terraform' and 'tofu' are missing from your $PATH. Please add at least one of these to your $PATH.",
-	"(?s).*bucket must have been previously created.*":                                    "Remote state bucket not found, create it manually or rerun with --backend-bootstrap to provision automatically.",
-	"(?s).*specified bucket does not exist.*":                                             "Remote state bucket not found, create it manually or rerun with --backend-bootstrap to provision automatically.",
-	"(?s).*S3 bucket does not exist.*":                                                    "Remote state bucket not found, create it manually or rerun with --backend-bootstrap to provision automatically.",
+const (
+	s3AccessExplanation       = "You don't have access to the S3 bucket where the state is stored. Check your credentials and permissions."
+	awsCredentialsExplanation = "Missing AWS credentials. Provide credentials to proceed."
+	bucketNotFoundExplanation = "Remote state bucket not found, create it manually or rerun with --backend-bootstrap to provision automatically."
+)
+
+// errorExplanation explains an error matching the pattern, optionally suggesting a command that fixes it.
+type errorExplanation struct {
+	pattern *regexp.Regexp
+	message string
+	command string
+}
+
+// terraformErrorsMatcher List of errors that we know how to explain to the user.
+var terraformErrorsMatcher = []errorExplanation{
+	// S3 backend
+	{pattern: regexp.MustCompile(`Error refreshing state: AccessDenied: Access Denied`), message: s3AccessExplanation},
+	{pattern: regexp.MustCompile(`AllAccessDisabled: All access to this object has been disabled`), message: s3AccessExplanation},
+	{pattern: regexp.MustCompile(`operation error S3: ListObjectsV2, https response error StatusCode: 301`), message: s3AccessExplanation},
+	{pattern: regexp.MustCompile(`The authorization header is malformed`), message: s3AccessExplanation},
+	{pattern: regexp.MustCompile(`Unable to list objects in S3 bucket`), message: s3AccessExplanation},
+	{pattern: regexp.MustCompile(`bucket must have been previously created`), message: bucketNotFoundExplanation},
+	{pattern: regexp.MustCompile(`specified bucket does not exist`), message: bucketNotFoundExplanation},
+	{pattern: regexp.MustCompile(`S3 bucket does not exist`), message: bucketNotFoundExplanation},
+
+	// AWS credentials
+	{pattern: regexp.MustCompile(`Error finding AWS credentials`), message: awsCredentialsExplanation},
+	{pattern: regexp.MustCompile(`Error: No valid credential sources found`), message: awsCredentialsExplanation},
+	{pattern: regexp.MustCompile(`Error: validating provider credentials`), message: awsCredentialsExplanation},
+	{pattern: regexp.MustCompile(`NoCredentialProviders`), message: awsCredentialsExplanation},
+	{pattern: regexp.MustCompile(`client: no valid credential sources`), message: awsCredentialsExplanation},
+
+	// GCS backend
+	{
+		pattern: regexp.MustCompile(`(?s)googleapi: Error 403.*storage\.(objects|buckets)\.|does not have storage\.(objects|buckets)\.\w+ access`),
+		message: "You don't have access to the GCS bucket where the state is stored. Check your credentials and the IAM permissions of the bucket.",
+	},
+	{
+		pattern: regexp.MustCompile(`google: could not find default credentials`),
+		message: "Missing GCP credentials. Provide credentials to proceed.",
+		command: "gcloud auth application-default login",
+	},
+	{pattern: regexp.MustCompile(`storage: bucket doesn't exist`), message: bucketNotFoundExplanation},
+
+	// azurerm backend
+	{
+		pattern: regexp.MustCompile(`AuthorizationPermissionMismatch|AuthorizationFailed`),
+		message: "You don't have access to the Azure storage account where the state is stored. Check the role assignments of your identity.",
+	},
+	{
+		pattern: regexp.MustCompile(`Please run 'az login'`),
+		message: "Missing Azure credentials. Provide credentials to proceed.",
+		command: "az login",
+	},
+	{
+		pattern: regexp.MustCompile(`ContainerNotFound`),
+		message: "The Azure storage container where the state is stored was not found, create it manually or rerun with --backend-bootstrap to provision automatically.",
+	},
+
+	// State lock
+	{
+		pattern: regexp.MustCompile(`Error acquiring the state lock`),
+		message: "The state is locked by another operation. Wait for it to finish, or release the lock if that operation was interrupted.",
+		command: "terragrunt run -- force-unlock <LOCK_ID>",
+	},
+
+	// Dependency lock file
+	{
+		pattern: regexp.MustCompile(`doesn't match any of the checksums previously recorded in the dependency lock file`),
+		message: "The provider checksums in .terraform.lock.hcl don't include the current platform. Record the checksums of all the platforms you run on.",
+		command: "terragrunt run -- providers lock -platform=linux_amd64 -platform=darwin_arm64",
+	},
+	{
+		pattern: regexp.MustCompile(`Inconsistent dependency lock file`),
+		message: "The dependency lock file doesn't match the required providers, update it to proceed.",
+		command: "terragrunt run -- init -upgrade",
+	},
+
+	// Registry
+	{
+		pattern: regexp.MustCompile(`Failed to query available provider packages`),
+		message: "Could not query the provider registry. Check the provider source address, version constraints and network access to the registry.",
+	},
+	{
+		pattern: regexp.MustCompile(`Failed to retrieve available versions for module|Module not found`),
+		message: "Could not find the module in the registry. Check the module source address and your access to the registry.",
+	},
+	{
+		pattern: regexp.MustCompile(`Invalid provider registry host`),
+		message: "The provider registry host does not provide a provider registry. Check the hostname of the provider source address.",
+	},
+
+	// Provider download
+	{
+		pattern: regexp.MustCompile(`Failed to install provider`),
+		message: "Could not download the provider. Check your network access, or rerun with --provider-cache to reuse already downloaded providers.",
+	},
+	{
+		pattern: regexp.MustCompile(`Required plugins are not installed`),
+		message: "Required providers are not installed, initialize the working directory to install them.",
+		command: "terragrunt run -- init",
+	},
+
+	// Executables
+	{
+		pattern: regexp.MustCompile(`exec: "(tofu|terraform)": executable file not found`),
+		message: "The executables 'terraform' and 'tofu' are missing from your $PATH. Please add at least one of these to your $PATH.",
+	},
+
+	// Initialization
+	{pattern: regexp.MustCompile(`Error: Initialization required`), message: "You need to run terragrunt (run --all) init to initialize working directory."},
+	{pattern: regexp.MustCompile(`Unit source has changed`), message: "You need to run terragrunt (run --all) init install all required modules."},
 }
 
 // ExplainError will try to explain the error to the user, if we know how to do so.
+// Explanations configured with `explain` blocks are included along with the built-in ones.
 func ExplainError(err error) string {
 	explanations := map[string]string{}
 
 	for _, err := range flattenErrorChain(err) {
+		if explainedErr, ok := err.(*errorconfig.ExplainedError); ok {
+			for _, explanation := range explainedErr.Explanations {
+				explanations[formatExplanation(explanation.Message, explanation.Command)] = "1"
+			}
+		}
+
 		message := err.Error()
 
 		// extract process output, if it is the case
@@ -46,9 +148,9 @@ func ExplainError(err error) string {
 			message = fmt.Sprintf("%s\n%s", stdOut, errorOutput)
 		}
 
-		for regex, explanation := range terraformErrorsMatcher {
-			if match, _ := regexp.MatchString(regex, message); match {
-				explanations[explanation] = "1"
+		for _, explanation := range terraformErrorsMatcher {
+			if explanation.pattern.MatchString(message) {
+				explanations[formatExplanation(explanation.message, explanation.command)] = "1"
 			}
 		}
 	}
@@ -56,6 +158,15 @@ func ExplainError(err error) string {
 	return strings.Join(slices.Sorted(maps.Keys(explanations)), "\n")
 }
 
+// formatExplanation appends the suggested command, if any, to the explanation message.
+func formatExplanation(message, command string) string {
+	if command == "" {
+		return message
+	}
+
+	return fmt.Sprintf("%s Try running: %s", message, command)
+}
+
 // flattenErrorChain walks both single-error (Unwrap() error) and joined-error
 // (Unwrap() []error) chains, returning every error encountered including the root.
 func flattenErrorChain(err error) []error {
@@ -2,10 +2,12 @@ package shell_test
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
 	"errors"
 
+	"github.com/gruntwork-io/terragrunt/internal/errorconfig"
 	"github.com/gruntwork-io/terragrunt/internal/shell"
 	"github.com/gruntwork-io/terragrunt/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +40,38 @@ func TestExplainError(t *testing.T) {
 			errorOutput: "exec: \"tofu\": executable file not found in $PATH",
 			explanation: "The executables 'terraform' and 'tofu' are missing from your $PATH. Please add at least one of these to your $PATH.",
 		},
+		{
+			errorOutput: "Error: Failed to get existing workspaces: querying Cloud Storage failed: googleapi: Error 403: sa@project.iam.gserviceaccount.com does not have storage.objects.list access to the Google Cloud Storage bucket.",
+			explanation: "You don't have access to the GCS bucket where the state is stored.",
+		},
+		{
+			errorOutput: "Error: storage.NewClient() failed: dialing: google: could not find default credentials.",
+			explanation: "Missing GCP credentials. Provide credentials to proceed. Try running: gcloud auth application-default login",
+		},
+		{
+			errorOutput: "Error: Failed to get existing workspaces: containers.Client#ListBlobs: Failure responding to request: StatusCode=404 -- Original Error: autorest/azure: Service returned an error. Status=404 Code=\"ContainerNotFound\"",
+			explanation: "The Azure storage container where the state is stored was not found",
+		},
+		{
+			errorOutput: "Error: building AzureRM Client: please ensure you have installed Azure CLI version 2.0.79 or newer. Please run 'az login' to setup account.",
+			explanation: "Missing Azure credentials. Provide credentials to proceed. Try running: az login",
+		},
+		{
+			errorOutput: "Error: Error acquiring the state lock\n\nError message: ConditionalCheckFailedException: The conditional request failed",
+			explanation: "Try running: terragrunt run -- force-unlock <LOCK_ID>",
+		},
+		{
+			errorOutput: "Error: Failed to install provider\n\nError while installing hashicorp/aws v5.0.0: the current package for registry.terraform.io/hashicorp/aws 5.0.0 doesn't match any of the checksums previously recorded in the dependency lock file",
+			explanation: "Try running: terragrunt run -- providers lock",
+		},
+		{
+			errorOutput: "Error: Failed to query available provider packages\n\nCould not retrieve the list of available versions for provider hashicorp/awz",
+			explanation: "Could not query the provider registry.",
+		},
+		{
+			errorOutput: "Error: Inconsistent dependency lock file",
+			explanation: "Try running: terragrunt run -- init -upgrade",
+		},
 	}
 
 	for _, tt := range testCases {
@@ -56,3 +90,20 @@ func TestExplainError(t *testing.T) {
 		})
 	}
 }
+
+func TestExplainErrorWithExplainRules(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("run failed: %w", &errorconfig.ExplainedError{
+		Err: errors.New("Error: Error acquiring the state lock"),
+		Explanations: []*errorconfig.ExplainConfig{
+			{Name: "vpn", Message: "Connect to the VPN first."},
+			{Name: "lock", Message: "Ask in #infra before unlocking.", Command: "make unlock"},
+		},
+	})
+
+	explanation := shell.ExplainError(err)
+	assert.Contains(t, explanation, "Connect to the VPN first.")
+	assert.Contains(t, explanation, "Ask in #infra before unlocking. Try running: make unlock")
+	assert.Contains(t, explanation, "Try running: terragrunt run -- force-unlock <LOCK_ID>")
+}
@@ -0,0 +1,410 @@
+package generate
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+)
+
+// Change describes how a component, file or value differs between a rendered stack and the generated stack on disk.
+type Change string
+
+const (
+	// ChangeAdded is something generation would create.
+	ChangeAdded Change = "added"
+	// ChangeRemoved is something on disk that generation would no longer produce.
+	ChangeRemoved Change = "removed"
+	// ChangeChanged is something generation would rewrite with different content.
+	ChangeChanged Change = "changed"
+)
+
+// Diff is the difference between the stacks rendered by a dry run and the generated stacks on disk.
+type Diff struct {
+	Components []*ComponentDiff `json:"components"`
+}
+
+// Empty reports whether generation would leave the generated stacks on disk unchanged.
+func (d *Diff) Empty() bool {
+	return len(d.Components) == 0
+}
+
+// Count returns the number of components with the given change.
+func (d *Diff) Count(change Change) int {
+	count := 0
+
+	for _, cmp := range d.Components {
+		if cmp.Change == change {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ComponentDiff is the difference of a single generated unit or stack.
+type ComponentDiff struct {
+	// Path is the directory of the component, relative to the working directory.
+	Path   string         `json:"path"`
+	Kind   component.Kind `json:"kind"`
+	Change Change         `json:"change"`
+	// Files lists the differing files of a changed component.
+	Files []*FileDiff `json:"files,omitempty"`
+	// Values lists the differing values of a changed component.
+	Values []*ValueDiff `json:"values,omitempty"`
+}
+
+// FileDiff is a file that differs within a changed component.
+type FileDiff struct {
+	// Path is the path of the file, relative to the component directory.
+	Path   string `json:"path"`
+	Change Change `json:"change"`
+}
+
+// ValueDiff is a value of the component values file that differs. Before and After hold the HCL expressions of the
+// value, and are empty when the value is added or removed.
+type ValueDiff struct {
+	Name   string `json:"name"`
+	Change Change `json:"change"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// DiffStacks compares the stacks rendered into the preview of a dry run with the stacks generated on disk for the
+// given stack files. Paths in the diff are relative to workingDir, and only the stacks under it are compared.
+func DiffStacks(workingDir string, stackFiles []string, preview *config.StackPreview) (*Diff, error) {
+	rendered := make(map[string]string)
+	onDisk := make(map[string]string)
+	noStackDirs := make(map[string]bool)
+
+	previewDir := preview.Path(workingDir)
+
+	if err := collectFiles(previewDir, previewDir, rendered); err != nil {
+		return nil, err
+	}
+
+	roots := make([]string, 0, len(stackFiles))
+	for _, stackFile := range stackFiles {
+		roots = append(roots, filepath.Join(filepath.Dir(stackFile), config.StackDir))
+	}
+
+	for _, dir := range preview.NoStackDirs() {
+		rel, ok := relPath(workingDir, dir)
+		if !ok {
+			continue
+		}
+
+		noStackDirs[rel] = true
+		roots = append(roots, dir)
+	}
+
+	for _, root := range roots {
+		if _, ok := relPath(workingDir, root); !ok {
+			continue
+		}
+
+		if err := collectFiles(workingDir, root, onDisk); err != nil {
+			return nil, err
+		}
+	}
+
+	return diffFiles(rendered, onDisk, noStackDirs), nil
+}
+
+// collectFiles adds the generated files under root, which may be a single file, to files, keyed by their
+// slash-separated path relative to baseDir. Missing roots, ignorable directories and manifests are skipped.
+func collectFiles(baseDir, root string, files map[string]string) error {
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path == root {
+				return nil
+			}
+
+			return util.SkipDirIfIgnorable(d.Name())
+		}
+
+		if d.Name() == config.ManifestName {
+			return nil
+		}
+
+		rel, err := filepath.Rel(baseDir, path)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = path
+
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// relPath returns the slash-separated path of path relative to dir, and whether path lies within dir.
+func relPath(dir, path string) (string, bool) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+
+	return filepath.ToSlash(rel), true
+}
+
+// componentFiles holds the rendered and on-disk files of a component, keyed by path relative to the component.
+type componentFiles struct {
+	rendered map[string]string
+	onDisk   map[string]string
+	kind     component.Kind
+}
+
+// diffFiles groups the rendered and on-disk files into the components holding them and compares each component.
+// Components are the directories holding a unit or stack configuration, the no_stack components, and otherwise the
+// directories generated directly into a stack directory.
+func diffFiles(rendered, onDisk map[string]string, noStackDirs map[string]bool) *Diff {
+	components := make(map[string]*componentFiles)
+
+	for dir := range noStackDirs {
+		components[dir] = &componentFiles{kind: component.UnitKind}
+	}
+
+	for _, files := range []map[string]string{rendered, onDisk} {
+		for path := range files {
+			dir, name := slashSplit(path)
+
+			switch name {
+			case config.DefaultTerragruntConfigPath:
+				components[dir] = &componentFiles{kind: component.UnitKind}
+			case config.DefaultStackFile:
+				components[dir] = &componentFiles{kind: component.StackKind}
+			}
+		}
+	}
+
+	group := func(files map[string]string, isRendered bool) {
+		for path, abs := range files {
+			dir := componentDir(path, components)
+
+			cmp, ok := components[dir]
+			if !ok {
+				cmp = &componentFiles{kind: component.UnitKind}
+				components[dir] = cmp
+			}
+
+			if cmp.rendered == nil {
+				cmp.rendered = make(map[string]string)
+				cmp.onDisk = make(map[string]string)
+			}
+
+			rel := strings.TrimPrefix(path, dir+"/")
+
+			if isRendered {
+				cmp.rendered[rel] = abs
+			} else {
+				cmp.onDisk[rel] = abs
+			}
+		}
+	}
+
+	group(rendered, true)
+	group(onDisk, false)
+
+	diff := &Diff{Components: []*ComponentDiff{}}
+
+	for path, files := range components {
+		if cmp := diffComponent(path, files); cmp != nil {
+			diff.Components = append(diff.Components, cmp)
+		}
+	}
+
+	slices.SortFunc(diff.Components, func(a, b *ComponentDiff) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return diff
+}
+
+// componentDir returns the directory of the component holding the file at path: the deepest known component
+// directory, or the directory generated directly into a stack directory.
+func componentDir(path string, components map[string]*componentFiles) string {
+	dir, _ := slashSplit(path)
+
+	for dir != "." {
+		if _, ok := components[dir]; ok {
+			return dir
+		}
+
+		parent, _ := slashSplit(dir)
+		if _, name := slashSplit(parent); name == config.StackDir {
+			return dir
+		}
+
+		dir = parent
+	}
+
+	return dir
+}
+
+// diffComponent compares the rendered and on-disk files of a component, returning nil when they match.
+func diffComponent(path string, files *componentFiles) *ComponentDiff {
+	cmp := &ComponentDiff{Path: path, Kind: files.kind}
+
+	switch {
+	case len(files.rendered) == 0 && len(files.onDisk) == 0:
+		return nil
+	case len(files.onDisk) == 0:
+		cmp.Change = ChangeAdded
+
+		return cmp
+	case len(files.rendered) == 0:
+		cmp.Change = ChangeRemoved
+
+		return cmp
+	}
+
+	for name, renderedPath := range files.rendered {
+		diskPath, ok := files.onDisk[name]
+		if !ok {
+			cmp.Files = append(cmp.Files, &FileDiff{Path: name, Change: ChangeAdded})
+
+			continue
+		}
+
+		if !sameContent(renderedPath, diskPath) {
+			cmp.Files = append(cmp.Files, &FileDiff{Path: name, Change: ChangeChanged})
+
+			if name == config.ValuesFile {
+				cmp.Values = diffValues(diskPath, renderedPath)
+			}
+		}
+	}
+
+	for name := range files.onDisk {
+		if _, ok := files.rendered[name]; !ok {
+			cmp.Files = append(cmp.Files, &FileDiff{Path: name, Change: ChangeRemoved})
+		}
+	}
+
+	if len(cmp.Files) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(cmp.Files, func(a, b *FileDiff) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	cmp.Change = ChangeChanged
+
+	return cmp
+}
+
+// diffValues compares the values of two values files. Values files that fail to parse are not compared.
+func diffValues(beforePath, afterPath string) []*ValueDiff {
+	before, err := valueExpressions(beforePath)
+	if err != nil {
+		return nil
+	}
+
+	after, err := valueExpressions(afterPath)
+	if err != nil {
+		return nil
+	}
+
+	var diffs []*ValueDiff
+
+	for name, expr := range after {
+		prev, ok := before[name]
+
+		switch {
+		case !ok:
+			diffs = append(diffs, &ValueDiff{Name: name, Change: ChangeAdded, After: expr})
+		case prev != expr:
+			diffs = append(diffs, &ValueDiff{Name: name, Change: ChangeChanged, Before: prev, After: expr})
+		}
+	}
+
+	for name, expr := range before {
+		if _, ok := after[name]; !ok {
+			diffs = append(diffs, &ValueDiff{Name: name, Change: ChangeRemoved, Before: expr})
+		}
+	}
+
+	slices.SortFunc(diffs, func(a, b *ValueDiff) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return diffs
+}
+
+// valueExpressions returns the expression of each value in a values file.
+func valueExpressions(path string) (map[string]string, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, diags := hclwrite.ParseConfig(src, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	attrs := file.Body().Attributes()
+	exprs := make(map[string]string, len(attrs))
+
+	for name, attr := range attrs {
+		exprs[name] = strings.TrimSpace(string(attr.Expr().BuildTokens(nil).Bytes()))
+	}
+
+	return exprs, nil
+}
+
+// sameContent reports whether two files have the same content. Unreadable files are reported as different.
+func sameContent(a, b string) bool {
+	contentA, err := os.ReadFile(a)
+	if err != nil {
+		return false
+	}
+
+	contentB, err := os.ReadFile(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(contentA, contentB)
+}
+
+// slashSplit splits a slash-separated path into its directory, "." at the top, and its last element.
+func slashSplit(path string) (string, string) {
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return ".", path
+	}
+
+	return path[:i], path[i+1:]
+}
+
+// isGeneratedPath reports whether path lies in a stack directory generated under workingDir.
+func isGeneratedPath(workingDir, path string) bool {
+	rel, err := filepath.Rel(workingDir, path)
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(strings.Split(filepath.ToSlash(rel), "/"), config.StackDir)
+}
@@ -0,0 +1,116 @@
+package generate_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/component"
+	"github.com/gruntwork-io/terragrunt/internal/stacks/generate"
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for path, content := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestDiffStacks(t *testing.T) {
+	t.Parallel()
+
+	workingDir := helpers.TmpDirWOSymlinks(t)
+
+	writeFiles(t, workingDir, map[string]string{
+		"terragrunt.stack.hcl": "",
+
+		// Generated on disk.
+		".terragrunt-stack/same/terragrunt.hcl":                        "same",
+		".terragrunt-stack/app/terragrunt.hcl":                         "app",
+		".terragrunt-stack/app/terragrunt.values.hcl":                  "ver = \"1.0\"\nregion = \"eu\"\n",
+		".terragrunt-stack/app/.terragrunt-stack-manifest":             "manifest",
+		".terragrunt-stack/app/old.tf":                                 "old",
+		".terragrunt-stack/gone/terragrunt.hcl":                        "gone",
+		".terragrunt-stack/nested/terragrunt.stack.hcl":                "nested",
+		".terragrunt-stack/nested/.terragrunt-stack/db/terragrunt.hcl": "db",
+		"config/settings.txt":                                          "v1",
+	})
+
+	preview := config.NewStackPreview(helpers.TmpDirWOSymlinks(t))
+	preview.AddNoStackDir(filepath.Join(workingDir, "config"))
+
+	// Rendered by the dry run.
+	writeFiles(t, preview.Path(workingDir), map[string]string{
+		".terragrunt-stack/same/terragrunt.hcl":                        "same",
+		".terragrunt-stack/app/terragrunt.hcl":                         "app",
+		".terragrunt-stack/app/terragrunt.values.hcl":                  "ver = \"1.1\"\nzone = \"a\"\n",
+		".terragrunt-stack/app/.terragrunt-stack-manifest":             "other manifest",
+		".terragrunt-stack/new/terragrunt.hcl":                         "new",
+		".terragrunt-stack/nested/terragrunt.stack.hcl":                "nested",
+		".terragrunt-stack/nested/.terragrunt-stack/db/terragrunt.hcl": "db",
+		"config/settings.txt":                                          "v2",
+	})
+
+	diff, err := generate.DiffStacks(workingDir, []string{filepath.Join(workingDir, "terragrunt.stack.hcl")}, preview)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*generate.ComponentDiff{
+		{
+			Path:   ".terragrunt-stack/app",
+			Kind:   component.UnitKind,
+			Change: generate.ChangeChanged,
+			Files: []*generate.FileDiff{
+				{Path: "old.tf", Change: generate.ChangeRemoved},
+				{Path: "terragrunt.values.hcl", Change: generate.ChangeChanged},
+			},
+			Values: []*generate.ValueDiff{
+				{Name: "region", Change: generate.ChangeRemoved, Before: `"eu"`},
+				{Name: "ver", Change: generate.ChangeChanged, Before: `"1.0"`, After: `"1.1"`},
+				{Name: "zone", Change: generate.ChangeAdded, After: `"a"`},
+			},
+		},
+		{Path: ".terragrunt-stack/gone", Kind: component.UnitKind, Change: generate.ChangeRemoved},
+		{Path: ".terragrunt-stack/new", Kind: component.UnitKind, Change: generate.ChangeAdded},
+		{
+			Path:   "config",
+			Kind:   component.UnitKind,
+			Change: generate.ChangeChanged,
+			Files:  []*generate.FileDiff{{Path: "settings.txt", Change: generate.ChangeChanged}},
+		},
+	}, diff.Components)
+
+	assert.Equal(t, 1, diff.Count(generate.ChangeAdded))
+	assert.Equal(t, 2, diff.Count(generate.ChangeChanged))
+	assert.Equal(t, 1, diff.Count(generate.ChangeRemoved))
+}
+
+func TestDiffStacksWithoutChanges(t *testing.T) {
+	t.Parallel()
+
+	workingDir := helpers.TmpDirWOSymlinks(t)
+
+	writeFiles(t, workingDir, map[string]string{
+		"live/terragrunt.stack.hcl":                 "",
+		"live/.terragrunt-stack/app/terragrunt.hcl": "app",
+	})
+
+	preview := config.NewStackPreview(helpers.TmpDirWOSymlinks(t))
+
+	writeFiles(t, preview.Path(workingDir), map[string]string{
+		"live/.terragrunt-stack/app/terragrunt.hcl": "app",
+	})
+
+	diff, err := generate.DiffStacks(workingDir, []string{filepath.Join(workingDir, "live", "terragrunt.stack.hcl")}, preview)
+	require.NoError(t, err)
+
+	assert.True(t, diff.Empty())
+}
@@ -4,6 +4,8 @@ package generate
 import (
 	"context"
 	"fmt"
+	"io/fs"
+	"os"
 	"path/filepath"
 	"runtime"
 	"slices"
@@ -72,9 +74,62 @@ func (g *Generator) GenerateStacks(
 	g.locks.Lock(workingDir)
 	defer g.locks.Unlock(workingDir)
 
-	foundFiles, err := ListStackFiles(ctx, l, opts, wts)
+	_, err = generateStacks(ctx, l, opts, wts, workingDir, nil)
+
+	return err
+}
+
+// DryRun renders the stacks under opts.WorkingDir the way [Generator.GenerateStacks] would generate them, but into
+// a temporary preview directory, and returns how the rendered stacks differ from the generated stacks on disk. The
+// preview directory is removed before returning.
+func (g *Generator) DryRun(
+	ctx context.Context,
+	l log.Logger,
+	opts *options.TerragruntOptions,
+	wts *worktrees.Worktrees,
+) (*Diff, error) {
+	workingDir, err := util.CanonicalResolvedPath(opts.WorkingDir, opts.WorkingDir)
+	if err != nil {
+		return nil, &CanonicalizeWorkingDirError{Path: opts.WorkingDir, Err: err}
+	}
+
+	g.locks.Lock(workingDir)
+	defer g.locks.Unlock(workingDir)
+
+	previewDir, err := os.MkdirTemp("", "terragrunt-stack-preview-")
 	if err != nil {
-		return fmt.Errorf("failed to list stack files in %s %w", opts.WorkingDir, err)
+		return nil, fmt.Errorf("failed to create stack preview directory: %w", err)
+	}
+
+	defer func() {
+		if err := os.RemoveAll(previewDir); err != nil {
+			l.Warnf("Failed to remove stack preview directory %s: %v", previewDir, err)
+		}
+	}()
+
+	preview := config.NewStackPreview(previewDir)
+
+	stackFiles, err := generateStacks(ctx, l, opts, wts, workingDir, preview)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffStacks(workingDir, stackFiles, preview)
+}
+
+// generateStacks generates the stacks under workingDir, the canonical opts.WorkingDir, or renders them into preview
+// when it is not nil, and returns the stack files found before generation.
+func generateStacks(
+	ctx context.Context,
+	l log.Logger,
+	opts *options.TerragruntOptions,
+	wts *worktrees.Worktrees,
+	workingDir string,
+	preview *config.StackPreview,
+) ([]string, error) {
+	foundFiles, err := listStackFilesToGenerate(ctx, l, opts, wts, workingDir, preview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stack files in %s %w", opts.WorkingDir, err)
 	}
 
 	if len(foundFiles) == 0 {
@@ -82,7 +137,7 @@ func (g *Generator) GenerateStacks(
 			l.Warnf("No stack files found in %s Nothing to generate.", opts.WorkingDir)
 		}
 
-		return nil
+		return nil, nil
 	}
 
 	// Dedup keyed by canonical stack-file path; accessed only from the main goroutine.
@@ -96,7 +151,7 @@ func (g *Generator) GenerateStacks(
 	const maxLevel = 1024
 	for level := range maxLevel {
 		if level == maxLevel-1 {
-			return fmt.Errorf("cycle detected: maximum level (%d) exceeded", maxLevel)
+			return nil, fmt.Errorf("cycle detected: maximum level (%d) exceeded", maxLevel)
 		}
 
 		levelNodes := getNodesAtLevel(stackTrees, level)
@@ -106,16 +161,86 @@ func (g *Generator) GenerateStacks(
 
 		warnOnRepeatedClaims(l, levelNodes, claimedBy)
 
-		if err := generateLevel(ctx, l, opts, level, levelNodes, generatedFiles); err != nil {
+		if err := generateLevel(ctx, l, opts, level, levelNodes, generatedFiles, preview); err != nil {
+			return nil, err
+		}
+
+		if err := discoverAndAddNewNodes(ctx, l, opts, wts, workingDir, stackTrees, generatedFiles, level+1, preview); err != nil {
+			return nil, err
+		}
+	}
+
+	return foundFiles, nil
+}
+
+// listStackFilesToGenerate lists the stack files like [ListStackFiles]. On a dry run, the stack files generated on
+// disk are replaced by the ones rendered into the preview, at the paths they would be generated at.
+func listStackFilesToGenerate(
+	ctx context.Context,
+	l log.Logger,
+	opts *options.TerragruntOptions,
+	wts *worktrees.Worktrees,
+	workingDir string,
+	preview *config.StackPreview,
+) ([]string, error) {
+	files, err := ListStackFiles(ctx, l, opts, wts)
+	if err != nil || preview == nil {
+		return files, err
+	}
+
+	files = slices.DeleteFunc(files, func(file string) bool {
+		return isGeneratedPath(workingDir, file)
+	})
+
+	rendered, err := previewStackFiles(preview, workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files = append(files, rendered...)
+	slices.Sort(files)
+
+	return slices.Compact(files), nil
+}
+
+// previewStackFiles returns the stack files rendered into preview under workingDir, at the paths they would be
+// generated at.
+func previewStackFiles(preview *config.StackPreview, workingDir string) ([]string, error) {
+	previewDir := preview.Path(workingDir)
+
+	var files []string
+
+	err := filepath.WalkDir(previewDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
 			return err
 		}
 
-		if err := discoverAndAddNewNodes(ctx, l, opts, wts, workingDir, stackTrees, generatedFiles, level+1); err != nil {
+		if d.IsDir() {
+			if path == previewDir {
+				return nil
+			}
+
+			return util.SkipDirIfIgnorable(d.Name())
+		}
+
+		if d.Name() != config.DefaultStackFile {
+			return nil
+		}
+
+		rel, err := filepath.Rel(previewDir, path)
+		if err != nil {
 			return err
 		}
+
+		files = append(files, filepath.Join(workingDir, rel))
+
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
 	}
 
-	return nil
+	return files, err
 }
 
 // warnOnRepeatedClaims logs a warning when a stack file is claimed by more than
@@ -150,6 +275,7 @@ func generateLevel(
 	level int,
 	levelNodes []*StackNode,
 	generatedFiles map[string]bool,
+	preview *config.StackPreview,
 ) error {
 	l.Debugf("Generating stack level %d with %d files", level, len(levelNodes))
 
@@ -164,12 +290,14 @@ func generateLevel(
 		generatedFiles[node.FilePath] = true
 
 		// Best-effort skip; GenerateStackFile surfaces ENOENT if the file is removed in the TOCTOU window.
-		if !util.FileExists(node.FilePath) {
+		if !util.FileExists(preview.ReadPath(node.FilePath)) {
 			continue
 		}
 
 		wp.Submit(func() error {
 			_, pctx := configbridge.NewParsingContext(ctx, l, opts)
+			pctx.StackPreview = preview
+
 			return config.GenerateStackFile(ctx, l, pctx, wp, node.FilePath)
 		})
 	}
@@ -190,8 +318,9 @@ func discoverAndAddNewNodes(
 	dependencyGraph map[string]*StackNode,
 	generatedFiles map[string]bool,
 	minLevel int,
+	preview *config.StackPreview,
 ) error {
-	newFiles, listErr := ListStackFiles(ctx, l, opts, worktrees)
+	newFiles, listErr := listStackFilesToGenerate(ctx, l, opts, worktrees, workingDir, preview)
 	if listErr != nil {
 		return fmt.Errorf("failed to list stack files after level %d: %w", minLevel-1, listErr)
 	}
@@ -24,6 +24,10 @@ import (
 	"github.com/zclconf/go-cty/cty"
 )
 
+// SensitiveMask replaces the value of sensitive outputs unless they are requested with
+// [options.TerragruntOptions.StackOutputShowSensitive].
+const SensitiveMask = "<sensitive>"
+
 // UnitOutputError is returned when reading terraform outputs for a stack unit fails.
 type UnitOutputError struct {
 	Err      error
@@ -165,11 +169,15 @@ func StackOutput(
 			declaredUnits[key] = unit
 
 			wp.Submit(func() error {
-				out, err := readUnitOutput(ctx, l, pctx, unit, unitDir)
+				out, sensitive, err := readUnitOutput(ctx, l, pctx, unit, unitDir)
 				if err != nil {
 					return err
 				}
 
+				if !opts.StackOutputShowSensitive {
+					maskSensitiveOutputs(out, sensitive)
+				}
+
 				outputs.Store(key, out)
 
 				return nil
@@ -197,7 +205,7 @@ func StackOutput(
 		nameToPath := make(map[string]string) // Map to track which path each stack name came from
 
 		for stackPath, stackName := range declaredStacks {
-			if strings.Contains(path, stackPath) {
+			if strings.HasPrefix(path, stackPath+string(filepath.Separator)) {
 				stackNames = append(stackNames, stackName)
 				nameToPath[stackName] = stackPath
 			}
@@ -295,15 +303,27 @@ func nestUnitOutputs(flat map[string]map[string]cty.Value) (map[string]any, erro
 	return nested, nil
 }
 
-// readUnitOutput returns the tofu/terraform outputs for a unit.
+// maskSensitiveOutputs replaces the value of the sensitive outputs with [SensitiveMask].
+func maskSensitiveOutputs(outputs map[string]cty.Value, sensitive map[string]bool) {
+	for key := range sensitive {
+		if _, ok := outputs[key]; ok {
+			outputs[key] = cty.StringVal(SensitiveMask)
+		}
+	}
+}
+
+// readUnitOutput returns the tofu/terraform outputs for a unit, and the set of outputs declared sensitive.
 func readUnitOutput(
 	ctx context.Context,
 	l log.Logger,
 	pctx *config.ParsingContext,
 	unit *config.Unit,
 	unitDir string,
-) (map[string]cty.Value, error) {
-	var output map[string]cty.Value
+) (map[string]cty.Value, map[string]bool, error) {
+	var (
+		output    map[string]cty.Value
+		sensitive map[string]bool
+	)
 
 	err := telemetry.TelemeterFromContext(ctx).Collect(ctx, "unit_output", map[string]any{
 		"unit_name":   unit.Name,
@@ -312,15 +332,15 @@ func readUnitOutput(
 	}, func(ctx context.Context) error {
 		var outputErr error
 
-		output, outputErr = unit.ReadOutputs(ctx, l, pctx, unitDir)
+		output, sensitive, outputErr = unit.ReadOutputs(ctx, l, pctx, unitDir)
 
 		return outputErr
 	})
 	if err != nil {
-		return nil, UnitOutputError{UnitName: unit.Name, UnitDir: unitDir, Err: err}
+		return nil, nil, UnitOutputError{UnitName: unit.Name, UnitDir: unitDir, Err: err}
 	}
 
-	return output, nil
+	return output, sensitive, nil
 }
 
 // buildWorktreesIfNeeded creates worktrees if the filter-flag experiment is enabled and git filters exist.
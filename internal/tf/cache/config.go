@@ -51,6 +51,14 @@ func WithProviderService(service *services.ProviderService) Option {
 	}
 }
 
+// WithModuleService enables caching of the module packages downloaded through the module registry proxy.
+func WithModuleService(service *services.ModuleService) Option {
+	return func(cfg Config) Config {
+		cfg.moduleService = service
+		return cfg
+	}
+}
+
 func WithProviderHandlers(handlers ...handlers.ProviderHandler) Option {
 	return func(cfg Config) Config {
 		cfg.providerHandlers = handlers
@@ -79,6 +87,14 @@ func WithCacheProviderHTTPStatusCode(statusCode int) Option {
 	}
 }
 
+// WithMetrics enables the `/metrics` endpoint exposing the provider cache usage.
+func WithMetrics(enabled bool) Option {
+	return func(cfg Config) Config {
+		cfg.metrics = enabled
+		return cfg
+	}
+}
+
 func WithLogger(logger log.Logger) Option {
 	return func(cfg Config) Config {
 		cfg.logger = logger
@@ -89,6 +105,7 @@ func WithLogger(logger log.Logger) Option {
 type Config struct {
 	logger                      log.Logger
 	providerService             *services.ProviderService
+	moduleService               *services.ModuleService
 	proxyProviderHandler        *handlers.ProxyProviderHandler
 	proxyModuleHandler          *handlers.ProxyModuleHandler
 	hostname                    string
@@ -97,6 +114,7 @@ type Config struct {
 	port                        int
 	shutdownTimeout             time.Duration
 	cacheProviderHTTPStatusCode int
+	metrics                     bool
 }
 
 func NewConfig(opts ...Option) *Config {
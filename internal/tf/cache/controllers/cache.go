@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"net/http"
+	"path/filepath"
+
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/router"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/services"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// URL path to this controller
+	cachePath = "/cache"
+)
+
+// CacheController lets the clients of a shared cache server retrieve the providers cached for their cache requests,
+// which the in-process server hands over by calling ProviderService.WaitForCacheReady directly.
+type CacheController struct {
+	*router.Router
+
+	AuthMiddleware  echo.MiddlewareFunc
+	ProviderService *services.ProviderService
+	Logger          log.Logger
+}
+
+// Register implements router.Controller.Register
+func (controller *CacheController) Register(router *router.Router) {
+	controller.Router = router.Group(cachePath)
+
+	if controller.AuthMiddleware != nil {
+		controller.Use(controller.AuthMiddleware)
+	}
+
+	controller.GET("/:cache_request_id", controller.getCacheAction)
+	controller.DELETE("/:cache_request_id", controller.releaseCacheAction)
+}
+
+// getCacheAction waits until all providers requested with the cache request ID are processed and returns them.
+func (controller *CacheController) getCacheAction(ctx echo.Context) error {
+	providers, err := controller.ProviderService.WaitForCacheReady(ctx.Param("cache_request_id"))
+
+	resp := models.CacheResponse{
+		Providers: make([]*models.CachedProvider, 0, len(providers)),
+	}
+
+	if err != nil {
+		for _, err := range unwrapJoined(err) {
+			resp.Errors = append(resp.Errors, err.Error())
+		}
+	}
+
+	for _, provider := range providers {
+		packagePath, err := filepath.Rel(controller.ProviderService.CacheDir(), provider.PackageDir())
+		if err != nil {
+			return err
+		}
+
+		documentSHA256Sums, err := provider.DocumentSHA256Sums(ctx.Request().Context())
+		if err != nil {
+			resp.Errors = append(resp.Errors, err.Error())
+			continue
+		}
+
+		cachedProvider := &models.CachedProvider{
+			Address:            provider.Address(),
+			Version:            provider.Version(),
+			PackagePath:        filepath.ToSlash(packagePath),
+			DocumentSHA256Sums: documentSHA256Sums,
+		}
+
+		if registryHashes := provider.RegistryHashes(); registryHashes != nil {
+			cachedProvider.RegistryHashes = make(map[string][]string, len(registryHashes))
+
+			for platform, hashes := range registryHashes {
+				for _, hash := range hashes {
+					cachedProvider.RegistryHashes[platform] = append(cachedProvider.RegistryHashes[platform], string(hash))
+				}
+			}
+		}
+
+		resp.Providers = append(resp.Providers, cachedProvider)
+	}
+
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// releaseCacheAction releases the providers requested with the cache request ID, once the client no longer needs them.
+func (controller *CacheController) releaseCacheAction(ctx echo.Context) error {
+	controller.ProviderService.ReleaseRequestID(ctx.Param("cache_request_id"))
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// unwrapJoined returns the errors joined with errors.Join, or the error itself.
+func unwrapJoined(err error) []error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint
+		return joined.Unwrap()
+	}
+
+	return []error{err}
+}
@@ -12,13 +12,15 @@ import (
 )
 
 const (
-	downloadPath = "/downloads"
+	downloadPath       = "/downloads"
+	moduleArchivesPath = "modules"
 )
 
 type DownloaderController struct {
 	*router.Router
 
 	ProviderService      *services.ProviderService
+	ModuleService        *services.ModuleService
 	ProxyProviderHandler *handlers.ProxyProviderHandler
 }
 
@@ -26,10 +28,26 @@ type DownloaderController struct {
 func (controller *DownloaderController) Register(router *router.Router) {
 	controller.Router = router.Group(downloadPath)
 
+	// Download cached module
+	controller.GET("/"+moduleArchivesPath+"/:archive", controller.downloadModuleAction)
+
 	// Download provider
 	controller.GET("/:remote_host/:remote_path", controller.downloadProviderAction)
 }
 
+func (controller *DownloaderController) downloadModuleAction(ctx echo.Context) error {
+	if controller.ModuleService == nil {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	path := controller.ModuleService.GetModuleArchive(ctx.Param("archive"))
+	if path == "" {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	return ctx.File(path)
+}
+
 func (controller *DownloaderController) downloadProviderAction(ctx echo.Context) error {
 	var (
 		remoteHost = ctx.Param("remote_host")
@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/router"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/services"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// URL path to this controller
+	metricsPath = "/metrics"
+
+	metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// MetricsController exposes the provider and module cache usage in the Prometheus text exposition format.
+type MetricsController struct {
+	*router.Router
+
+	ProviderService *services.ProviderService
+	ModuleService   *services.ModuleService
+}
+
+// Register implements router.Controller.Register
+func (controller *MetricsController) Register(router *router.Router) {
+	controller.Router = router.Group(metricsPath)
+
+	controller.GET("", controller.metricsAction)
+}
+
+func (controller *MetricsController) metricsAction(ctx echo.Context) error {
+	stats := controller.ProviderService.Stats()
+
+	type metric struct {
+		name  string
+		typ   string
+		help  string
+		value any
+	}
+
+	metrics := []metric{
+		{"terragrunt_provider_cache_hits_total", "counter", "Number of requests for providers that were already cached.", stats.Hits},
+		{"terragrunt_provider_cache_misses_total", "counter", "Number of requests for providers that had to be cached.", stats.Misses},
+		{"terragrunt_provider_cache_evictions_total", "counter", "Number of providers evicted to keep the cache within its size limit.", stats.Evictions},
+		{"terragrunt_provider_cache_failures_total", "counter", "Number of providers that could not be cached.", stats.Failures},
+		{"terragrunt_provider_cache_providers", "gauge", "Number of cached providers.", stats.Providers},
+		{"terragrunt_provider_cache_size_bytes", "gauge", "Total size of the cached providers.", stats.SizeBytes},
+		{"terragrunt_provider_cache_max_size_bytes", "gauge", "Size limit of the cache, 0 if unlimited.", stats.MaxSizeBytes},
+	}
+
+	if controller.ModuleService != nil {
+		moduleStats := controller.ModuleService.Stats()
+
+		metrics = append(metrics,
+			metric{"terragrunt_module_cache_hits_total", "counter", "Number of module downloads served from the cache.", moduleStats.Hits},
+			metric{"terragrunt_module_cache_misses_total", "counter", "Number of module downloads that had to be cached.", moduleStats.Misses},
+			metric{"terragrunt_module_cache_modules", "gauge", "Number of cached modules.", moduleStats.Modules},
+		)
+	}
+
+	var sb strings.Builder
+
+	for _, metric := range metrics {
+		fmt.Fprintf(&sb, "# HELP %s %s\n# TYPE %s %s\n%s %v\n", metric.name, metric.help, metric.name, metric.typ, metric.name, metric.value)
+	}
+
+	return ctx.Blob(http.StatusOK, metricsContentType, []byte(sb.String()))
+}
@@ -1,8 +1,13 @@
 package controllers
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/gruntwork-io/terragrunt/internal/tf/cache/handlers"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/models"
 	"github.com/gruntwork-io/terragrunt/internal/tf/cache/router"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/services"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 	"github.com/labstack/echo/v4"
 )
@@ -15,12 +20,15 @@ const (
 // ModuleController exposes the modules.v1 registry protocol on the Terragrunt
 // cache server, accepting requests authenticated with the cache server's API key
 // and forwarding them to the upstream registry with the user's real credentials.
+// If ModuleService is set, module downloads are cached and served from the local disk.
 type ModuleController struct {
 	*router.Router
 
-	AuthMiddleware     echo.MiddlewareFunc
-	ProxyModuleHandler *handlers.ProxyModuleHandler
-	Logger             log.Logger
+	AuthMiddleware       echo.MiddlewareFunc
+	DownloaderController router.Controller
+	ProxyModuleHandler   *handlers.ProxyModuleHandler
+	ModuleService        *services.ModuleService
+	Logger               log.Logger
 }
 
 // Endpoints implements controllers.Endpointer.
@@ -47,5 +55,32 @@ func (c *ModuleController) proxyAction(ctx echo.Context) error {
 	registryName := ctx.Param("registry_name")
 	rest := ctx.Param("*")
 
+	if c.ModuleService != nil && c.ProxyModuleHandler.ProxiesRegistry(registryName) {
+		if module := models.ParseModuleDownloadPath(registryName, rest); module != nil {
+			return c.downloadAction(ctx, module, rest)
+		}
+	}
+
 	return c.ProxyModuleHandler.Proxy(ctx, registryName, rest)
 }
+
+// downloadAction caches the module package and points the client to the archive served by the DownloaderController.
+// If the module can't be cached, the request is forwarded to the upstream registry as is.
+func (c *ModuleController) downloadAction(ctx echo.Context, module *models.Module, rest string) error {
+	cache, err := c.ModuleService.CacheModule(ctx.Request().Context(), module, func(reqCtx context.Context) (string, error) {
+		return c.ProxyModuleHandler.DownloadSource(reqCtx, module.RegistryName, rest)
+	})
+	if err != nil {
+		c.Logger.Warnf("Unable to cache module %s, downloading it from the upstream registry: %v", module, err)
+		return c.ProxyModuleHandler.Proxy(ctx, module.RegistryName, rest)
+	}
+
+	downloadURL := c.DownloaderController.URL().JoinPath(moduleArchivesPath, cache.ArchiveName()).String()
+	if subdir := cache.Subdir(); subdir != "" {
+		downloadURL += "//" + subdir
+	}
+
+	ctx.Response().Header().Set("X-Terraform-Get", downloadURL)
+
+	return ctx.NoContent(http.StatusNoContent)
+}
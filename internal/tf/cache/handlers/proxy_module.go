@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/tf/cache/helpers"
 	"github.com/gruntwork-io/terragrunt/internal/tf/cliconfig"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
+	svchost "github.com/hashicorp/terraform-svchost"
 	"github.com/labstack/echo/v4"
 )
 
@@ -42,9 +44,14 @@ func NewProxyModuleHandler(logger log.Logger, credsSource *cliconfig.Credentials
 	}
 }
 
+// ProxiesRegistry reports whether module-registry requests for the given registry are proxied.
+func (h *ProxyModuleHandler) ProxiesRegistry(registryName string) bool {
+	return slices.Contains(h.registryNames, registryName)
+}
+
 // Proxy forwards a module-registry request to the upstream registry.
 func (h *ProxyModuleHandler) Proxy(ctx echo.Context, registryName, restPath string) error {
-	if !slices.Contains(h.registryNames, registryName) {
+	if !h.ProxiesRegistry(registryName) {
 		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("registry %q is not configured for module proxying", registryName))
 	}
 
@@ -70,6 +77,80 @@ func (h *ProxyModuleHandler) Proxy(ctx echo.Context, registryName, restPath stri
 	return h.ReverseProxy.NewRequest(ctx, upstream)
 }
 
+// DownloadSource requests the module download endpoint of the upstream registry with the user's credentials
+// and returns the go-getter source address of the module package, taken from the `X-Terraform-Get` header
+// or, as a fallback, from the `location` field of the response body.
+func (h *ProxyModuleHandler) DownloadSource(ctx context.Context, registryName, restPath string) (string, error) {
+	if !h.ProxiesRegistry(registryName) {
+		return "", fmt.Errorf("registry %q is not configured for module proxying", registryName)
+	}
+
+	apiURLs, err := h.discoverer.DiscoveryURL(ctx, registryName)
+	if err != nil {
+		return "", err
+	}
+
+	upstream, err := buildModulesUpstreamURL(registryName, apiURLs.ModulesV1, restPath)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, upstream.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	if h.CredsSource != nil {
+		if creds := h.CredsSource.ForHost(svchost.Hostname(upstream.Hostname())); creds != nil {
+			creds.PrepareRequest(req)
+		}
+	}
+
+	resp, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return "", fmt.Errorf("%s returned from %s", resp.Status, upstream)
+	}
+
+	source := resp.Header.Get("X-Terraform-Get")
+
+	if source == "" {
+		var body struct {
+			Location string `json:"location"`
+		}
+
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return "", fmt.Errorf("parsing module download response from %s: %w", upstream, err)
+		}
+
+		source = body.Location
+	}
+
+	if source == "" {
+		return "", fmt.Errorf("no download location returned from %s", upstream)
+	}
+
+	return resolveModuleSource(upstream, source)
+}
+
+// resolveModuleSource resolves a source address relative to the download URL, as allowed by the registry protocol.
+func resolveModuleSource(base *url.URL, source string) (string, error) {
+	if !strings.HasPrefix(source, "/") && !strings.HasPrefix(source, "./") && !strings.HasPrefix(source, "../") {
+		return source, nil
+	}
+
+	ref, err := url.Parse(source)
+	if err != nil {
+		return "", fmt.Errorf("parsing module download location %q: %w", source, err)
+	}
+
+	return base.ResolveReference(ref).String(), nil
+}
+
 // buildModulesUpstreamURL constructs the upstream URL for a module-registry request.
 // If modulesV1 is an absolute URL (contains "://"), it is used as the base.
 // Otherwise the URL is built as https://<registryName><modulesV1>.
@@ -0,0 +1,21 @@
+package models
+
+// CachedProvider describes a provider cached by the cache server, as returned to the clients of a shared cache server.
+type CachedProvider struct {
+	// RegistryHashes are the registry-supplied per-platform hashes, keyed by `${os}_${arch}`.
+	RegistryHashes map[string][]string `json:"registry_hashes,omitempty"`
+	// Address is the source address of the provider, e.g. registry.terraform.io/hashicorp/aws.
+	Address string `json:"address"`
+	// Version is the version of the provider.
+	Version string `json:"version"`
+	// PackagePath is the path of the unpacked provider, relative to the provider cache directory.
+	PackagePath string `json:"package_path"`
+	// DocumentSHA256Sums is the document with the provider hashes for the different platforms.
+	DocumentSHA256Sums []byte `json:"document_sha256sums,omitempty"`
+}
+
+// CacheResponse is the response of the cache server with the providers cached for a cache request.
+type CacheResponse struct {
+	Providers []*CachedProvider `json:"providers"`
+	Errors    []string          `json:"errors,omitempty"`
+}
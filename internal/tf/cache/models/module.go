@@ -0,0 +1,49 @@
+package models
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+const moduleDownloadPathParts = 5
+
+// Module represents the details of a registry module at a specific version.
+type Module struct {
+	RegistryName string
+	Namespace    string
+	Name         string
+	System       string
+	Version      string
+}
+
+// ParseModuleDownloadPath parses the `<namespace>/<name>/<system>/<version>/download` path of the modules.v1
+// registry protocol. Returns nil if the given path is not a module download request.
+func ParseModuleDownloadPath(registryName, restPath string) *Module {
+	parts := strings.Split(strings.Trim(restPath, "/"), "/")
+	if len(parts) != moduleDownloadPathParts || parts[moduleDownloadPathParts-1] != "download" {
+		return nil
+	}
+
+	for _, part := range append(parts, registryName) {
+		if part == "" || part == "." || part == ".." || strings.Contains(part, `\`) {
+			return nil
+		}
+	}
+
+	return &Module{
+		RegistryName: registryName,
+		Namespace:    parts[0],
+		Name:         parts[1],
+		System:       parts[2],
+		Version:      parts[3],
+	}
+}
+
+func (module *Module) String() string {
+	return fmt.Sprintf("%s v%s", module.Address(), module.Version)
+}
+
+func (module *Module) Address() string {
+	return path.Join(module.RegistryName, module.Namespace, module.Name, module.System)
+}
@@ -0,0 +1,34 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseModuleDownloadPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		expected *models.Module
+		restPath string
+	}{
+		{
+			restPath: "acme/vpc/aws/1.0.0/download",
+			expected: &models.Module{RegistryName: "registry.example.com", Namespace: "acme", Name: "vpc", System: "aws", Version: "1.0.0"},
+		},
+		{restPath: "acme/vpc/aws/versions"},
+		{restPath: "acme/vpc/aws/1.0.0"},
+		{restPath: "acme/../aws/1.0.0/download"},
+		{restPath: "acme//aws/1.0.0/download"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.restPath, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, models.ParseModuleDownloadPath("registry.example.com", tc.restPath))
+		})
+	}
+}
@@ -35,6 +35,7 @@ func NewServer(opts ...Option) *Server {
 	downloaderController := &controllers.DownloaderController{
 		ProxyProviderHandler: cfg.proxyProviderHandler,
 		ProviderService:      cfg.providerService,
+		ModuleService:        cfg.moduleService,
 	}
 
 	providerController := &controllers.ProviderController{
@@ -47,10 +48,18 @@ func NewServer(opts ...Option) *Server {
 		Logger:                      cfg.logger,
 	}
 
+	cacheController := &controllers.CacheController{
+		AuthMiddleware:  authMiddleware,
+		ProviderService: cfg.providerService,
+		Logger:          cfg.logger,
+	}
+
 	moduleController := &controllers.ModuleController{
-		AuthMiddleware:     authMiddleware,
-		ProxyModuleHandler: cfg.proxyModuleHandler,
-		Logger:             cfg.logger,
+		AuthMiddleware:       authMiddleware,
+		DownloaderController: downloaderController,
+		ProxyModuleHandler:   cfg.proxyModuleHandler,
+		ModuleService:        cfg.moduleService,
+		Logger:               cfg.logger,
 	}
 
 	endpointers := []controllers.Endpointer{providerController}
@@ -67,17 +76,26 @@ func NewServer(opts ...Option) *Server {
 	rootRouter.Use(middleware.Recover(cfg.logger))
 	rootRouter.Register(discoveryController, downloaderController)
 
+	if cfg.metrics {
+		rootRouter.Register(&controllers.MetricsController{ProviderService: cfg.providerService, ModuleService: cfg.moduleService})
+	}
+
 	v1Group := rootRouter.Group("v1")
-	v1Group.Register(providerController)
+	v1Group.Register(providerController, cacheController)
 
 	if cfg.proxyModuleHandler != nil {
 		v1Group.Register(moduleController)
 	}
 
+	svcs := []services.Service{cfg.providerService}
+	if cfg.moduleService != nil {
+		svcs = append(svcs, cfg.moduleService)
+	}
+
 	return &Server{
 		Router:             rootRouter,
 		Config:             cfg,
-		services:           []services.Service{cfg.providerService},
+		services:           svcs,
 		ProviderController: providerController,
 		ModuleController:   moduleController,
 	}
@@ -19,3 +19,14 @@ type UnexpectedProviderCachePathError struct {
 func (e *UnexpectedProviderCachePathError) Error() string {
 	return fmt.Sprintf("unexpected non-symlink at provider package path %q (mode %s); refusing to remove", e.Path, e.Mode)
 }
+
+// ModuleChecksumMismatchError is returned when a cached module archive doesn't match its recorded checksum.
+type ModuleChecksumMismatchError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (err ModuleChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for module archive %s: expected %s, got %s", err.Path, err.Expected, err.Actual)
+}
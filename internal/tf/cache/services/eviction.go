@@ -0,0 +1,330 @@
+package services
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+)
+
+// ProviderCacheStats is a snapshot of the provider cache usage.
+type ProviderCacheStats struct {
+	// Providers is the number of cached providers.
+	Providers int
+	// SizeBytes is the total size of the cached providers.
+	SizeBytes int64
+	// MaxSizeBytes is the size limit of the cache, zero means no limit.
+	MaxSizeBytes int64
+	// Hits is the number of requests for providers that were already cached or being cached.
+	Hits uint64
+	// Misses is the number of requests for providers that had to be cached.
+	Misses uint64
+	// Evictions is the number of providers removed to keep the cache within its size limit.
+	Evictions uint64
+	// Failures is the number of providers that could not be cached.
+	Failures uint64
+}
+
+// Stats returns the provider cache usage.
+func (service *ProviderService) Stats() ProviderCacheStats {
+	service.cacheMu.RLock()
+	defer service.cacheMu.RUnlock()
+
+	stats := ProviderCacheStats{
+		MaxSizeBytes: service.maxCacheSize,
+		Hits:         service.hits.Load(),
+		Misses:       service.misses.Load(),
+		Evictions:    service.evictions.Load(),
+		Failures:     service.failures.Load(),
+	}
+
+	for _, cache := range service.providerCaches {
+		if cache.isReady() {
+			stats.Providers++
+			stats.SizeBytes += cache.getSize()
+		}
+	}
+
+	for _, pkg := range service.existingPackages {
+		stats.Providers++
+		stats.SizeBytes += pkg.size
+	}
+
+	return stats
+}
+
+// existingPackage is a provider package that was already in the cache directory when the service started. It counts
+// towards the size limit, and can be evicted, until a client requests the provider again.
+type existingPackage struct {
+	lastUsed     time.Time
+	dir          string
+	lockfilePath string
+	size         int64
+}
+
+// packageDirDepth is the depth of the provider packages in the cache directory: hostname/namespace/name/version/platform.
+const packageDirDepth = 5
+
+// scanCacheDir registers the provider packages cached by previous runs of the service, so that a service restarted
+// with a size limit also evicts them. Packages symlinked from the user plugins directory take no space and are skipped.
+func (service *ProviderService) scanCacheDir() error {
+	// The lock files of the packages are taken on eviction.
+	if err := service.FS().MkdirAll(service.tempDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	var packages []*existingPackage
+
+	err := vfs.WalkDir(service.FS(), service.cacheDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(service.cacheDir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		if len(parts) < packageDirDepth {
+			return nil
+		}
+
+		if !entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		packages = append(packages, &existingPackage{
+			dir:          path,
+			lockfilePath: filepath.Join(service.tempDir, strings.Join(parts, "-")+".lock"),
+			lastUsed:     info.ModTime(),
+			size:         service.packageSize(path),
+		})
+
+		return fs.SkipDir
+	})
+	if err != nil {
+		return err
+	}
+
+	service.cacheMu.Lock()
+	service.existingPackages = packages
+	service.cacheMu.Unlock()
+
+	service.logger.Debugf("Found %d provider packages in the cache dir %s", len(packages), service.cacheDir)
+
+	return nil
+}
+
+// ReleaseRequestID tells the service that the providers requested with the given `requestID` are no longer needed
+// by the client. A long-running service must release each request once its providers are retrieved:
+// providers that are not requested by anyone can be evicted, and providers that failed to cache are retried on the
+// next request.
+func (service *ProviderService) ReleaseRequestID(requestID string) {
+	service.cacheMu.Lock()
+
+	service.providerCaches = slices.DeleteFunc(service.providerCaches, func(cache *ProviderCache) bool {
+		if !cache.containsRequestID(requestID) {
+			return false
+		}
+
+		return cache.removeRequestID(requestID) && cache.getErr() != nil
+	})
+
+	service.cacheMu.Unlock()
+
+	service.evictProviderCaches()
+}
+
+// evictionCandidate is a cached provider, or a provider package found in the cache directory at startup, which is not
+// requested by anyone and can be evicted.
+type evictionCandidate struct {
+	lastUsed time.Time
+	cache    *ProviderCache
+	pkg      *existingPackage
+	size     int64
+}
+
+// evictProviderCaches removes the least recently used providers, which are not requested by anyone, until the total
+// size of the cache fits in the limit.
+func (service *ProviderService) evictProviderCaches() {
+	if service.maxCacheSize <= 0 {
+		return
+	}
+
+	service.cacheMu.Lock()
+	defer service.cacheMu.Unlock()
+
+	var (
+		totalSize  int64
+		candidates []evictionCandidate
+		managed    = make(map[string]bool, len(service.providerCaches))
+	)
+
+	for _, cache := range service.providerCaches {
+		managed[cache.packageDir] = true
+
+		if !cache.isReady() {
+			continue
+		}
+
+		size := cache.getSize()
+		totalSize += size
+
+		// Providers symlinked from the user plugins directory take no space in the cache.
+		if size > 0 && len(cache.getRequestIDs()) == 0 {
+			candidates = append(candidates, evictionCandidate{cache: cache, size: size, lastUsed: cache.getLastUsed()})
+		}
+	}
+
+	// A package found at startup is managed by its provider cache once the provider is requested again.
+	service.existingPackages = slices.DeleteFunc(service.existingPackages, func(pkg *existingPackage) bool {
+		return managed[pkg.dir]
+	})
+
+	for _, pkg := range service.existingPackages {
+		totalSize += pkg.size
+
+		if pkg.size > 0 {
+			candidates = append(candidates, evictionCandidate{pkg: pkg, size: pkg.size, lastUsed: pkg.lastUsed})
+		}
+	}
+
+	if totalSize <= service.maxCacheSize {
+		return
+	}
+
+	slices.SortFunc(candidates, func(a, b evictionCandidate) int {
+		return a.lastUsed.Compare(b.lastUsed)
+	})
+
+	for _, candidate := range candidates {
+		if totalSize <= service.maxCacheSize {
+			break
+		}
+
+		name, err := service.evictCandidate(candidate)
+		if err != nil {
+			service.logger.Warnf("Failed to evict provider %s from the cache: %v", name, err)
+			continue
+		}
+
+		totalSize -= candidate.size
+
+		service.evictions.Add(1)
+
+		service.logger.Infof("Evicted %s from the cache, cache size %d of %d bytes", name, totalSize, service.maxCacheSize)
+	}
+}
+
+// evictCandidate removes the candidate from the cache, and returns its name for logging.
+func (service *ProviderService) evictCandidate(candidate evictionCandidate) (string, error) {
+	if candidate.pkg != nil {
+		name, _ := filepath.Rel(service.cacheDir, candidate.pkg.dir)
+
+		if err := service.evictExistingPackage(candidate.pkg); err != nil {
+			return name, err
+		}
+
+		service.existingPackages = slices.DeleteFunc(service.existingPackages, func(pkg *existingPackage) bool { return pkg == candidate.pkg })
+
+		return name, nil
+	}
+
+	cache := candidate.cache
+
+	if err := service.evictProviderCache(cache); err != nil {
+		return cache.Provider.String(), err
+	}
+
+	service.providerCaches = slices.DeleteFunc(service.providerCaches, func(c *ProviderCache) bool { return c == cache })
+
+	return cache.Provider.String(), nil
+}
+
+// evictProviderCache removes the unpacked provider from the cache directory, taking the same lock file that is used
+// while the provider is being cached, so that a provider is never removed while another process writes it.
+func (service *ProviderService) evictProviderCache(cache *ProviderCache) error {
+	lockfile := util.NewLockfile(cache.lockfilePath)
+	if err := lockfile.TryLock(); err != nil {
+		return err
+	}
+	defer lockfile.Unlock() //nolint:errcheck
+
+	cache.setReady(false)
+
+	return service.FS().RemoveAll(cache.packageDir)
+}
+
+// evictExistingPackage removes a package found in the cache directory at startup, taking the same lock file as
+// evictProviderCache.
+func (service *ProviderService) evictExistingPackage(pkg *existingPackage) error {
+	lockfile := util.NewLockfile(pkg.lockfilePath)
+	if err := lockfile.TryLock(); err != nil {
+		return err
+	}
+	defer lockfile.Unlock() //nolint:errcheck
+
+	return service.FS().RemoveAll(pkg.dir)
+}
+
+// packageSize returns the total size of the files in the given provider package directory. A package that is a
+// symlink to the user plugins directory has zero size.
+func (service *ProviderService) packageSize(packageDir string) int64 {
+	var size int64
+
+	err := vfs.WalkDir(service.FS(), packageDir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		size += info.Size()
+
+		return nil
+	})
+	if err != nil {
+		service.logger.Warnf("Failed to calculate the size of provider package %s: %v", packageDir, err)
+	}
+
+	return size
+}
+
+func (cache *ProviderCache) getSize() int64 {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	return cache.size
+}
+
+func (cache *ProviderCache) setSize(size int64) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.size = size
+}
+
+func (cache *ProviderCache) getLastUsed() time.Time {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	return cache.lastUsed
+}
@@ -0,0 +1,189 @@
+package services_test
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/services"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+)
+
+const providerBinarySize = 1024
+
+// newProviderArchive writes a provider archive with a binary of providerBinarySize bytes and returns the provider
+// to be downloaded from it.
+func newProviderArchive(t *testing.T, name string) *models.Provider {
+	t.Helper()
+
+	archivePath := filepath.Join(t.TempDir(), name+".zip")
+
+	file, err := os.Create(archivePath)
+	require.NoError(t, err)
+
+	writer := zip.NewWriter(file)
+
+	binary, err := writer.Create("terraform-provider-" + name)
+	require.NoError(t, err)
+
+	_, err = binary.Write(make([]byte, providerBinarySize))
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Close())
+	require.NoError(t, file.Close())
+
+	return &models.Provider{
+		RegistryName: "registry.opentofu.org",
+		Namespace:    "eviction-test",
+		Name:         name + "-" + filepath.Base(t.TempDir()),
+		Version:      "1.0.0",
+		OS:           "linux",
+		Arch:         "amd64",
+		ResponseBody: &models.ResponseBody{
+			Filename:    name + ".zip",
+			DownloadURL: archivePath,
+		},
+	}
+}
+
+// cacheProvider caches the provider, as requested by a client, and releases the request.
+func cacheProvider(t *testing.T, service *services.ProviderService, requestID string, provider *models.Provider) string {
+	t.Helper()
+
+	cache := service.CacheProvider(t.Context(), requestID, provider)
+
+	providers, err := service.WaitForCacheReady(requestID)
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+
+	service.ReleaseRequestID(requestID)
+
+	return cache.PackageDir()
+}
+
+func TestProviderServiceEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+
+	// Room for two providers.
+	service := services.NewProviderService(cacheDir, t.TempDir(), nil, logger.CreateLogger(),
+		services.WithMaxCacheSize(2*providerBinarySize+providerBinarySize/2))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+
+	go func() { done <- service.Run(ctx) }()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	first, second, third := newProviderArchive(t, "first"), newProviderArchive(t, "second"), newProviderArchive(t, "third")
+
+	firstDir := cacheProvider(t, service, "1", first)
+	secondDir := cacheProvider(t, service, "2", second)
+
+	// Using the first provider again makes the second one the least recently used.
+	cacheProvider(t, service, "3", first)
+
+	thirdDir := cacheProvider(t, service, "4", third)
+
+	assert.DirExists(t, firstDir)
+	assert.NoDirExists(t, secondDir)
+	assert.DirExists(t, thirdDir)
+
+	stats := service.Stats()
+	assert.Equal(t, 2, stats.Providers)
+	assert.Equal(t, int64(2*providerBinarySize), stats.SizeBytes)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(3), stats.Misses)
+	assert.Equal(t, uint64(1), stats.Evictions)
+
+	// An evicted provider is cached again on the next request.
+	assert.Equal(t, secondDir, cacheProvider(t, service, "5", second))
+	assert.DirExists(t, secondDir)
+	assert.Equal(t, uint64(2), service.Stats().Evictions)
+}
+
+func TestProviderServiceKeepsRequestedProviders(t *testing.T) {
+	t.Parallel()
+
+	// No room for any provider.
+	service := services.NewProviderService(t.TempDir(), t.TempDir(), nil, logger.CreateLogger(),
+		services.WithMaxCacheSize(1))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+
+	go func() { done <- service.Run(ctx) }()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	cache := service.CacheProvider(t.Context(), "1", newProviderArchive(t, "requested"))
+
+	_, err := service.WaitForCacheReady("1")
+	require.NoError(t, err)
+
+	// The provider is not evicted while the client that requested it still uses it.
+	assert.DirExists(t, cache.PackageDir())
+	assert.Equal(t, 1, service.Stats().Providers)
+
+	service.ReleaseRequestID("1")
+
+	assert.NoDirExists(t, cache.PackageDir())
+	assert.Zero(t, service.Stats().Providers)
+}
+
+func TestProviderServiceEvictsProvidersCachedBeforeStart(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+
+	// Providers cached by a previous run of the service, the old one last used first.
+	oldDir := filepath.Join(cacheDir, "registry.opentofu.org", "eviction-test", "old-"+filepath.Base(t.TempDir()), "1.0.0", "linux_amd64")
+	newDir := filepath.Join(cacheDir, "registry.opentofu.org", "eviction-test", "new-"+filepath.Base(t.TempDir()), "1.0.0", "linux_amd64")
+
+	for _, dir := range []string{oldDir, newDir} {
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "terraform-provider"), make([]byte, providerBinarySize), 0644))
+	}
+
+	require.NoError(t, os.Chtimes(oldDir, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour)))
+
+	// Room for one provider.
+	service := services.NewProviderService(cacheDir, t.TempDir(), nil, logger.CreateLogger(),
+		services.WithMaxCacheSize(providerBinarySize+providerBinarySize/2))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+
+	go func() { done <- service.Run(ctx) }()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	<-service.Ready()
+
+	assert.Eventually(t, func() bool { return service.Stats().Evictions == 1 }, 10*time.Second, 10*time.Millisecond)
+
+	assert.NoDirExists(t, oldDir)
+	assert.DirExists(t, newDir)
+
+	stats := service.Stats()
+	assert.Equal(t, 1, stats.Providers)
+	assert.Equal(t, int64(providerBinarySize), stats.SizeBytes)
+}
@@ -0,0 +1,520 @@
+package services
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gruntwork-io/terragrunt/internal/getter"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+const (
+	moduleArchiveName  = "module.tar.gz"
+	moduleMetadataName = "module.json"
+	moduleLockfileName = "module.lock"
+
+	// ModuleArchiveExt is the extension of the cached module archives, which lets go-getter pick the right decompressor.
+	ModuleArchiveExt = ".tar.gz"
+)
+
+// ModuleSourceResolver returns the go-getter source address of the module package, as
+// returned by the registry in the `X-Terraform-Get` header of the download request.
+type ModuleSourceResolver func(ctx context.Context) (string, error)
+
+// moduleMetadata is stored next to the module archive.
+type moduleMetadata struct {
+	Source string `json:"source"`
+	Subdir string `json:"subdir,omitempty"`
+	SHA256 string `json:"sha256"`
+}
+
+type ModuleCache struct {
+	*models.Module
+	*ModuleService
+
+	packageDir   string
+	archivePath  string
+	metadataPath string
+	lockfilePath string
+	checksum     string
+	subdir       string
+	mu           sync.Mutex
+}
+
+// Checksum returns the SHA256 checksum of the module archive.
+func (cache *ModuleCache) Checksum() string {
+	return cache.checksum
+}
+
+// Subdir returns the subdirectory of the module package that contains the module, if any.
+func (cache *ModuleCache) Subdir() string {
+	return cache.subdir
+}
+
+// ArchivePath returns the path to the module archive.
+func (cache *ModuleCache) ArchivePath() string {
+	return cache.archivePath
+}
+
+// ArchiveName returns the content-addressed name of the module archive used in download URLs.
+func (cache *ModuleCache) ArchiveName() string {
+	return cache.checksum + ModuleArchiveExt
+}
+
+// load reads the metadata of the previously cached module and verifies the archive against the recorded checksum.
+func (cache *ModuleCache) load() error {
+	fs := cache.ModuleService.FS()
+
+	data, err := vfs.ReadFile(fs, cache.metadataPath)
+	if err != nil {
+		return err
+	}
+
+	var metadata moduleMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return fmt.Errorf("parsing module metadata %s: %w", cache.metadataPath, err)
+	}
+
+	checksum, err := fileSHA256(fs, cache.archivePath)
+	if err != nil {
+		return err
+	}
+
+	if checksum != metadata.SHA256 {
+		return ModuleChecksumMismatchError{Path: cache.archivePath, Expected: metadata.SHA256, Actual: checksum}
+	}
+
+	cache.checksum = metadata.SHA256
+	cache.subdir = metadata.Subdir
+
+	return nil
+}
+
+// download fetches the module package from the given go-getter source, packs it into a tar.gz archive,
+// and stores the archive along with its checksum in the cache directory.
+func (cache *ModuleCache) download(ctx context.Context, source string) error {
+	src, subdir := getter.SourceDirSubdir(source)
+	fs := cache.ModuleService.FS()
+
+	tempDir, err := vfs.MkdirTemp(fs, cache.packageDir, ".download-")
+	if err != nil {
+		return err
+	}
+	defer fs.RemoveAll(tempDir) //nolint:errcheck
+
+	moduleDir := filepath.Join(tempDir, "module")
+
+	cache.logger.Debugf("Fetching module %s from %s", cache.Module, src)
+
+	if _, err := getter.Get(ctx, moduleDir, src, getter.WithLogger(cache.logger)); err != nil {
+		return fmt.Errorf("fetching module %s from %s: %w", cache.Module, src, err)
+	}
+
+	// go-getter always fetches onto the real disk, and local sources are symlinked rather than copied.
+	if moduleDir, err = filepath.EvalSymlinks(moduleDir); err != nil {
+		return err
+	}
+
+	tempArchivePath := filepath.Join(tempDir, moduleArchiveName)
+
+	checksum, err := writeModuleArchive(fs, moduleDir, tempArchivePath)
+	if err != nil {
+		return fmt.Errorf("packing module %s: %w", cache.Module, err)
+	}
+
+	if err := fs.Rename(tempArchivePath, cache.archivePath); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(moduleMetadata{Source: source, Subdir: subdir, SHA256: checksum}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tempMetadataPath := filepath.Join(tempDir, moduleMetadataName)
+
+	if err := vfs.WriteFile(fs, tempMetadataPath, data, 0o644); err != nil {
+		return err
+	}
+
+	if err := fs.Rename(tempMetadataPath, cache.metadataPath); err != nil {
+		return err
+	}
+
+	cache.checksum = checksum
+	cache.subdir = subdir
+
+	return nil
+}
+
+func (cache *ModuleCache) remove() error {
+	for _, path := range []string{cache.metadataPath, cache.archivePath} {
+		if err := cache.ModuleService.FS().Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cache *ModuleCache) acquireLockFile(ctx context.Context) (vfs.Unlocker, error) {
+	var unlocker vfs.Unlocker
+
+	if err := util.DoWithRetry(ctx, "Acquiring lock file "+cache.lockfilePath, maxRetriesLockFile, retryDelayLockFile, cache.logger, log.DebugLevel, func(ctx context.Context) error {
+		lock, acquired, err := vfs.TryLock(cache.ModuleService.FS(), cache.lockfilePath)
+		if err != nil {
+			return err
+		}
+
+		if !acquired {
+			return fmt.Errorf("lock file %s is held by another process", cache.lockfilePath)
+		}
+
+		unlocker = lock
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("unable to acquire lock file %s (already locked?) try to remove the file manually: %w", cache.lockfilePath, err)
+	}
+
+	return unlocker, nil
+}
+
+// releaseLockFile releases the lock and removes the lock file, like util.Lockfile does.
+func (cache *ModuleCache) releaseLockFile(lock vfs.Unlocker) error {
+	if err := lock.Unlock(); err != nil {
+		return err
+	}
+
+	if err := cache.ModuleService.FS().Remove(cache.lockfilePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// ModuleCacheStats holds the usage counters of the module cache.
+type ModuleCacheStats struct {
+	Modules int
+	Hits    uint64
+	Misses  uint64
+}
+
+// ModuleService caches registry module packages as checksummed archives, so that each module version is
+// downloaded from its source only once and then served from local disk.
+type ModuleService struct {
+	logger log.Logger
+
+	// fs is the filesystem for file operations.
+	fs vfs.FS
+
+	// The path to store module archives, laid out as `<registry>/<namespace>/<name>/<system>/<version>`.
+	cacheDir string
+
+	moduleCaches map[string]*ModuleCache
+	archives     map[string]*ModuleCache
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+
+	cacheMu sync.RWMutex
+}
+
+// ModuleServiceOption configures a ModuleService.
+type ModuleServiceOption func(*ModuleService)
+
+// WithModuleFS sets the filesystem for file operations.
+// If not set, defaults to the real OS filesystem.
+func WithModuleFS(fs vfs.FS) ModuleServiceOption {
+	return func(ms *ModuleService) {
+		ms.fs = fs
+	}
+}
+
+func NewModuleService(cacheDir string, l log.Logger, opts ...ModuleServiceOption) *ModuleService {
+	service := &ModuleService{
+		cacheDir:     cacheDir,
+		logger:       l,
+		fs:           vfs.NewOSFS(),
+		moduleCaches: make(map[string]*ModuleCache),
+		archives:     make(map[string]*ModuleCache),
+	}
+
+	for _, opt := range opts {
+		opt(service)
+	}
+
+	l.Debugf("Module service initialized with cache dir: %s", cacheDir)
+
+	return service
+}
+
+// FS returns the configured filesystem.
+func (service *ModuleService) FS() vfs.FS {
+	return service.fs
+}
+
+// CacheDir returns the directory with the module archives.
+func (service *ModuleService) CacheDir() string {
+	return service.cacheDir
+}
+
+// Stats returns the usage counters of the module cache.
+func (service *ModuleService) Stats() ModuleCacheStats {
+	service.cacheMu.RLock()
+	defer service.cacheMu.RUnlock()
+
+	return ModuleCacheStats{
+		Modules: len(service.archives),
+		Hits:    service.hits.Load(),
+		Misses:  service.misses.Load(),
+	}
+}
+
+// CacheModule returns the cached archive of the given module. If the module is not cached yet, or its archive
+// no longer matches the recorded checksum, the module package is fetched from the source returned by `resolveSource`.
+func (service *ModuleService) CacheModule(ctx context.Context, module *models.Module, resolveSource ModuleSourceResolver) (*ModuleCache, error) {
+	cache := service.moduleCache(module)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.checksum != "" {
+		exists, err := vfs.FileExists(service.FS(), cache.archivePath)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists {
+			service.hits.Add(1)
+			return cache, nil
+		}
+	}
+
+	if err := service.FS().MkdirAll(cache.packageDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	lockfile, err := cache.acquireLockFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cache.releaseLockFile(lockfile) //nolint:errcheck
+
+	// The module may have been cached by another process, or during a previous run.
+	if err := cache.load(); err == nil {
+		service.logger.Debugf("Found cached module %s", module)
+		service.hits.Add(1)
+		service.addArchive(cache)
+
+		return cache, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		service.logger.Warnf("Discarding cached module %s: %v", module, err)
+
+		if err := cache.remove(); err != nil {
+			return nil, err
+		}
+	}
+
+	service.misses.Add(1)
+
+	source, err := resolveSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cache.download(ctx, source); err != nil {
+		return nil, err
+	}
+
+	service.logger.Debugf("Cached module %s, sha256 %s", module, cache.checksum)
+	service.addArchive(cache)
+
+	return cache, nil
+}
+
+// GetModuleArchive returns the path to the module archive with the given name, if it exists.
+func (service *ModuleService) GetModuleArchive(name string) string {
+	service.cacheMu.RLock()
+	cache, ok := service.archives[name]
+	service.cacheMu.RUnlock()
+
+	if !ok {
+		return ""
+	}
+
+	if exists, err := vfs.FileExists(service.FS(), cache.archivePath); err != nil || !exists {
+		return ""
+	}
+
+	return cache.archivePath
+}
+
+// Run implements Service.Run, it only creates the cache directory since modules are cached on request.
+func (service *ModuleService) Run(ctx context.Context) error {
+	if service.cacheDir == "" {
+		return errors.New("module cache directory not specified")
+	}
+
+	if err := service.FS().MkdirAll(service.cacheDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+
+	return nil
+}
+
+func (service *ModuleService) moduleCache(module *models.Module) *ModuleCache {
+	service.cacheMu.Lock()
+	defer service.cacheMu.Unlock()
+
+	key := module.Address() + "/" + module.Version
+
+	if cache, ok := service.moduleCaches[key]; ok {
+		return cache
+	}
+
+	packageDir := filepath.Join(service.cacheDir, filepath.FromSlash(module.Address()), module.Version)
+
+	cache := &ModuleCache{
+		Module:        module,
+		ModuleService: service,
+		packageDir:    packageDir,
+		archivePath:   filepath.Join(packageDir, moduleArchiveName),
+		metadataPath:  filepath.Join(packageDir, moduleMetadataName),
+		lockfilePath:  filepath.Join(packageDir, moduleLockfileName),
+	}
+
+	service.moduleCaches[key] = cache
+
+	return cache
+}
+
+func (service *ModuleService) addArchive(cache *ModuleCache) {
+	service.cacheMu.Lock()
+	defer service.cacheMu.Unlock()
+
+	service.archives[cache.ArchiveName()] = cache
+}
+
+// writeModuleArchive packs the given directory of the real disk into a tar.gz archive written to fsys,
+// skipping `.git` directories, and returns the SHA256 checksum of the archive.
+func writeModuleArchive(fsys vfs.FS, srcDir, dst string) (string, error) {
+	file, err := fsys.Create(dst)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close() //nolint:errcheck
+
+	hash := sha256.New()
+	gzipWriter := gzip.NewWriter(io.MultiWriter(file, hash))
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = filepath.WalkDir(srcDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == srcDir {
+			return nil
+		}
+
+		if entry.IsDir() && entry.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+
+		header.Name = filepath.ToSlash(rel)
+		if entry.IsDir() {
+			header.Name += "/"
+		}
+
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		srcFile, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer srcFile.Close() //nolint:errcheck
+
+		_, err = io.Copy(tarWriter, srcFile)
+
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return "", err
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return "", err
+	}
+
+	if err := file.Sync(); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func fileSHA256(fsys vfs.FS, path string) (string, error) {
+	file, err := fsys.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close() //nolint:errcheck
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,154 @@
+package services_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/models"
+	"github.com/gruntwork-io/terragrunt/internal/tf/cache/services"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/test/helpers"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+)
+
+func TestModuleServiceCachesModule(t *testing.T) {
+	t.Parallel()
+
+	srcDir := helpers.TmpDirWOSymlinks(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "modules", "vpc"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "main.tf"), []byte("# root"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "modules", "vpc", "main.tf"), []byte("# vpc"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, ".git", "config"), []byte("[core]"), 0o644))
+
+	cacheDir := helpers.TmpDirWOSymlinks(t)
+	module := models.ParseModuleDownloadPath("registry.example.com", "acme/vpc/aws/1.0.0/download")
+	require.NotNil(t, module)
+
+	var resolved int
+
+	resolveSource := func(context.Context) (string, error) {
+		resolved++
+		return srcDir + "//modules/vpc", nil
+	}
+
+	service := services.NewModuleService(cacheDir, logger.CreateLogger())
+
+	cache, err := service.CacheModule(t.Context(), module, resolveSource)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resolved)
+	assert.Equal(t, "modules/vpc", cache.Subdir())
+	assert.Equal(t, filepath.Join(cacheDir, "registry.example.com", "acme", "vpc", "aws", "1.0.0", "module.tar.gz"), cache.ArchivePath())
+	assert.Equal(t, fileChecksum(t, cache.ArchivePath()), cache.Checksum())
+	assert.ElementsMatch(t, []string{"main.tf", "modules/", "modules/vpc/", "modules/vpc/main.tf"}, archiveEntries(t, cache.ArchivePath()))
+	assert.Equal(t, cache.ArchivePath(), service.GetModuleArchive(cache.ArchiveName()))
+	assert.Empty(t, service.GetModuleArchive("unknown.tar.gz"))
+
+	_, err = service.CacheModule(t.Context(), module, resolveSource)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resolved, "cached module must not be downloaded again")
+
+	// A new service, e.g. after a restart, reuses the archive from the disk.
+	service = services.NewModuleService(cacheDir, logger.CreateLogger())
+
+	cache, err = service.CacheModule(t.Context(), module, resolveSource)
+	require.NoError(t, err)
+	assert.Equal(t, 1, resolved, "module cached on disk must not be downloaded again")
+	assert.Equal(t, "modules/vpc", cache.Subdir())
+
+	// A corrupted archive is downloaded again.
+	require.NoError(t, os.WriteFile(cache.ArchivePath(), []byte("corrupted"), 0o644))
+
+	service = services.NewModuleService(cacheDir, logger.CreateLogger())
+
+	cache, err = service.CacheModule(t.Context(), module, resolveSource)
+	require.NoError(t, err)
+	assert.Equal(t, 2, resolved, "corrupted module must be downloaded again")
+	assert.Equal(t, fileChecksum(t, cache.ArchivePath()), cache.Checksum())
+
+	stats := service.Stats()
+	assert.Equal(t, 1, stats.Modules)
+	assert.Equal(t, uint64(1), stats.Misses)
+}
+
+func TestModuleServiceLoadsModuleFromFS(t *testing.T) {
+	t.Parallel()
+
+	fs := vfs.NewMemMapFS()
+	cacheDir := "/cache"
+	packageDir := filepath.Join(cacheDir, "registry.example.com", "acme", "vpc", "aws", "1.0.0")
+
+	archive := []byte("archive")
+	sum := sha256.Sum256(archive)
+	checksum := hex.EncodeToString(sum[:])
+
+	require.NoError(t, fs.MkdirAll(packageDir, 0o755))
+	require.NoError(t, vfs.WriteFile(fs, filepath.Join(packageDir, "module.tar.gz"), archive, 0o644))
+	require.NoError(t, vfs.WriteFile(fs, filepath.Join(packageDir, "module.json"),
+		[]byte(`{"source":"git::https://example.com/vpc.git","subdir":"modules/vpc","sha256":"`+checksum+`"}`), 0o644))
+
+	module := models.ParseModuleDownloadPath("registry.example.com", "acme/vpc/aws/1.0.0/download")
+	require.NotNil(t, module)
+
+	service := services.NewModuleService(cacheDir, logger.CreateLogger(), services.WithModuleFS(fs))
+
+	cache, err := service.CacheModule(t.Context(), module, func(context.Context) (string, error) {
+		return "", errors.New("module cached on the filesystem must not be downloaded")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, checksum, cache.Checksum())
+	assert.Equal(t, "modules/vpc", cache.Subdir())
+	assert.Equal(t, cache.ArchivePath(), service.GetModuleArchive(cache.ArchiveName()))
+}
+
+func fileChecksum(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func archiveEntries(t *testing.T, path string) []string {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	require.NoError(t, err)
+
+	var (
+		entries   []string
+		tarReader = tar.NewReader(gzipReader)
+	)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+
+		entries = append(entries, header.Name)
+	}
+
+	return entries
+}
@@ -15,6 +15,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"errors"
@@ -87,6 +88,7 @@ type ProviderCache struct {
 	*models.Provider
 	*ProviderService
 	started            chan struct{}
+	lastUsed           time.Time
 	userProviderDir    string
 	packageDir         string
 	lockfilePath       string
@@ -94,6 +96,7 @@ type ProviderCache struct {
 	signature          []byte
 	documentSHA256Sums []byte
 	requestIDs         []string
+	size               int64
 	archiveCached      bool
 	ready              bool
 	mu                 sync.RWMutex
@@ -235,6 +238,38 @@ func (cache *ProviderCache) getRequestIDs() []string {
 	return result
 }
 
+// removeRequestID removes the given requestID and reports whether the cache is no longer requested by anyone.
+func (cache *ProviderCache) removeRequestID(requestID string) bool {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.requestIDs = slices.DeleteFunc(cache.requestIDs, func(id string) bool { return id == requestID })
+
+	return len(cache.requestIDs) == 0
+}
+
+// touch marks the cache as the most recently used one.
+func (cache *ProviderCache) touch() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.lastUsed = time.Now()
+}
+
+func (cache *ProviderCache) getErr() error {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	return cache.err
+}
+
+func (cache *ProviderCache) setErr(err error) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.err = err
+}
+
 func (cache *ProviderCache) isReady() bool {
 	cache.mu.RLock()
 	defer cache.mu.RUnlock()
@@ -508,9 +543,18 @@ func WithFS(fs vfs.FS) ProviderServiceOption {
 	}
 }
 
+// WithMaxCacheSize sets the maximum total size, in bytes, of the unpacked providers in the cache directory.
+// Once exceeded, the least recently used providers are evicted. Zero means no limit.
+func WithMaxCacheSize(size int64) ProviderServiceOption {
+	return func(ps *ProviderService) {
+		ps.maxCacheSize = size
+	}
+}
+
 type ProviderService struct {
 	logger                log.Logger
 	providerCacheWarmUpCh chan *ProviderCache
+	ready                 chan struct{}
 	credsSource           *cliconfig.CredentialsSource
 
 	// fs is the filesystem for file operations.
@@ -525,8 +569,20 @@ type ProviderService struct {
 	// the user plugins directory, by default: %APPDATA%\terraform.d\plugins on Windows, ~/.terraform.d/plugins on other systems.
 	userCacheDir   string
 	providerCaches ProviderCaches
-	cacheMu        sync.RWMutex
-	cacheReadyMu   sync.RWMutex
+
+	// The provider packages found in the cache directory at startup, which are not requested by any client yet.
+	existingPackages []*existingPackage
+
+	// The maximum total size of the cached providers, zero means no limit.
+	maxCacheSize int64
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+	failures  atomic.Uint64
+
+	cacheMu      sync.RWMutex
+	cacheReadyMu sync.RWMutex
 }
 
 // FS returns the configured filesystem.
@@ -534,6 +590,11 @@ func (service *ProviderService) FS() vfs.FS {
 	return service.fs
 }
 
+// CacheDir returns the directory with the unpacked providers.
+func (service *ProviderService) CacheDir() string {
+	return service.cacheDir
+}
+
 func NewProviderService(
 	cacheDir,
 	userCacheDir string,
@@ -545,6 +606,7 @@ func NewProviderService(
 		cacheDir:              cacheDir,
 		userCacheDir:          userCacheDir,
 		providerCacheWarmUpCh: make(chan *ProviderCache, providerCacheWarmUpChBufferSize),
+		ready:                 make(chan struct{}),
 		credsSource:           credsSource,
 		logger:                l,
 		fs:                    vfs.NewOSFS(),
@@ -559,6 +621,11 @@ func NewProviderService(
 	return service
 }
 
+// Ready returns a channel that is closed once the service is running and accepts caching requests.
+func (service *ProviderService) Ready() <-chan struct{} {
+	return service.ready
+}
+
 func (service *ProviderService) Logger() log.Logger {
 	return service.logger
 }
@@ -584,13 +651,13 @@ func (service *ProviderService) WaitForCacheReady(requestID string) ([]getprovid
 
 	for i, cache := range service.providerCaches {
 		service.logger.Debugf("Cache %d: %s, requestIDs: %v, ready: %v, err: %v",
-			i, cache.Provider, cache.getRequestIDs(), cache.isReady(), cache.err)
+			i, cache.Provider, cache.getRequestIDs(), cache.isReady(), cache.getErr())
 	}
 
 	for _, provider := range caches {
-		if provider.err != nil {
-			errs = append(errs, fmt.Errorf("unable to cache provider: %s, err: %w", provider, provider.err))
-			service.logger.Errorf("Provider cache error for %s: %v", provider, provider.err)
+		if err := provider.getErr(); err != nil {
+			errs = append(errs, fmt.Errorf("unable to cache provider: %s, err: %w", provider, err))
+			service.logger.Errorf("Provider cache error for %s: %v", provider, err)
 		}
 
 		if provider.isReady() {
@@ -615,11 +682,15 @@ func (service *ProviderService) CacheProvider(ctx context.Context, requestID str
 
 	if cache := service.providerCaches.Find(provider); cache != nil {
 		service.logger.Debugf("Found existing cache for provider %s", provider)
+		service.hits.Add(1)
 		cache.addRequestID(requestID)
+		cache.touch()
 
 		return cache
 	}
 
+	service.misses.Add(1)
+
 	packageName := fmt.Sprintf("%s-%s-%s-%s-%s", provider.RegistryName, provider.Namespace, provider.Name, provider.Version, provider.Platform())
 
 	cache := &ProviderCache{
@@ -631,6 +702,7 @@ func (service *ProviderService) CacheProvider(ctx context.Context, requestID str
 		packageDir:      filepath.Join(service.cacheDir, provider.Address(), provider.Version, provider.Platform()),
 		lockfilePath:    filepath.Join(service.tempDir, packageName+".lock"),
 		archivePath:     filepath.Join(service.tempDir, packageName+path.Ext(provider.Filename)),
+		lastUsed:        time.Now(),
 	}
 
 	service.logger.Debugf("Sending provider %s to warm up channel", provider)
@@ -659,6 +731,7 @@ func (service *ProviderService) GetProviderCache(provider *models.Provider) *Pro
 
 	cache := service.providerCaches.Find(provider)
 	if cache != nil && cache.isReady() {
+		cache.touch()
 		return cache
 	}
 
@@ -692,7 +765,22 @@ func (service *ProviderService) Run(ctx context.Context) error {
 
 	errGroup, ctx := errgroup.WithContext(ctx)
 
+	// A long-running service with a size limit must also account for the providers cached before it started.
+	if service.maxCacheSize > 0 {
+		errGroup.Go(func() error {
+			if err := service.scanCacheDir(); err != nil {
+				service.logger.Warnf("Failed to scan the provider cache dir %s: %v", service.cacheDir, err)
+				return nil
+			}
+
+			service.evictProviderCaches()
+
+			return nil
+		})
+	}
+
 	service.logger.Debugf("Provider cache service is ready to process requests")
+	close(service.ready)
 
 	for {
 		select {
@@ -702,6 +790,8 @@ func (service *ProviderService) Run(ctx context.Context) error {
 				err := service.startProviderCaching(ctx, cache)
 				if err == nil {
 					service.logger.Debugf("Successfully started provider caching for %s", cache.Provider)
+					service.evictProviderCaches()
+
 					return nil
 				}
 
@@ -750,13 +840,15 @@ func (service *ProviderService) startProviderCaching(ctx context.Context, cache
 
 	service.logger.Debugf("Acquired lock file for %s, starting warm up", cache.Provider)
 
-	if cache.err = cache.warmUp(ctx); cache.err != nil {
-		service.logger.Errorf("Failed to warm up provider %s: %v", cache.Provider, cache.err)
+	if err := cache.warmUp(ctx); err != nil {
+		cache.setErr(err)
+		service.failures.Add(1)
+		service.logger.Errorf("Failed to warm up provider %s: %v", cache.Provider, err)
 
 		// UnexpectedProviderCachePathError signals that the path holds user
 		// content; RemoveAll here would silently override that contract.
 		var unexpectedPath *UnexpectedProviderCachePathError
-		if !errors.As(cache.err, &unexpectedPath) {
+		if !errors.As(err, &unexpectedPath) {
 			if err := service.FS().RemoveAll(cache.packageDir); err != nil {
 				service.logger.Warnf("Failed to clean up package dir %q: %v", cache.packageDir, err)
 			}
@@ -768,9 +860,10 @@ func (service *ProviderService) startProviderCaching(ctx context.Context, cache
 			}
 		}
 
-		return cache.err
+		return err
 	}
 
+	cache.setSize(service.packageSize(cache.packageDir))
 	cache.setReady(true)
 
 	service.logger.Debugf("Successfully cached provider: %s", cache.Provider)
@@ -63,6 +63,39 @@ func UpdateLockfile(ctx context.Context, workingDir string, providers []Provider
 	return nil
 }
 
+// LockedProviderVersions returns the provider versions pinned in the dependency lock file of `workingDir`,
+// keyed by provider address. If `.terraform.lock.hcl` does not exist, an empty map is returned.
+func LockedProviderVersions(workingDir string) (map[string]string, error) {
+	filename := filepath.Join(workingDir, tf.TerraformLockFile)
+	versions := make(map[string]string)
+
+	if !util.FileExists(filename) {
+		return versions, nil
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	file, diags := hclwrite.ParseConfig(content, filename, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	for _, block := range file.Body().Blocks() {
+		if block.Type() != "provider" || len(block.Labels()) != 1 {
+			continue
+		}
+
+		if versionAttr := block.Body().GetAttribute("version"); versionAttr != nil {
+			versions[block.Labels()[0]] = getAttributeValueAsUnquotedString(versionAttr)
+		}
+	}
+
+	return versions, nil
+}
+
 func updateLockfile(ctx context.Context, file *hclwrite.File, providers []Provider) error {
 	sort.Slice(providers, func(i, j int) bool {
 		return providers[i].Address() < providers[j].Address()
@@ -402,3 +402,36 @@ provider "registry.terraform.io/hashicorp/aws" {
 	assert.NotContains(t, string(actualLockfile), `constraints = ">= 3.0.0, < 7.0.0"`,
 		"Module-only constraints should not replace the aggregated constraints")
 }
+
+func TestLockedProviderVersions(t *testing.T) {
+	t.Parallel()
+
+	workingDir := helpers.TmpDirWOSymlinks(t)
+
+	versions, err := getproviders.LockedProviderVersions(workingDir)
+	require.NoError(t, err)
+	assert.Empty(t, versions)
+
+	lockfile := `
+provider "registry.terraform.io/hashicorp/aws" {
+  version     = "5.37.0"
+  constraints = ">= 5.0.0"
+  hashes = [
+    "h1:existing-hash=",
+  ]
+}
+
+provider "registry.opentofu.org/hashicorp/null" {
+  version = "3.2.2"
+}
+`
+	err = os.WriteFile(filepath.Join(workingDir, ".terraform.lock.hcl"), []byte(lockfile), 0644)
+	require.NoError(t, err)
+
+	versions, err = getproviders.LockedProviderVersions(workingDir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"registry.terraform.io/hashicorp/aws":  "5.37.0",
+		"registry.opentofu.org/hashicorp/null": "3.2.2",
+	}, versions)
+}
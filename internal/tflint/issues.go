@@ -0,0 +1,112 @@
+package tflint
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/gruntwork-io/terragrunt/internal/shell"
+	"github.com/gruntwork-io/terragrunt/internal/vfs"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+// Issue is an issue found by tflint, as reported by its JSON output format.
+type Issue struct {
+	Rule    IssueRule  `json:"rule"`
+	Message string     `json:"message"`
+	Range   IssueRange `json:"range"`
+}
+
+// IssueRule is the rule an issue was found by.
+type IssueRule struct {
+	Name     string `json:"name"`
+	Severity string `json:"severity"`
+	Link     string `json:"link"`
+}
+
+// IssueRange is the range of the file an issue was found in.
+type IssueRange struct {
+	Filename string   `json:"filename"`
+	Start    IssuePos `json:"start"`
+	End      IssuePos `json:"end"`
+}
+
+// IssuePos is a position in a file.
+type IssuePos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// issuesOutput is the output of tflint in JSON format.
+type issuesOutput struct {
+	Issues []Issue `json:"issues"`
+}
+
+// collectIssues runs tflint again with the given args to collect the issues it found in JSON format, so they can
+// be reported along with their locations. Paths of the files in the issues are made absolute.
+//
+// Collecting issues is best effort: any failure is logged and results in no issues.
+func collectIssues(ctx context.Context, l log.Logger, v Venv, opts *TFLintOptions, args []string) []Issue {
+	jsonArgs := withoutFormatArgs(args[1:])
+	// --force makes tflint exit successfully despite the issues it found.
+	jsonArgs = append(jsonArgs, "--format=json", "--force")
+
+	l.Debugf("Collecting tflint issues with args %v", jsonArgs)
+
+	out, err := shell.RunCommandWithOutput(ctx, l, v.Exec, opts.ShellOptions, opts.RootWorkingDir, true, false,
+		args[0], jsonArgs...)
+	if err != nil {
+		l.Debugf("Failed to collect tflint issues: %v", err)
+		return nil
+	}
+
+	var output issuesOutput
+	if err := json.Unmarshal(out.Stdout.Bytes(), &output); err != nil {
+		l.Debugf("Failed to parse tflint issues: %v", err)
+		return nil
+	}
+
+	for i := range output.Issues {
+		output.Issues[i].Range.Filename = issueFilePath(v.FS, opts, output.Issues[i].Range.Filename)
+	}
+
+	return output.Issues
+}
+
+// withoutFormatArgs returns the args without the output format flags, along with the value that follows
+// `-f` or `--format` when it is given as a separate argument.
+func withoutFormatArgs(args []string) []string {
+	result := make([]string, 0, len(args))
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		switch {
+		case arg == "-f" || arg == "--format":
+			// Skip the value of the flag too.
+			i++
+		case strings.HasPrefix(arg, "-f=") || strings.HasPrefix(arg, "--format="):
+		default:
+			result = append(result, arg)
+		}
+	}
+
+	return result
+}
+
+// issueFilePath returns the absolute path of a file tflint reported an issue in. Depending on its version, tflint
+// reports files relative to the directory it runs in or to the directory it lints with --chdir.
+func issueFilePath(fs vfs.FS, opts *TFLintOptions, filename string) string {
+	if filename == "" || filepath.IsAbs(filename) {
+		return filename
+	}
+
+	path := filepath.Join(opts.RootWorkingDir, filename)
+
+	if exists, err := vfs.FileExists(fs, path); err != nil || !exists {
+		return filepath.Join(opts.WorkingDir, filename)
+	}
+
+	return path
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gruntwork-io/terragrunt/internal/runner/runcfg"
 	"github.com/gruntwork-io/terragrunt/internal/shell"
+	"github.com/gruntwork-io/terragrunt/internal/vexec"
 	"github.com/gruntwork-io/terragrunt/internal/vfs"
 	"github.com/gruntwork-io/terragrunt/internal/writer"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -35,6 +36,9 @@ const (
 	argVarPrefix     = "-var="
 	argVarFilePrefix = "-var-file="
 	tfExternalTFLint = "--terragrunt-external-tflint"
+
+	// issuesFoundExitCode is the exit code of tflint when it ran successfully and found issues.
+	issuesFoundExitCode = 2
 )
 
 // RunTflintWithOpts runs tflint with the given options and returns an error if there are any issues.
@@ -106,7 +110,13 @@ func RunTflintWithOpts(
 	_, err = shell.RunCommandWithOutput(ctx, l, v.Exec, opts.ShellOptions, opts.RootWorkingDir, false, false,
 		args[0], args[1:]...)
 	if err != nil {
-		return ErrorRunningTflint{Args: args, Err: err}
+		runErr := ErrorRunningTflint{Args: args, Err: err}
+
+		if vexec.ExitCode(err) == issuesFoundExitCode {
+			runErr.Issues = collectIssues(ctx, l, v, opts, args)
+		}
+
+		return runErr
 	}
 
 	l.Info("Tflint has run successfully. No issues found.")
@@ -136,6 +146,8 @@ func InputsToTflintVar(inputs map[string]any) ([]string, error) {
 type ErrorRunningTflint struct {
 	Err  error
 	Args []string
+	// Issues are the issues tflint found, if it ran successfully and found any.
+	Issues []Issue
 }
 
 func (err ErrorRunningTflint) Error() string {
@@ -434,6 +434,94 @@ func TestRunTflintWithOpts_LintFailureWraps(t *testing.T) {
 	assert.NotContains(t, wrapped.Args, "--init")
 }
 
+func TestRunTflintWithOpts_CollectsIssues(t *testing.T) {
+	t.Parallel()
+
+	fs := vfs.NewMemMapFS()
+	require.NoError(t, vfs.WriteFile(fs, "/work/unit/.tflint.hcl", []byte("config {}"), 0o644))
+	require.NoError(t, vfs.WriteFile(fs, "/work/unit/main.tf", []byte(`variable "unused" {}`), 0o644))
+
+	var jsonArgs []string
+
+	exec := vexec.NewMemExec(func(_ context.Context, inv vexec.Invocation) vexec.Result {
+		switch {
+		case slices.Contains(inv.Args, "--init"):
+			return vexec.Result{}
+		case slices.Contains(inv.Args, "--format=json"):
+			jsonArgs = slices.Clone(inv.Args)
+
+			return vexec.Result{Stdout: []byte(`{"issues":[{"rule":{"name":"terraform_unused_declarations",` +
+				`"severity":"warning","link":"https://example.com/rule"},"message":"variable \"unused\" is declared but not used",` +
+				`"range":{"filename":"unit/main.tf","start":{"line":1,"column":1},"end":{"line":1,"column":18}}}],"errors":[]}`)}
+		}
+
+		return vexec.Result{ExitCode: 2}
+	})
+
+	err := runWithOpts(t, fs, exec, &runcfg.Hook{
+		Name:     "tflint",
+		Commands: []string{"plan"},
+		Execute:  []string{"tflint", "--format=compact"},
+	}, &runcfg.RunConfig{})
+
+	var wrapped tflint.ErrorRunningTflint
+
+	require.ErrorAs(t, err, &wrapped)
+	assert.NotContains(t, jsonArgs, "--format=compact")
+	assert.Contains(t, jsonArgs, "--force")
+
+	require.Len(t, wrapped.Issues, 1)
+
+	issue := wrapped.Issues[0]
+	assert.Equal(t, "terraform_unused_declarations", issue.Rule.Name)
+	assert.Equal(t, "warning", issue.Rule.Severity)
+	assert.Equal(t, "/work/unit/main.tf", issue.Range.Filename)
+	assert.Equal(t, tflint.IssuePos{Line: 1, Column: 18}, issue.Range.End)
+}
+
+func TestRunTflintWithOpts_CollectsIssuesWithoutFormatValue(t *testing.T) {
+	t.Parallel()
+
+	for _, execute := range [][]string{
+		{"tflint", "-f", "compact", "--minimum-failure-severity=warning"},
+		{"tflint", "--format", "compact", "--minimum-failure-severity=warning"},
+	} {
+		t.Run(execute[1], func(t *testing.T) {
+			t.Parallel()
+
+			fs := vfs.NewMemMapFS()
+			require.NoError(t, vfs.WriteFile(fs, "/work/unit/.tflint.hcl", []byte("config {}"), 0o644))
+
+			var jsonArgs []string
+
+			exec := vexec.NewMemExec(func(_ context.Context, inv vexec.Invocation) vexec.Result {
+				switch {
+				case slices.Contains(inv.Args, "--init"):
+					return vexec.Result{}
+				case slices.Contains(inv.Args, "--format=json"):
+					jsonArgs = slices.Clone(inv.Args)
+
+					return vexec.Result{Stdout: []byte(`{"issues":[],"errors":[]}`)}
+				}
+
+				return vexec.Result{ExitCode: 2}
+			})
+
+			err := runWithOpts(t, fs, exec, &runcfg.Hook{
+				Name:     "tflint",
+				Commands: []string{"plan"},
+				Execute:  execute,
+			}, &runcfg.RunConfig{})
+			require.Error(t, err)
+			require.NotEmpty(t, jsonArgs)
+
+			assert.NotContains(t, jsonArgs, "compact")
+			assert.NotContains(t, jsonArgs, execute[1])
+			assert.Contains(t, jsonArgs, "--minimum-failure-severity=warning")
+		})
+	}
+}
+
 func TestRunTflintWithOpts_MissingConfigSurfacesNotFound(t *testing.T) {
 	t.Parallel()
 
@@ -80,7 +80,7 @@ func (cfg *CatalogConfig) normalize(configPath string) {
 // otherwise generate a stub child `terragrunt.hcl` in memory with an `include` to pull in the one we found.
 // Unlike the "ReadTerragruntConfig" func, it ignores any configuration errors not related to the "catalog" block.
 func ReadCatalogConfig(parentCtx context.Context, l log.Logger, pctx *ParsingContext) (*CatalogConfig, error) {
-	configPath, configString, err := findCatalogConfig(parentCtx, l, pctx)
+	configPath, configString, err := findRootConfigWithBlock(parentCtx, l, pctx, catalogBlockReg)
 	if err != nil || configPath == "" {
 		return nil, err
 	}
@@ -98,11 +98,19 @@ func ReadCatalogConfig(parentCtx context.Context, l log.Logger, pctx *ParsingCon
 	return config.Catalog, nil
 }
 
-func findCatalogConfig(ctx context.Context, l log.Logger, outerPctx *ParsingContext) (string, string, error) {
+// findRootConfigWithBlock finds the root configuration in the parent directories, along with its contents.
+// If no root configuration with an `include` block is found, it falls back to the nearest configuration
+// containing the block matched by blockReg, returning a stub child configuration including it.
+func findRootConfigWithBlock(
+	ctx context.Context,
+	l log.Logger,
+	outerPctx *ParsingContext,
+	blockReg *regexp.Regexp,
+) (string, string, error) {
 	var (
-		configPath        = filepath.Join(filepath.Dir(outerPctx.TerragruntConfigPath), outerPctx.ScaffoldRootFileName)
-		configName        = outerPctx.ScaffoldRootFileName
-		catalogConfigPath string
+		configPath      = filepath.Join(filepath.Dir(outerPctx.TerragruntConfigPath), outerPctx.ScaffoldRootFileName)
+		configName      = outerPctx.ScaffoldRootFileName
+		blockConfigPath string
 	)
 
 	for {
@@ -138,19 +146,19 @@ func findCatalogConfig(ctx context.Context, l log.Logger, outerPctx *ParsingCont
 			return newConfigPath, configString, nil
 		}
 
-		// if the config contains a `catalog` block, save the path in case the root config is not found
-		if catalogBlockReg.MatchString(configString) {
-			catalogConfigPath = newConfigPath
+		// if the config contains the block, save the path in case the root config is not found
+		if blockReg.MatchString(configString) {
+			blockConfigPath = newConfigPath
 		}
 
 		configPath = filepath.Dir(newConfigPath)
 	}
 
-	// if the config with the `catalog` block is found, create the root config with `include{ find_in_parent_folders() }`
-	// and the path one directory deeper in order for `find_in_parent_folders` can find the catalog configuration.
-	if catalogConfigPath != "" {
+	// if the config with the block is found, create the root config with `include{ find_in_parent_folders() }`
+	// and the path one directory deeper in order for `find_in_parent_folders` can find the configuration.
+	if blockConfigPath != "" {
 		configString := fmt.Sprintf(rootConfigFmt, configName)
-		configPath = filepath.Join(filepath.Dir(catalogConfigPath), util.UniqueID(), configName)
+		configPath = filepath.Join(filepath.Dir(blockConfigPath), util.UniqueID(), configName)
 
 		return configPath, configString, nil
 	}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/gruntwork-io/terragrunt/pkg/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+var concurrencyGroupBlockReg = regexp.MustCompile(fmt.Sprintf(hclBlockRegExprFmt, MetadataConcurrencyGroup))
+
+// ConcurrencyConfig configures how a unit is scheduled when running in a queue, e.g.
+//
+//	concurrency {
+//	  weight = 3
+//	  groups = ["aws-eks"]
+//	}
+type ConcurrencyConfig struct {
+	// Weight is the number of concurrency slots the unit occupies while running.
+	Weight *int `hcl:"weight,attr" cty:"weight"`
+	// Groups are the names of the concurrency groups the unit belongs to.
+	Groups []string `hcl:"groups,optional" cty:"groups"`
+}
+
+// Clone returns a copy of the ConcurrencyConfig used in deep copy.
+func (c *ConcurrencyConfig) Clone() *ConcurrencyConfig {
+	return &ConcurrencyConfig{
+		Weight: c.Weight,
+		Groups: append([]string(nil), c.Groups...),
+	}
+}
+
+// Merge merges the ConcurrencyConfig with another ConcurrencyConfig.
+func (c *ConcurrencyConfig) Merge(concurrency *ConcurrencyConfig) {
+	if concurrency.Weight != nil {
+		c.Weight = concurrency.Weight
+	}
+
+	if len(concurrency.Groups) > 0 {
+		c.Groups = concurrency.Groups
+	}
+}
+
+// GetWeight returns the weight of the unit, defaulting to 1.
+func (c *ConcurrencyConfig) GetWeight() int {
+	if c == nil || c.Weight == nil {
+		return 1
+	}
+
+	return *c.Weight
+}
+
+// GetGroups returns the names of the concurrency groups the unit belongs to.
+func (c *ConcurrencyConfig) GetGroups() []string {
+	if c == nil {
+		return nil
+	}
+
+	return c.Groups
+}
+
+// Validate checks that the weight is positive.
+func (c *ConcurrencyConfig) Validate() error {
+	if c.Weight != nil && *c.Weight < 1 {
+		return InvalidConcurrencyWeightError{Weight: *c.Weight}
+	}
+
+	return nil
+}
+
+// ConcurrencyGroupConfig limits how many units of a named concurrency group run at once.
+// It is declared in the root configuration, e.g.
+//
+//	concurrency_group "aws-eks" {
+//	  max_concurrency = 2
+//	}
+type ConcurrencyGroupConfig struct {
+	Name           string `hcl:",label" cty:"name"`
+	MaxConcurrency int    `hcl:"max_concurrency,attr" cty:"max_concurrency"`
+}
+
+// ConcurrencyGroupConfigs is a list of named concurrency groups.
+type ConcurrencyGroupConfigs []*ConcurrencyGroupConfig
+
+// Limits returns the maximum concurrency of the groups by their names.
+func (groups ConcurrencyGroupConfigs) Limits() map[string]int {
+	limits := make(map[string]int, len(groups))
+
+	for _, group := range groups {
+		limits[group.Name] = group.MaxConcurrency
+	}
+
+	return limits
+}
+
+// ReadConcurrencyGroupConfigs reads the `concurrency_group` blocks from the root configuration in the parent directories,
+// the same way ReadCatalogConfig finds the `catalog` block.
+// Any configuration errors not related to the `concurrency_group` blocks are ignored.
+func ReadConcurrencyGroupConfigs(parentCtx context.Context, l log.Logger, pctx *ParsingContext) (ConcurrencyGroupConfigs, error) {
+	configPath, configString, err := findRootConfigWithBlock(parentCtx, l, pctx, concurrencyGroupBlockReg)
+	if err != nil || configPath == "" {
+		return nil, err
+	}
+
+	pctx = pctx.Clone()
+	pctx.TerragruntConfigPath = configPath
+	pctx.ParserOptions = append(pctx.ParserOptions, hclparse.WithHaltOnErrorOnlyForBlocks([]string{MetadataConcurrencyGroup}))
+	pctx.ConvertToTerragruntConfigFunc = convertToTerragruntConcurrencyGroupConfig
+
+	config, err := ParseConfigString(parentCtx, pctx, l, configPath, configString, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return config.ConcurrencyGroups, nil
+}
+
+func convertToTerragruntConcurrencyGroupConfig(
+	_ context.Context,
+	_ *ParsingContext,
+	configPath string,
+	terragruntConfigFromFile *terragruntConfigFile,
+) (*TerragruntConfig, error) {
+	terragruntConfig := &TerragruntConfig{}
+
+	if err := validateConcurrencyGroupConfigs(terragruntConfigFromFile.ConcurrencyGroups); err != nil {
+		return nil, err
+	}
+
+	if len(terragruntConfigFromFile.ConcurrencyGroups) > 0 {
+		terragruntConfig.ConcurrencyGroups = terragruntConfigFromFile.ConcurrencyGroups
+		terragruntConfig.SetFieldMetadata(MetadataConcurrencyGroup, map[string]any{FoundInFile: configPath})
+	}
+
+	return terragruntConfig, nil
+}
+
+// validateConcurrencyGroupConfigs checks that group names are unique and limits are positive.
+func validateConcurrencyGroupConfigs(groups ConcurrencyGroupConfigs) error {
+	var duplicates []string
+
+	seen := make(map[string]struct{}, len(groups))
+
+	for _, group := range groups {
+		if group.MaxConcurrency < 1 {
+			return InvalidConcurrencyGroupLimitError{Name: group.Name, MaxConcurrency: group.MaxConcurrency}
+		}
+
+		if _, ok := seen[group.Name]; ok {
+			duplicates = append(duplicates, group.Name)
+
+			continue
+		}
+
+		seen[group.Name] = struct{}{}
+	}
+
+	if len(duplicates) > 0 {
+		return DuplicatedConcurrencyGroupBlocksError{BlockName: duplicates}
+	}
+
+	return nil
+}
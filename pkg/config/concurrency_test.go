@@ -0,0 +1,170 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartialParseConcurrencyBlock(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+concurrency {
+  weight = 3
+  groups = ["aws-eks"]
+}
+`
+
+	l := logger.CreateLogger()
+
+	ctx, pctx := newTestParsingContext(t, config.DefaultTerragruntConfigPath)
+	pctx = pctx.WithDecodeList(config.ConcurrencyBlock)
+	terragruntConfig, err := config.PartialParseConfigString(ctx, pctx, l, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, terragruntConfig.Concurrency)
+	assert.Equal(t, 3, terragruntConfig.Concurrency.GetWeight())
+	assert.Equal(t, []string{"aws-eks"}, terragruntConfig.Concurrency.GetGroups())
+}
+
+func TestPartialParseConcurrencyBlockInvalidWeight(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+concurrency {
+  weight = 0
+}
+`
+
+	l := logger.CreateLogger()
+
+	ctx, pctx := newTestParsingContext(t, config.DefaultTerragruntConfigPath)
+	pctx = pctx.WithDecodeList(config.ConcurrencyBlock)
+	_, err := config.PartialParseConfigString(ctx, pctx, l, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.ErrorAs(t, err, &config.InvalidConcurrencyWeightError{})
+}
+
+func TestPartialParseConcurrencyBlockInheritedFromInclude(t *testing.T) {
+	t.Parallel()
+
+	rootDir := t.TempDir()
+	unitDir := filepath.Join(rootDir, "eks")
+	require.NoError(t, os.MkdirAll(unitDir, 0755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "root.hcl"), []byte(`
+concurrency {
+  groups = ["aws-eks"]
+}
+`), 0644))
+
+	unitConfigPath := filepath.Join(unitDir, config.DefaultTerragruntConfigPath)
+	require.NoError(t, os.WriteFile(unitConfigPath, []byte(`
+include "root" {
+  path           = find_in_parent_folders("root.hcl")
+  merge_strategy = "deep"
+}
+
+concurrency {
+  weight = 2
+}
+`), 0644))
+
+	l := logger.CreateLogger()
+
+	ctx, pctx := newTestParsingContext(t, unitConfigPath)
+	pctx = pctx.WithDecodeList(config.ConcurrencyBlock)
+	terragruntConfig, err := config.PartialParseConfigFile(ctx, pctx, l, unitConfigPath, nil)
+	require.NoError(t, err)
+
+	require.NotNil(t, terragruntConfig.Concurrency)
+	assert.Equal(t, 2, terragruntConfig.Concurrency.GetWeight())
+	assert.Equal(t, []string{"aws-eks"}, terragruntConfig.Concurrency.GetGroups())
+}
+
+func TestReadConcurrencyGroupConfigs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		expected    map[string]int
+		name        string
+		rootConfig  string
+		expectedErr string
+	}{
+		{
+			name: "concurrency groups",
+			rootConfig: `
+concurrency_group "aws-eks" {
+  max_concurrency = 2
+}
+
+concurrency_group "data-pipelines" {
+  max_concurrency = 1
+}
+`,
+			expected: map[string]int{"aws-eks": 2, "data-pipelines": 1},
+		},
+		{
+			name: "no concurrency groups",
+			rootConfig: `
+locals {
+  region = "us-east-1"
+}
+`,
+			expected: map[string]int{},
+		},
+		{
+			name: "duplicated concurrency groups",
+			rootConfig: `
+concurrency_group "aws-eks" {
+  max_concurrency = 2
+}
+
+concurrency_group "aws-eks" {
+  max_concurrency = 1
+}
+`,
+			expectedErr: config.DuplicatedConcurrencyGroupBlocksError{BlockName: []string{"aws-eks"}}.Error(),
+		},
+		{
+			name: "invalid limit",
+			rootConfig: `
+concurrency_group "aws-eks" {
+  max_concurrency = 0
+}
+`,
+			expectedErr: config.InvalidConcurrencyGroupLimitError{Name: "aws-eks"}.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rootDir := t.TempDir()
+			unitDir := filepath.Join(rootDir, "eks")
+			require.NoError(t, os.MkdirAll(unitDir, 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(rootDir, "root.hcl"), []byte(tc.rootConfig), 0644))
+
+			l := logger.CreateLogger()
+			_, pctx := newTestParsingContext(t, filepath.Join(unitDir, config.DefaultTerragruntConfigPath))
+			pctx.ScaffoldRootFileName = "root.hcl"
+
+			groups, err := config.ReadConcurrencyGroupConfigs(t.Context(), l, pctx)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, groups.Limits())
+		})
+	}
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/experiment"
 	"github.com/gruntwork-io/terragrunt/internal/iam"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/azuread"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/googleiam"
 	"github.com/gruntwork-io/terragrunt/internal/strict"
 	"github.com/gruntwork-io/terragrunt/internal/strict/controls"
 
@@ -74,18 +76,29 @@ const (
 	MetadataIamAssumeRoleDuration       = "iam_assume_role_duration"
 	MetadataIamAssumeRoleSessionName    = "iam_assume_role_session_name"
 	MetadataIamWebIdentityToken         = "iam_web_identity_token"
+	MetadataImpersonateServiceAccount   = "impersonate_service_account"
+	MetadataGCPWorkloadIdentityProvider = "gcp_workload_identity_provider"
+	MetadataGCPWebIdentityToken         = "gcp_web_identity_token"
+	MetadataAzureTenantID               = "azure_tenant_id"
+	MetadataAzureClientID               = "azure_client_id"
+	MetadataAzureFederatedToken         = "azure_federated_token"
 	MetadataInputs                      = "inputs"
 	MetadataLocals                      = "locals"
 	MetadataLocal                       = "local"
 	MetadataCatalog                     = "catalog"
+	MetadataFilter                      = "filter"
+	MetadataConcurrency                 = "concurrency"
+	MetadataConcurrencyGroup            = "concurrency_group"
 	MetadataEngine                      = "engine"
 	MetadataGenerateConfigs             = "generate"
 	MetadataInclude                     = "include"
 	MetadataFeatureFlag                 = "feature"
 	MetadataExclude                     = "exclude"
 	MetadataErrors                      = "errors"
+	MetadataPolicy                      = "policy"
 	MetadataRetry                       = "retry"
 	MetadataIgnore                      = "ignore"
+	MetadataExplain                     = "explain"
 	MetadataValues                      = "values"
 	MetadataStack                       = "stack"
 	MetadataUnit                        = "unit"
@@ -150,14 +163,24 @@ type TerragruntConfig struct {
 	RemoteState                 *remotestate.RemoteState
 	Dependencies                *ModuleDependencies
 	Exclude                     *ExcludeConfig
+	Concurrency                 *ConcurrencyConfig
 	PreventDestroy              *bool
 	GenerateConfigs             map[string]codegen.GenerateConfig
 	IamAssumeRoleDuration       *int64
 	Inputs                      map[string]any
 	Engine                      *EngineConfig
 	Catalog                     *CatalogConfig
+	Filters                     FilterConfigs
+	ConcurrencyGroups           ConcurrencyGroupConfigs
+	Policies                    PolicyConfigs
 	IamWebIdentityToken         string
 	IamAssumeRoleSessionName    string
+	ImpersonateServiceAccount   string
+	GCPWorkloadIdentityProvider string
+	GCPWebIdentityToken         string
+	AzureTenantID               string
+	AzureClientID               string
+	AzureFederatedToken         string
 	IamRole                     string
 	DownloadDir                 string
 	TerragruntVersionConstraint string
@@ -218,6 +241,26 @@ func (cfg *TerragruntConfig) GetIAMRoleOptions() iam.RoleOptions {
 	return configIAMRoleOptions
 }
 
+// GetGCPCredentialsOptions is a helper function that converts the Terragrunt config GCP attributes to
+// googleiam.Options struct.
+func (cfg *TerragruntConfig) GetGCPCredentialsOptions() googleiam.Options {
+	return googleiam.Options{
+		ImpersonateServiceAccount: cfg.ImpersonateServiceAccount,
+		WorkloadIdentityProvider:  cfg.GCPWorkloadIdentityProvider,
+		WebIdentityToken:          cfg.GCPWebIdentityToken,
+	}
+}
+
+// GetAzureCredentialsOptions is a helper function that converts the Terragrunt config Azure attributes to
+// azuread.Options struct.
+func (cfg *TerragruntConfig) GetAzureCredentialsOptions() azuread.Options {
+	return azuread.Options{
+		TenantID:       cfg.AzureTenantID,
+		ClientID:       cfg.AzureClientID,
+		FederatedToken: cfg.AzureFederatedToken,
+	}
+}
+
 // WriteTo writes the terragrunt config to a writer
 func (cfg *TerragruntConfig) WriteTo(w io.Writer) (int64, error) {
 	cfgAsCty, err := TerragruntConfigAsCty(cfg)
@@ -574,9 +617,98 @@ func (cfg *TerragruntConfig) WriteTo(w io.Writer) (int64, error) {
 			}
 		}
 
+		// Handle explain blocks
+		for _, explainConfig := range cfg.Errors.Explain {
+			explainBlock := hclwrite.NewBlock(MetadataExplain, []string{explainConfig.Label})
+			explainBody := explainBlock.Body()
+
+			if len(explainConfig.ExplainableErrors) > 0 {
+				explainableErrors := make([]cty.Value, len(explainConfig.ExplainableErrors))
+
+				for i, err := range explainConfig.ExplainableErrors {
+					explainableErrors[i] = cty.StringVal(err)
+				}
+
+				explainBody.SetAttributeValue("explainable_errors", cty.ListVal(explainableErrors))
+			}
+
+			explainBody.SetAttributeValue("message", cty.StringVal(explainConfig.Message))
+
+			if explainConfig.Command != "" {
+				explainBody.SetAttributeValue("command", cty.StringVal(explainConfig.Command))
+			}
+
+			errorsBody.AppendBlock(explainBlock)
+		}
+
 		rootBody.AppendBlock(errorsBlock)
 	}
 
+	// Handle concurrency block
+	if cfg.Concurrency != nil {
+		concurrencyBlock := hclwrite.NewBlock(MetadataConcurrency, nil)
+		concurrencyBody := concurrencyBlock.Body()
+		concurrencyAsCty := cfgAsCty.GetAttr(MetadataConcurrency)
+
+		if cfg.Concurrency.Weight != nil {
+			concurrencyBody.SetAttributeValue("weight", concurrencyAsCty.GetAttr("weight"))
+		}
+
+		if len(cfg.Concurrency.Groups) > 0 {
+			concurrencyBody.SetAttributeValue("groups", concurrencyAsCty.GetAttr("groups"))
+		}
+
+		rootBody.AppendBlock(concurrencyBlock)
+	}
+
+	// Handle policy blocks
+	for _, policy := range cfg.Policies {
+		policyBlock := hclwrite.NewBlock(MetadataPolicy, []string{policy.Name})
+		policyBody := policyBlock.Body()
+		policyAsCty := cfgAsCty.GetAttr(MetadataPolicy).GetAttr(policy.Name)
+
+		if len(policy.DenyActions) > 0 {
+			policyBody.SetAttributeValue("deny_actions", policyAsCty.GetAttr("deny_actions"))
+		}
+
+		if len(policy.ResourceTypes) > 0 {
+			policyBody.SetAttributeValue("resource_types", policyAsCty.GetAttr("resource_types"))
+		}
+
+		if policy.MaxReplacements != nil {
+			policyBody.SetAttributeValue("max_replacements", policyAsCty.GetAttr("max_replacements"))
+		}
+
+		if policy.MaxDestroys != nil {
+			policyBody.SetAttributeValue("max_destroys", policyAsCty.GetAttr("max_destroys"))
+		}
+
+		if policy.Message != nil {
+			policyBody.SetAttributeValue("message", policyAsCty.GetAttr("message"))
+		}
+
+		if policy.ChangeWindow != nil {
+			changeWindowBlock := hclwrite.NewBlock("change_window", nil)
+			changeWindowBody := changeWindowBlock.Body()
+			changeWindowAsCty := policyAsCty.GetAttr("change_window")
+
+			if len(policy.ChangeWindow.Days) > 0 {
+				changeWindowBody.SetAttributeValue("days", changeWindowAsCty.GetAttr("days"))
+			}
+
+			changeWindowBody.SetAttributeValue("start", changeWindowAsCty.GetAttr("start"))
+			changeWindowBody.SetAttributeValue("end", changeWindowAsCty.GetAttr("end"))
+
+			if policy.ChangeWindow.Timezone != nil {
+				changeWindowBody.SetAttributeValue("timezone", changeWindowAsCty.GetAttr("timezone"))
+			}
+
+			policyBody.AppendBlock(changeWindowBlock)
+		}
+
+		rootBody.AppendBlock(policyBlock)
+	}
+
 	// Handle catalog block
 	if cfg.Catalog != nil {
 		catalogBlock := hclwrite.NewBlock("catalog", nil)
@@ -645,13 +777,15 @@ func (cfg *TerragruntConfig) WriteTo(w io.Writer) (int64, error) {
 // terragruntConfigFile represents the configuration supported in a Terragrunt configuration file (i.e.
 // terragrunt.hcl)
 type terragruntConfigFile struct {
-	Catalog                     *CatalogConfig   `hcl:"catalog,block"`
-	Engine                      *EngineConfig    `hcl:"engine,block"`
-	Terraform                   *TerraformConfig `hcl:"terraform,block"`
-	TerraformBinary             *string          `hcl:"terraform_binary,attr"`
-	TerraformVersionConstraint  *string          `hcl:"terraform_version_constraint,attr"`
-	TerragruntVersionConstraint *string          `hcl:"terragrunt_version_constraint,attr"`
-	Inputs                      *cty.Value       `hcl:"inputs,attr"`
+	Catalog                     *CatalogConfig            `hcl:"catalog,block"`
+	Filters                     []*FilterConfig           `hcl:"filter,block"`
+	ConcurrencyGroups           []*ConcurrencyGroupConfig `hcl:"concurrency_group,block"`
+	Engine                      *EngineConfig             `hcl:"engine,block"`
+	Terraform                   *TerraformConfig          `hcl:"terraform,block"`
+	TerraformBinary             *string                   `hcl:"terraform_binary,attr"`
+	TerraformVersionConstraint  *string                   `hcl:"terraform_version_constraint,attr"`
+	TerragruntVersionConstraint *string                   `hcl:"terragrunt_version_constraint,attr"`
+	Inputs                      *cty.Value                `hcl:"inputs,attr"`
 
 	// We allow users to configure remote state (backend) via blocks:
 	//
@@ -676,10 +810,20 @@ type terragruntConfigFile struct {
 	IamAssumeRoleDuration    *int64              `hcl:"iam_assume_role_duration,attr"`
 	IamAssumeRoleSessionName *string             `hcl:"iam_assume_role_session_name,attr"`
 	IamWebIdentityToken      *string             `hcl:"iam_web_identity_token,attr"`
-	TerragruntDependencies   []Dependency        `hcl:"dependency,block"`
-	FeatureFlags             []*FeatureFlag      `hcl:"feature,block"`
-	Exclude                  *ExcludeConfig      `hcl:"exclude,block"`
-	Errors                   *ErrorsConfig       `hcl:"errors,block"`
+
+	ImpersonateServiceAccount   *string `hcl:"impersonate_service_account,attr"`
+	GCPWorkloadIdentityProvider *string `hcl:"gcp_workload_identity_provider,attr"`
+	GCPWebIdentityToken         *string `hcl:"gcp_web_identity_token,attr"`
+	AzureTenantID               *string `hcl:"azure_tenant_id,attr"`
+	AzureClientID               *string `hcl:"azure_client_id,attr"`
+	AzureFederatedToken         *string `hcl:"azure_federated_token,attr"`
+
+	TerragruntDependencies []Dependency       `hcl:"dependency,block"`
+	FeatureFlags           []*FeatureFlag     `hcl:"feature,block"`
+	Exclude                *ExcludeConfig     `hcl:"exclude,block"`
+	Errors                 *ErrorsConfig      `hcl:"errors,block"`
+	Concurrency            *ConcurrencyConfig `hcl:"concurrency,block"`
+	Policies               []*PolicyConfig    `hcl:"policy,block"`
 
 	// We allow users to configure code generation via blocks:
 	//
@@ -1794,6 +1938,36 @@ func convertToTerragruntConfig(ctx context.Context, pctx *ParsingContext, config
 		terragruntConfig.SetFieldMetadata(MetadataIamWebIdentityToken, defaultMetadata)
 	}
 
+	if terragruntConfigFromFile.ImpersonateServiceAccount != nil {
+		terragruntConfig.ImpersonateServiceAccount = *terragruntConfigFromFile.ImpersonateServiceAccount
+		terragruntConfig.SetFieldMetadata(MetadataImpersonateServiceAccount, defaultMetadata)
+	}
+
+	if terragruntConfigFromFile.GCPWorkloadIdentityProvider != nil {
+		terragruntConfig.GCPWorkloadIdentityProvider = *terragruntConfigFromFile.GCPWorkloadIdentityProvider
+		terragruntConfig.SetFieldMetadata(MetadataGCPWorkloadIdentityProvider, defaultMetadata)
+	}
+
+	if terragruntConfigFromFile.GCPWebIdentityToken != nil {
+		terragruntConfig.GCPWebIdentityToken = *terragruntConfigFromFile.GCPWebIdentityToken
+		terragruntConfig.SetFieldMetadata(MetadataGCPWebIdentityToken, defaultMetadata)
+	}
+
+	if terragruntConfigFromFile.AzureTenantID != nil {
+		terragruntConfig.AzureTenantID = *terragruntConfigFromFile.AzureTenantID
+		terragruntConfig.SetFieldMetadata(MetadataAzureTenantID, defaultMetadata)
+	}
+
+	if terragruntConfigFromFile.AzureClientID != nil {
+		terragruntConfig.AzureClientID = *terragruntConfigFromFile.AzureClientID
+		terragruntConfig.SetFieldMetadata(MetadataAzureClientID, defaultMetadata)
+	}
+
+	if terragruntConfigFromFile.AzureFederatedToken != nil {
+		terragruntConfig.AzureFederatedToken = *terragruntConfigFromFile.AzureFederatedToken
+		terragruntConfig.SetFieldMetadata(MetadataAzureFederatedToken, defaultMetadata)
+	}
+
 	if terragruntConfigFromFile.Engine != nil {
 		terragruntConfig.Engine = terragruntConfigFromFile.Engine
 		terragruntConfig.SetFieldMetadata(MetadataEngine, defaultMetadata)
@@ -1816,6 +1990,27 @@ func convertToTerragruntConfig(ctx context.Context, pctx *ParsingContext, config
 		terragruntConfig.SetFieldMetadata(MetadataErrors, defaultMetadata)
 	}
 
+	if len(terragruntConfigFromFile.Policies) > 0 {
+		policies := PolicyConfigs(terragruntConfigFromFile.Policies)
+		if err := policies.Validate(); err != nil {
+			return nil, err
+		}
+
+		terragruntConfig.Policies = policies
+		for _, policy := range policies {
+			terragruntConfig.SetFieldMetadataWithType(MetadataPolicy, policy.Name, defaultMetadata)
+		}
+	}
+
+	if terragruntConfigFromFile.Concurrency != nil {
+		if err := terragruntConfigFromFile.Concurrency.Validate(); err != nil {
+			return nil, err
+		}
+
+		terragruntConfig.Concurrency = terragruntConfigFromFile.Concurrency
+		terragruntConfig.SetFieldMetadata(MetadataConcurrency, defaultMetadata)
+	}
+
 	generateBlocks := []terragruntGenerateBlock{}
 	generateBlocks = append(generateBlocks, terragruntConfigFromFile.GenerateBlocks...)
 
@@ -2187,8 +2382,9 @@ func (cfg *TerragruntConfig) ErrorsConfig() (*errorconfig.Config, error) {
 	}
 
 	result := &errorconfig.Config{
-		Retry:  make(map[string]*errorconfig.RetryConfig),
-		Ignore: make(map[string]*errorconfig.IgnoreConfig),
+		Retry:   make(map[string]*errorconfig.RetryConfig),
+		Ignore:  make(map[string]*errorconfig.IgnoreConfig),
+		Explain: make(map[string]*errorconfig.ExplainConfig),
 	}
 
 	for _, retryBlock := range cfg.Errors.Retry {
@@ -2264,6 +2460,31 @@ func (cfg *TerragruntConfig) ErrorsConfig() (*errorconfig.Config, error) {
 		}
 	}
 
+	for _, explainBlock := range cfg.Errors.Explain {
+		if explainBlock == nil {
+			continue
+		}
+
+		compiledPatterns := make([]*errorconfig.Pattern, 0, len(explainBlock.ExplainableErrors))
+
+		for _, pattern := range explainBlock.ExplainableErrors {
+			value, err := errorsPattern(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid explain pattern %q in block %q: %w",
+					pattern, explainBlock.Label, err)
+			}
+
+			compiledPatterns = append(compiledPatterns, value)
+		}
+
+		result.Explain[explainBlock.Label] = &errorconfig.ExplainConfig{
+			Name:              explainBlock.Label,
+			ExplainableErrors: compiledPatterns,
+			Message:           explainBlock.Message,
+			Command:           explainBlock.Command,
+		}
+	}
+
 	return result, nil
 }
 
@@ -26,6 +26,12 @@ func TerragruntConfigAsCty(config *TerragruntConfig) (cty.Value, error) {
 	output[MetadataIamRole] = gostringToCty(config.IamRole)
 	output[MetadataIamAssumeRoleSessionName] = gostringToCty(config.IamAssumeRoleSessionName)
 	output[MetadataIamWebIdentityToken] = gostringToCty(config.IamWebIdentityToken)
+	output[MetadataImpersonateServiceAccount] = gostringToCty(config.ImpersonateServiceAccount)
+	output[MetadataGCPWorkloadIdentityProvider] = gostringToCty(config.GCPWorkloadIdentityProvider)
+	output[MetadataGCPWebIdentityToken] = gostringToCty(config.GCPWebIdentityToken)
+	output[MetadataAzureTenantID] = gostringToCty(config.AzureTenantID)
+	output[MetadataAzureClientID] = gostringToCty(config.AzureClientID)
+	output[MetadataAzureFederatedToken] = gostringToCty(config.AzureFederatedToken)
 
 	catalogConfigCty, err := catalogConfigAsCty(config.Catalog)
 	if err != nil {
@@ -54,6 +60,15 @@ func TerragruntConfigAsCty(config *TerragruntConfig) (cty.Value, error) {
 		output[MetadataExclude] = excludeConfigCty
 	}
 
+	concurrencyConfigCty, err := concurrencyConfigAsCty(config.Concurrency)
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	if concurrencyConfigCty != cty.NilVal {
+		output[MetadataConcurrency] = concurrencyConfigCty
+	}
+
 	errorsConfigCty, err := errorsConfigAsCty(config.Errors)
 	if err != nil {
 		return cty.NilVal, err
@@ -139,6 +154,15 @@ func TerragruntConfigAsCty(config *TerragruntConfig) (cty.Value, error) {
 		output[MetadataLocals] = localsCty
 	}
 
+	policiesCty, err := policyBlocksAsCty(config.Policies)
+	if err != nil {
+		return cty.NilVal, fieldError(MetadataPolicy, err)
+	}
+
+	if policiesCty != cty.NilVal {
+		output[MetadataPolicy] = policiesCty
+	}
+
 	featureFlagsCty, err := featureFlagsBlocksAsCty(config.FeatureFlags)
 	if err != nil {
 		return cty.NilVal, fieldError(MetadataFeatureFlag, err)
@@ -632,6 +656,26 @@ func dependencyBlocksAsCty(dependencyBlocks Dependencies) (cty.Value, error) {
 	return ConvertValuesMapToCtyVal(out)
 }
 
+// Serialize the list of policies to a cty Value as a map that maps the policy names to the cty representation.
+func policyBlocksAsCty(policies PolicyConfigs) (cty.Value, error) {
+	if len(policies) == 0 {
+		return cty.NilVal, nil
+	}
+
+	out := map[string]cty.Value{}
+
+	for _, policy := range policies {
+		policyCty, err := GoTypeToCty(policy)
+		if err != nil {
+			return cty.NilVal, err
+		}
+
+		out[policy.Name] = policyCty
+	}
+
+	return ConvertValuesMapToCtyVal(out)
+}
+
 // Serialize the list of feature flags to a cty Value as a map that maps the feature names to the cty representation.
 func featureFlagsBlocksAsCty(featureFlagBlocks FeatureFlags) (cty.Value, error) {
 	out := map[string]cty.Value{}
@@ -669,6 +713,15 @@ func errorsConfigAsCty(config *ErrorsConfig) (cty.Value, error) {
 		output[MetadataIgnore] = ignoreCty
 	}
 
+	if len(config.Explain) > 0 {
+		explainCty, err := GoTypeToCty(config.Explain)
+		if err != nil {
+			return cty.NilVal, err
+		}
+
+		output[MetadataExplain] = explainCty
+	}
+
 	return ConvertValuesMapToCtyVal(output)
 }
 
@@ -941,3 +994,23 @@ func FormatValue(value cty.Value) (string, error) {
 
 	return GetValueString(value)
 }
+
+// ctyConcurrency concurrency representation for cty.
+type ctyConcurrency struct {
+	Groups []string `cty:"groups"`
+	Weight int      `cty:"weight"`
+}
+
+// concurrencyConfigAsCty serialize concurrency configuration to a cty Value.
+func concurrencyConfigAsCty(config *ConcurrencyConfig) (cty.Value, error) {
+	if config == nil {
+		return cty.NilVal, nil
+	}
+
+	configCty := ctyConcurrency{
+		Groups: config.Groups,
+		Weight: config.GetWeight(),
+	}
+
+	return GoTypeToCty(configCty)
+}
@@ -140,6 +140,12 @@ func TestTerragruntConfigAsCtyDrift(t *testing.T) {
 			},
 		},
 		Exclude: &config.ExcludeConfig{},
+		Concurrency: &config.ConcurrencyConfig{
+			Groups: []string{"aws-eks"},
+		},
+		Policies: config.PolicyConfigs{
+			{Name: "no-db-deletes", DenyActions: []string{"delete"}},
+		},
 	}
 	ctyVal, err := config.TerragruntConfigAsCty(&testConfig)
 	require.NoError(t, err)
@@ -351,6 +357,18 @@ func terragruntConfigStructFieldToMapKey(t *testing.T, fieldName string) (string
 		return "iam_assume_role_session_name", true
 	case "IamWebIdentityToken":
 		return "iam_web_identity_token", true
+	case "ImpersonateServiceAccount":
+		return "impersonate_service_account", true
+	case "GCPWorkloadIdentityProvider":
+		return "gcp_workload_identity_provider", true
+	case "GCPWebIdentityToken":
+		return "gcp_web_identity_token", true
+	case "AzureTenantID":
+		return "azure_tenant_id", true
+	case "AzureClientID":
+		return "azure_client_id", true
+	case "AzureFederatedToken":
+		return "azure_federated_token", true
 	case "Inputs":
 		return "inputs", true
 	case "Locals":
@@ -365,6 +383,14 @@ func terragruntConfigStructFieldToMapKey(t *testing.T, fieldName string) (string
 		return "", false
 	case "FieldsMetadata":
 		return "", false
+	case "Filters":
+		// Named filters are only read from the root configuration, see ReadFilterConfigs.
+		return "", false
+	case "ConcurrencyGroups":
+		// Concurrency groups are only read from the root configuration, see ReadConcurrencyGroupConfigs.
+		return "", false
+	case "Concurrency":
+		return "concurrency", true
 	case "Engine":
 		return "engine", true
 	case "FeatureFlags":
@@ -373,6 +399,8 @@ func terragruntConfigStructFieldToMapKey(t *testing.T, fieldName string) (string
 		return "exclude", true
 	case "Errors":
 		return "errors", true
+	case "Policies":
+		return "policy", true
 	default:
 		t.Fatalf("Unknown struct property: %s", fieldName)
 		// This should not execute
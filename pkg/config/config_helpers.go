@@ -848,7 +848,7 @@ func ParseTerragruntConfig(ctx context.Context, pctx *ParsingContext, l log.Logg
 	}
 
 	// check if file is a values file, decode as values file
-	if strings.HasSuffix(targetConfig, valuesFile) {
+	if strings.HasSuffix(targetConfig, ValuesFile) {
 		unitValues, readErr := ReadValues(ctx, pctx, l, filepath.Dir(targetConfig))
 		if readErr != nil {
 			return cty.NilVal, readErr
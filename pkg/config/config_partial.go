@@ -38,6 +38,7 @@ const (
 	ExcludeBlock
 	ErrorsBlock
 	TerraformExtraArgs
+	ConcurrencyBlock
 )
 
 // terragruntIncludeMultiple is a struct that can be used to only decode the include block with labels.
@@ -64,6 +65,12 @@ type terragruntErrors struct {
 	Remain hcl.Body      `hcl:",remain"`
 }
 
+// terragruntConcurrency is a struct that can be used to only decode the concurrency block.
+type terragruntConcurrency struct {
+	Concurrency *ConcurrencyConfig `hcl:"concurrency,block"`
+	Remain      hcl.Body           `hcl:",remain"`
+}
+
 // terragruntTerraform is a struct that can be used to only decode the terraform block.
 type terragruntTerraform struct {
 	Terraform *TerraformConfig `hcl:"terraform,block"`
@@ -96,12 +103,19 @@ type terraformConfigExtraArgs struct {
 	ExtraArgs []TerraformExtraArguments `hcl:"extra_arguments,block"`
 }
 
-// terragruntFlags is a struct that can be used to only decode the flag attributes (prevent_destroy)
+// terragruntFlags is a struct that can be used to only decode the flag attributes (prevent_destroy) and the
+// attributes configuring the credentials used to read the state of the unit.
 type terragruntFlags struct {
-	IamRole             *string  `hcl:"iam_role,attr"`
-	IamWebIdentityToken *string  `hcl:"iam_web_identity_token,attr"`
-	PreventDestroy      *bool    `hcl:"prevent_destroy,attr"`
-	Remain              hcl.Body `hcl:",remain"`
+	IamRole                     *string  `hcl:"iam_role,attr"`
+	IamWebIdentityToken         *string  `hcl:"iam_web_identity_token,attr"`
+	ImpersonateServiceAccount   *string  `hcl:"impersonate_service_account,attr"`
+	GCPWorkloadIdentityProvider *string  `hcl:"gcp_workload_identity_provider,attr"`
+	GCPWebIdentityToken         *string  `hcl:"gcp_web_identity_token,attr"`
+	AzureTenantID               *string  `hcl:"azure_tenant_id,attr"`
+	AzureClientID               *string  `hcl:"azure_client_id,attr"`
+	AzureFederatedToken         *string  `hcl:"azure_federated_token,attr"`
+	PreventDestroy              *bool    `hcl:"prevent_destroy,attr"`
+	Remain                      hcl.Body `hcl:",remain"`
 }
 
 // terragruntVersionConstraints is a struct that can be used to only decode the attributes related to constraining the
@@ -563,6 +577,30 @@ func PartialParseConfig(ctx context.Context, pctx *ParsingContext, l log.Logger,
 			if decoded.IamWebIdentityToken != nil {
 				output.IamWebIdentityToken = *decoded.IamWebIdentityToken
 			}
+
+			if decoded.ImpersonateServiceAccount != nil {
+				output.ImpersonateServiceAccount = *decoded.ImpersonateServiceAccount
+			}
+
+			if decoded.GCPWorkloadIdentityProvider != nil {
+				output.GCPWorkloadIdentityProvider = *decoded.GCPWorkloadIdentityProvider
+			}
+
+			if decoded.GCPWebIdentityToken != nil {
+				output.GCPWebIdentityToken = *decoded.GCPWebIdentityToken
+			}
+
+			if decoded.AzureTenantID != nil {
+				output.AzureTenantID = *decoded.AzureTenantID
+			}
+
+			if decoded.AzureClientID != nil {
+				output.AzureClientID = *decoded.AzureClientID
+			}
+
+			if decoded.AzureFederatedToken != nil {
+				output.AzureFederatedToken = *decoded.AzureFederatedToken
+			}
 		case TerragruntVersionConstraints:
 			decoded := terragruntVersionConstraints{}
 
@@ -636,6 +674,28 @@ func PartialParseConfig(ctx context.Context, pctx *ParsingContext, l log.Logger,
 				output.Errors = decoded.Errors
 			}
 
+		case ConcurrencyBlock:
+			decoded := terragruntConcurrency{}
+
+			err := file.Decode(&decoded, evalParsingContext)
+			if err != nil {
+				return nil, err
+			}
+
+			if decoded.Concurrency == nil {
+				continue
+			}
+
+			if err := decoded.Concurrency.Validate(); err != nil {
+				return nil, err
+			}
+
+			if output.Concurrency != nil {
+				output.Concurrency.Merge(decoded.Concurrency)
+			} else {
+				output.Concurrency = decoded.Concurrency
+			}
+
 		default:
 			return nil, InvalidPartialBlockName{decode}
 		}
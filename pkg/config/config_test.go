@@ -12,6 +12,8 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/codegen"
 	"github.com/gruntwork-io/terragrunt/internal/experiment"
 	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/azuread"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/googleiam"
 	"github.com/gruntwork-io/terragrunt/internal/util"
 	"github.com/gruntwork-io/terragrunt/pkg/config"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
@@ -476,6 +478,41 @@ func TestParseIamWebIdentity(t *testing.T) {
 	assert.Equal(t, token, terragruntConfig.IamWebIdentityToken)
 }
 
+func TestParseCloudCredentials(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+impersonate_service_account    = "deployer@project.iam.gserviceaccount.com"
+gcp_workload_identity_provider = "projects/123/locations/global/workloadIdentityPools/ci/providers/github"
+gcp_web_identity_token         = "gcp-token"
+azure_tenant_id                = "tenant"
+azure_client_id                = "client"
+azure_federated_token          = "azure-token"
+`
+
+	l := createLogger()
+
+	ctx, pctx := newTestParsingContext(t, "test-time-mock")
+
+	terragruntConfig, err := config.ParseConfigString(ctx, pctx, l, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, googleiam.Options{
+		ImpersonateServiceAccount: "deployer@project.iam.gserviceaccount.com",
+		WorkloadIdentityProvider:  "projects/123/locations/global/workloadIdentityPools/ci/providers/github",
+		WebIdentityToken:          "gcp-token",
+	}, terragruntConfig.GetGCPCredentialsOptions())
+	assert.Equal(t, azuread.Options{
+		TenantID:       "tenant",
+		ClientID:       "client",
+		FederatedToken: "azure-token",
+	}, terragruntConfig.GetAzureCredentialsOptions())
+
+	runCfg := terragruntConfig.ToRunConfig(l)
+	assert.Equal(t, terragruntConfig.GetGCPCredentialsOptions(), runCfg.GetGCPCredentialsOptions())
+	assert.Equal(t, terragruntConfig.GetAzureCredentialsOptions(), runCfg.GetAzureCredentialsOptions())
+}
+
 func TestParseTerragruntConfigDependenciesOnePath(t *testing.T) {
 	t.Parallel()
 
@@ -1842,6 +1879,12 @@ errors {
 			key = "value"
 		}
 	}
+
+	explain "test_explain" {
+		explainable_errors = [".*state lock.*"]
+		message = "The state is locked"
+		command = "terragrunt run -- force-unlock <LOCK_ID>"
+	}
 }
 
 // The catalog block won't actually show up when using
@@ -1976,6 +2019,9 @@ inputs = {
 		assert.Equal(t, terragruntConfig.Errors.Ignore[0].Signals, rereadConfig.Errors.Ignore[0].Signals)
 	}
 
+	require.Len(t, rereadConfig.Errors.Explain, 1)
+	assert.Equal(t, terragruntConfig.Errors.Explain, rereadConfig.Errors.Explain)
+
 	// The catalog block won't actually show up when using
 	// ParseConfigString. It probably should, but that's not
 	// a problem for this test.
@@ -23,6 +23,9 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/remotestate"
 	"github.com/gruntwork-io/terragrunt/pkg/log"
 
+	"github.com/gruntwork-io/terragrunt/internal/remotestate/backend"
+	azurermbackend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/azurerm"
+	gcsbackend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/gcs"
 	s3backend "github.com/gruntwork-io/terragrunt/internal/remotestate/backend/s3"
 
 	"github.com/gruntwork-io/terragrunt/internal/getter"
@@ -36,8 +39,11 @@ import (
 	"github.com/gruntwork-io/terragrunt/internal/report"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/amazonsts"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/azuread"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/externalcmd"
+	"github.com/gruntwork-io/terragrunt/internal/runner/run/creds/providers/googleiam"
 	"github.com/gruntwork-io/terragrunt/internal/shell"
 	"github.com/gruntwork-io/terragrunt/internal/telemetry"
 	"github.com/gruntwork-io/terragrunt/internal/tf"
@@ -51,6 +57,9 @@ import (
 const (
 	renderJSONCommand = "render-json"
 	renderCommand     = "render"
+
+	// tfWorkspaceEnvName selects the workspace whose state is read when fetching outputs directly from the backend.
+	tfWorkspaceEnvName = "TF_WORKSPACE"
 )
 
 type Dependencies []Dependency
@@ -1131,21 +1140,19 @@ func resolveOutputJSON(ctx context.Context, pctx *ParsingContext, l log.Logger,
 			l,
 			targetConfig,
 			remoteStateTGConfig.RemoteState,
-			remoteStateTGConfig.GetIAMRoleOptions(),
+			dependencyCredsProviders(l, pctx, remoteStateTGConfig),
 		)
 
 		return out, "state", fetchErr
 	}
 
 	if isInit {
-		mergedIAM := iam.MergeRoleOptions(remoteStateTGConfig.GetIAMRoleOptions(), pctx.OriginalIAMRoleOptions)
 		if err = creds.NewGetter().ObtainAndUpdateEnvIfNecessary(
 			ctx,
 			l,
 			pctx.Venv.Exec,
 			pctx.Env,
-			externalcmd.NewProvider(l, pctx.AuthProviderCmd, shellRunOptsFromPctx(pctx)),
-			amazonsts.NewProvider(l, mergedIAM, pctx.Env),
+			dependencyCredsProviders(l, pctx, remoteStateTGConfig)...,
 		); err != nil {
 			return nil, "", err
 		}
@@ -1166,7 +1173,7 @@ func resolveOutputJSON(ctx context.Context, pctx *ParsingContext, l log.Logger,
 		l,
 		targetConfig,
 		remoteStateTGConfig.RemoteState,
-		remoteStateTGConfig.GetIAMRoleOptions(),
+		dependencyCredsProviders(l, pctx, remoteStateTGConfig),
 	)
 
 	return out, "state", fetchErr
@@ -1281,6 +1288,19 @@ func getTerragruntOutputJSONFromInitFolder(
 	return jsonBytes, nil
 }
 
+// dependencyCredsProviders returns the providers of the credentials used to read the state of a dependency, as
+// configured in its config. The IAM role options passed on the command line take precedence over the config.
+func dependencyCredsProviders(l log.Logger, pctx *ParsingContext, cfg *TerragruntConfig) []providers.Provider {
+	mergedIAM := iam.MergeRoleOptions(cfg.GetIAMRoleOptions(), pctx.OriginalIAMRoleOptions)
+
+	return []providers.Provider{
+		externalcmd.NewProvider(l, pctx.AuthProviderCmd, shellRunOptsFromPctx(pctx)),
+		amazonsts.NewProvider(l, mergedIAM, pctx.Env),
+		googleiam.NewProvider(l, cfg.GetGCPCredentialsOptions(), googleiam.WithFS(pctx.Venv.FS)),
+		azuread.NewProvider(l, cfg.GetAzureCredentialsOptions(), pctx.Env, azuread.WithFS(pctx.Venv.FS)),
+	}
+}
+
 // getTerragruntOutputJSONFromRemoteState will retrieve the outputs directly by using just the remote state block. This
 // uses terraform's feature where `output` and `init` can work without the real source, as long as you have the
 // `backend` configured.
@@ -1297,7 +1317,7 @@ func getTerragruntOutputJSONFromRemoteState(
 	l log.Logger,
 	targetConfigPath string,
 	remoteState *remotestate.RemoteState,
-	iamRoleOpts iam.RoleOptions,
+	credsProviders []providers.Provider,
 ) ([]byte, error) {
 	l.Debugf("Detected remote state block with generate config. Resolving dependency by pulling remote state.")
 	// Create working directory where we will run terraform in. We will create the temporary directory in the download
@@ -1321,14 +1341,12 @@ func getTerragruntOutputJSONFromRemoteState(
 
 	l.Debugf("Setting dependency working directory to %s", tempWorkDir)
 
-	mergedIAM := iam.MergeRoleOptions(iamRoleOpts, pctx.OriginalIAMRoleOptions)
 	if err = creds.NewGetter().ObtainAndUpdateEnvIfNecessary(
 		ctx,
 		l,
 		pctx.Venv.Exec,
 		pctx.Env,
-		externalcmd.NewProvider(l, pctx.AuthProviderCmd, shellRunOptsFromPctx(pctx)),
-		amazonsts.NewProvider(l, mergedIAM, pctx.Env),
+		credsProviders...,
 	); err != nil {
 		return nil, err
 	}
@@ -1351,6 +1369,39 @@ func getTerragruntOutputJSONFromRemoteState(
 
 			l.Debugf("Retrieved output from %s as json: %s using s3 bucket", pctx.TerragruntConfigPath, jsonBytes)
 
+			return jsonBytes, nil
+		case gcsbackend.BackendName:
+			jsonBytes, gcsGetErr := getTerragruntOutputJSONFromRemoteStateGCS(
+				ctx,
+				l,
+				pctx,
+				remoteState,
+			)
+			if gcsGetErr != nil {
+				return nil, gcsGetErr
+			}
+
+			l.Debugf("Retrieved output from %s as json: %s using gcs bucket", pctx.TerragruntConfigPath, jsonBytes)
+
+			return jsonBytes, nil
+		case azurermbackend.BackendName:
+			if !pctx.Experiments.Evaluate(experiment.AzureBackend) {
+				l.Debugf("Fetching outputs from the azurerm backend requires the %s experiment, falling back to default output retrieval", experiment.AzureBackend)
+				break
+			}
+
+			jsonBytes, azurermGetErr := getTerragruntOutputJSONFromRemoteStateAzurerm(
+				ctx,
+				l,
+				pctx,
+				remoteState,
+			)
+			if azurermGetErr != nil {
+				return nil, azurermGetErr
+			}
+
+			l.Debugf("Retrieved output from %s as json: %s using azurerm container", pctx.TerragruntConfigPath, jsonBytes)
+
 			return jsonBytes, nil
 		default:
 			l.Debugf("dependency-fetch-output-from-state experiment is not supported for backend %s, falling back to default output retrieval", backend)
@@ -1451,17 +1502,86 @@ func getTerragruntOutputJSONFromRemoteStateS3(ctx context.Context, l log.Logger,
 			return fmt.Errorf("reading dependency state body from s3://%s/%s: %w", bucket, key, err)
 		}
 
-		jsonMap := make(map[string]any)
-		if err := json.Unmarshal(steateBody, &jsonMap); err != nil {
-			return fmt.Errorf("parsing dependency state JSON from s3://%s/%s: %w", bucket, key, err)
+		jsonOutputs, err = backend.StateOutputsJSON(steateBody)
+		if err != nil {
+			return fmt.Errorf("reading outputs from dependency state at s3://%s/%s: %w", bucket, key, err)
 		}
 
-		jsonOutputs, err = json.Marshal(jsonMap["outputs"])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonOutputs, nil
+}
+
+// getTerragruntOutputJSONFromRemoteStateGCS pulls the output directly from a GCS bucket without calling Terraform
+func getTerragruntOutputJSONFromRemoteStateGCS(ctx context.Context, l log.Logger, pctx *ParsingContext, remoteState *remotestate.RemoteState) ([]byte, error) {
+	extGCSCfg, err := gcsbackend.Config(remoteState.BackendConfig).ExtendedGCSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("parsing gcs backend config: %w", err)
+	}
+
+	var (
+		bucket    = extGCSCfg.RemoteStateConfigGCS.Bucket
+		workspace = pctx.Env[tfWorkspaceEnvName]
+		key       = extGCSCfg.RemoteStateConfigGCS.StateObjectKey(workspace)
+	)
+
+	var jsonOutputs []byte
+
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "dependency_output_state_gcs", map[string]any{
+		"bucket": bucket,
+		"key":    key,
+	}, func(ctx context.Context) error {
+		client, err := gcsbackend.NewClient(ctx, extGCSCfg, &backend.Options{Env: pctx.Env})
 		if err != nil {
-			return fmt.Errorf("encoding outputs from dependency state at s3://%s/%s: %w", bucket, key, err)
+			return fmt.Errorf("building gcs client for gs://%s/%s: %w", bucket, key, err)
 		}
 
-		return nil
+		defer func() {
+			if err := client.Close(); err != nil {
+				l.Warnf("Error closing GCS client: %v", err)
+			}
+		}()
+
+		jsonOutputs, err = client.ReadStateOutputs(ctx, l, workspace)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonOutputs, nil
+}
+
+// getTerragruntOutputJSONFromRemoteStateAzurerm pulls the output directly from an Azure blob container without calling Terraform
+func getTerragruntOutputJSONFromRemoteStateAzurerm(ctx context.Context, l log.Logger, pctx *ParsingContext, remoteState *remotestate.RemoteState) ([]byte, error) {
+	azurermCfg, err := azurermbackend.Config(remoteState.BackendConfig).AzurermConfig()
+	if err != nil {
+		return nil, fmt.Errorf("parsing azurerm backend config: %w", err)
+	}
+
+	var (
+		workspace   = pctx.Env[tfWorkspaceEnvName]
+		jsonOutputs []byte
+	)
+
+	err = telemetry.TelemeterFromContext(ctx).Collect(ctx, "dependency_output_state_azurerm", map[string]any{
+		"storage_account": azurermCfg.StorageAccountName,
+		"container":       azurermCfg.ContainerName,
+		"key":             azurermCfg.StateBlobName(workspace),
+	}, func(ctx context.Context) error {
+		client, err := azurermbackend.NewClient(azurermCfg, &backend.Options{Env: pctx.Env})
+		if err != nil {
+			return fmt.Errorf("building azurerm client for storage account %s: %w", azurermCfg.StorageAccountName, err)
+		}
+
+		jsonOutputs, err = client.ReadStateOutputs(ctx, l, workspace)
+
+		return err
 	})
 	if err != nil {
 		return nil, err
@@ -1554,6 +1674,7 @@ func runTerragruntOutputJSON(ctx context.Context, pctx *ParsingContext, l log.Lo
 	runOpts.Telemetry = pctx.Telemetry
 	runOpts.AuthProviderCmd = pctx.AuthProviderCmd
 	runOpts.CASCloneDepth = pctx.CASCloneDepth
+	runOpts.CASRemote = pctx.CASRemote
 
 	err = run.Run(ctx, l, run.FromRoot(pctx.Venv), runOpts, report.NewReport(), runCfg, credsGetter)
 	if err != nil {
@@ -1604,6 +1725,13 @@ func tfRunOptsFromPctx(pctx *ParsingContext) *tf.TFOptions {
 // TerraformOutputJSONToCtyValueMap takes the terraform output json and converts to a mapping between output keys to the
 // parsed cty.Value encoding of the json objects.
 func TerraformOutputJSONToCtyValueMap(targetConfigPath string, jsonBytes []byte) (map[string]cty.Value, error) {
+	outputs, _, err := ParseTerraformOutputJSON(targetConfigPath, jsonBytes)
+	return outputs, err
+}
+
+// ParseTerraformOutputJSON is like [TerraformOutputJSONToCtyValueMap], but also returns the set of output keys declared
+// sensitive.
+func ParseTerraformOutputJSON(targetConfigPath string, jsonBytes []byte) (map[string]cty.Value, map[string]bool, error) {
 	// When getting all outputs, terraform returns a json with the data containing metadata about the types, so we
 	// can't quite return the data directly. Instead, we will need further processing to get the output we want.
 	// To do so, we first Unmarshal the json into a simple go map to a OutputMeta struct.
@@ -1617,26 +1745,31 @@ func TerraformOutputJSONToCtyValueMap(targetConfigPath string, jsonBytes []byte)
 
 	err := json.Unmarshal(jsonBytes, &outputs)
 	if err != nil {
-		return nil, TerragruntOutputParsingError{Path: targetConfigPath, Err: err}
+		return nil, nil, TerragruntOutputParsingError{Path: targetConfigPath, Err: err}
 	}
 
 	flattenedOutput := map[string]cty.Value{}
+	sensitive := map[string]bool{}
 
 	for k, v := range outputs {
 		outputType, err := ctyjson.UnmarshalType(v.Type)
 		if err != nil {
-			return nil, TerragruntOutputParsingError{Path: targetConfigPath, Err: err}
+			return nil, nil, TerragruntOutputParsingError{Path: targetConfigPath, Err: err}
 		}
 
 		outputVal, err := ctyjson.Unmarshal(v.Value, outputType)
 		if err != nil {
-			return nil, TerragruntOutputParsingError{Path: targetConfigPath, Err: err}
+			return nil, nil, TerragruntOutputParsingError{Path: targetConfigPath, Err: err}
 		}
 
 		flattenedOutput[k] = outputVal
+
+		if v.Sensitive {
+			sensitive[k] = true
+		}
 	}
 
-	return flattenedOutput, nil
+	return flattenedOutput, sensitive, nil
 }
 
 // runTerraformInitForDependencyOutput will run terraform init in a mode that doesn't pull down plugins or modules. Note
@@ -77,6 +77,84 @@ func (err DuplicatedGenerateBlocksError) Error() string {
 	)
 }
 
+type DuplicatedFilterBlocksError struct {
+	BlockName []string
+}
+
+func (err DuplicatedFilterBlocksError) Error() string {
+	return fmt.Sprintf(
+		"Detected filter blocks with the same name: %v", err.BlockName,
+	)
+}
+
+type DuplicatedConcurrencyGroupBlocksError struct {
+	BlockName []string
+}
+
+func (err DuplicatedConcurrencyGroupBlocksError) Error() string {
+	return fmt.Sprintf(
+		"Detected concurrency_group blocks with the same name: %v", err.BlockName,
+	)
+}
+
+type InvalidConcurrencyGroupLimitError struct {
+	Name           string
+	MaxConcurrency int
+}
+
+func (err InvalidConcurrencyGroupLimitError) Error() string {
+	return fmt.Sprintf("max_concurrency of concurrency_group %q must be at least 1, got %d", err.Name, err.MaxConcurrency)
+}
+
+type InvalidConcurrencyWeightError struct {
+	Weight int
+}
+
+func (err InvalidConcurrencyWeightError) Error() string {
+	return fmt.Sprintf("weight of the concurrency block must be at least 1, got %d", err.Weight)
+}
+
+type DuplicatedPolicyBlocksError struct {
+	BlockName []string
+}
+
+func (err DuplicatedPolicyBlocksError) Error() string {
+	return fmt.Sprintf(
+		"Detected policy blocks with the same name: %v", err.BlockName,
+	)
+}
+
+type InvalidPolicyError struct {
+	Name   string
+	Reason string
+}
+
+func (err InvalidPolicyError) Error() string {
+	return fmt.Sprintf("invalid policy %q: %s", err.Name, err.Reason)
+}
+
+type InvalidChangeWindowError struct {
+	Field string
+	Value string
+}
+
+func (err InvalidChangeWindowError) Error() string {
+	return fmt.Sprintf("invalid %s %q in change_window", err.Field, err.Value)
+}
+
+type FilterNotFoundError struct {
+	Name      string
+	Available []string
+}
+
+func (err FilterNotFoundError) Error() string {
+	if len(err.Available) == 0 {
+		return fmt.Sprintf("Filter @%s not found: no filter blocks are defined in the root configuration", err.Name)
+	}
+
+	return fmt.Sprintf("Filter @%s not found, available filters: %s", err.Name, strings.Join(err.Available, ", "))
+}
+
 type TFVarFileNotFoundError struct {
 	File  string
 	Cause string
@@ -10,8 +10,9 @@ import (
 
 // ErrorsConfig represents the top-level errors configuration
 type ErrorsConfig struct {
-	Retry  []*RetryBlock  `cty:"retry" hcl:"retry,block"`
-	Ignore []*IgnoreBlock `cty:"ignore" hcl:"ignore,block"`
+	Retry   []*RetryBlock   `cty:"retry" hcl:"retry,block"`
+	Ignore  []*IgnoreBlock  `cty:"ignore" hcl:"ignore,block"`
+	Explain []*ExplainBlock `cty:"explain" hcl:"explain,block"`
 }
 
 // RetryBlock represents a labeled retry block
@@ -30,6 +31,14 @@ type IgnoreBlock struct {
 	IgnorableErrors []string             `cty:"ignorable_errors" hcl:"ignorable_errors"`
 }
 
+// ExplainBlock represents a labeled explain block
+type ExplainBlock struct {
+	Label             string   `cty:"name" hcl:"name,label"`
+	Message           string   `cty:"message" hcl:"message"`
+	Command           string   `cty:"command" hcl:"command,optional"`
+	ExplainableErrors []string `cty:"explainable_errors" hcl:"explainable_errors"`
+}
+
 // Clone returns a deep copy of ErrorsConfig
 func (c *ErrorsConfig) Clone() *ErrorsConfig {
 	if c == nil {
@@ -37,8 +46,9 @@ func (c *ErrorsConfig) Clone() *ErrorsConfig {
 	}
 
 	return &ErrorsConfig{
-		Retry:  cloneRetryBlocks(c.Retry),
-		Ignore: cloneIgnoreBlocks(c.Ignore),
+		Retry:   cloneRetryBlocks(c.Retry),
+		Ignore:  cloneIgnoreBlocks(c.Ignore),
+		Explain: cloneExplainBlocks(c.Explain),
 	}
 }
 
@@ -50,6 +60,7 @@ func (c *ErrorsConfig) Merge(other *ErrorsConfig) {
 
 	c.Retry = mergeRetryBlocks(c.Retry, other.Retry)
 	c.Ignore = mergeIgnoreBlocks(c.Ignore, other.Ignore)
+	c.Explain = mergeExplainBlocks(c.Explain, other.Explain)
 }
 
 // Clone returns a deep copy of a RetryBlock
@@ -80,6 +91,20 @@ func (i *IgnoreBlock) Clone() *IgnoreBlock {
 	}
 }
 
+// Clone returns a deep copy of an ExplainBlock
+func (e *ExplainBlock) Clone() *ExplainBlock {
+	if e == nil {
+		return nil
+	}
+
+	return &ExplainBlock{
+		Label:             e.Label,
+		ExplainableErrors: cloneStringSlice(e.ExplainableErrors),
+		Message:           e.Message,
+		Command:           e.Command,
+	}
+}
+
 // Helper function to deep copy a slice of RetryBlock
 func cloneRetryBlocks(blocks []*RetryBlock) []*RetryBlock {
 	if blocks == nil {
@@ -108,6 +133,20 @@ func cloneIgnoreBlocks(blocks []*IgnoreBlock) []*IgnoreBlock {
 	return cloned
 }
 
+// Helper function to deep copy a slice of ExplainBlock
+func cloneExplainBlocks(blocks []*ExplainBlock) []*ExplainBlock {
+	if blocks == nil {
+		return nil
+	}
+
+	cloned := make([]*ExplainBlock, len(blocks))
+	for i, block := range blocks {
+		cloned[i] = block.Clone()
+	}
+
+	return cloned
+}
+
 // Helper function to deep copy a slice of strings
 func cloneStringSlice(slice []string) []string {
 	if slice == nil {
@@ -196,3 +235,34 @@ func mergeIgnoreBlocks(existing, other []*IgnoreBlock) []*IgnoreBlock {
 	// Convert map back to slice
 	return slices.Collect(maps.Values(ignoreMap))
 }
+
+// Merges two slices of ExplainBlock, prioritizing the second slice
+func mergeExplainBlocks(existing, other []*ExplainBlock) []*ExplainBlock {
+	explainMap := make(map[string]*ExplainBlock, len(existing)+len(other))
+
+	// Add existing explain blocks
+	for _, block := range existing {
+		explainMap[block.Label] = block
+	}
+
+	// Merge explain blocks from 'other'
+	for _, otherBlock := range other {
+		if existingBlock, found := explainMap[otherBlock.Label]; found {
+			existingBlock.ExplainableErrors = util.MergeSlices(existingBlock.ExplainableErrors, otherBlock.ExplainableErrors)
+
+			if otherBlock.Message != "" {
+				existingBlock.Message = otherBlock.Message
+			}
+
+			if otherBlock.Command != "" {
+				existingBlock.Command = otherBlock.Command
+			}
+
+			continue
+		}
+
+		explainMap[otherBlock.Label] = otherBlock
+	}
+
+	return slices.Collect(maps.Values(explainMap))
+}
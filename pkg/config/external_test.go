@@ -229,6 +229,29 @@ func TestExternalTerraformOutputJSONToCtyValueMap(t *testing.T) {
 	assert.Equal(t, "vpc-abc123", vpcID.AsString())
 }
 
+func TestExternalParseTerraformOutputJSON(t *testing.T) {
+	t.Parallel()
+
+	jsonOutput := []byte(`{
+		"vpc_id": {
+			"sensitive": false,
+			"type": "string",
+			"value": "vpc-abc123"
+		},
+		"db_password": {
+			"sensitive": true,
+			"type": "string",
+			"value": "hunter2"
+		}
+	}`)
+
+	result, sensitive, err := config.ParseTerraformOutputJSON("test-config", jsonOutput)
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "hunter2", result["db_password"].AsString())
+	assert.Equal(t, map[string]bool{"db_password": true}, sensitive)
+}
+
 func TestExternalUnitGeneratedPath(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/gruntwork-io/terragrunt/pkg/config/hclparse"
+	"github.com/gruntwork-io/terragrunt/pkg/log"
+)
+
+var filterBlockReg = regexp.MustCompile(fmt.Sprintf(hclBlockRegExprFmt, MetadataFilter))
+
+// FilterConfig is a named, reusable filter query declared in the root configuration, e.g.
+//
+//	filter "prod-network" {
+//	  query = "...{./prod/network/**}"
+//	}
+//
+// It is referenced with `--filter @prod-network`.
+type FilterConfig struct {
+	Description *string `hcl:"description,optional" cty:"description"`
+	Name        string  `hcl:",label" cty:"name"`
+	Query       string  `hcl:"query,attr" cty:"query"`
+}
+
+// FilterConfigs is a list of named filter queries.
+type FilterConfigs []*FilterConfig
+
+// Find returns the filter with the given name, or nil if there is none.
+func (filters FilterConfigs) Find(name string) *FilterConfig {
+	for _, filter := range filters {
+		if filter.Name == name {
+			return filter
+		}
+	}
+
+	return nil
+}
+
+// Names returns the names of the filters.
+func (filters FilterConfigs) Names() []string {
+	names := make([]string, 0, len(filters))
+
+	for _, filter := range filters {
+		names = append(names, filter.Name)
+	}
+
+	return names
+}
+
+// ReadFilterConfigs reads the `filter` blocks from the root configuration in the parent directories,
+// the same way ReadCatalogConfig finds the `catalog` block.
+// Any configuration errors not related to the `filter` blocks are ignored.
+func ReadFilterConfigs(parentCtx context.Context, l log.Logger, pctx *ParsingContext) (FilterConfigs, error) {
+	configPath, configString, err := findRootConfigWithBlock(parentCtx, l, pctx, filterBlockReg)
+	if err != nil || configPath == "" {
+		return nil, err
+	}
+
+	pctx = pctx.Clone()
+	pctx.TerragruntConfigPath = configPath
+	pctx.ParserOptions = append(pctx.ParserOptions, hclparse.WithHaltOnErrorOnlyForBlocks([]string{MetadataFilter}))
+	pctx.ConvertToTerragruntConfigFunc = convertToTerragruntFilterConfig
+
+	config, err := ParseConfigString(parentCtx, pctx, l, configPath, configString, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return config.Filters, nil
+}
+
+func convertToTerragruntFilterConfig(
+	_ context.Context,
+	_ *ParsingContext,
+	configPath string,
+	terragruntConfigFromFile *terragruntConfigFile,
+) (*TerragruntConfig, error) {
+	terragruntConfig := &TerragruntConfig{}
+
+	if err := validateFilterConfigs(terragruntConfigFromFile.Filters); err != nil {
+		return nil, err
+	}
+
+	if len(terragruntConfigFromFile.Filters) > 0 {
+		terragruntConfig.Filters = terragruntConfigFromFile.Filters
+		terragruntConfig.SetFieldMetadata(MetadataFilter, map[string]any{FoundInFile: configPath})
+	}
+
+	return terragruntConfig, nil
+}
+
+// validateFilterConfigs checks that filter names are unique.
+func validateFilterConfigs(filters FilterConfigs) error {
+	var duplicates []string
+
+	seen := make(map[string]struct{}, len(filters))
+
+	for _, filter := range filters {
+		if _, ok := seen[filter.Name]; ok {
+			duplicates = append(duplicates, filter.Name)
+
+			continue
+		}
+
+		seen[filter.Name] = struct{}{}
+	}
+
+	if len(duplicates) > 0 {
+		return DuplicatedFilterBlocksError{BlockName: duplicates}
+	}
+
+	return nil
+}
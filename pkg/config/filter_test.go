@@ -0,0 +1,111 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/gruntwork-io/terragrunt/test/helpers/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFilterConfigs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		rootConfig  string
+		expectedErr string
+		expected    config.FilterConfigs
+	}{
+		{
+			name: "named filters",
+			rootConfig: `
+filter "prod-network" {
+  query       = "...{./prod/network/**}"
+  description = "Production networking and its dependents"
+}
+
+filter "no-legacy" {
+  query = "!./legacy/**"
+}
+`,
+			expected: config.FilterConfigs{
+				{
+					Name:        "prod-network",
+					Query:       "...{./prod/network/**}",
+					Description: new("Production networking and its dependents"),
+				},
+				{
+					Name:  "no-legacy",
+					Query: "!./legacy/**",
+				},
+			},
+		},
+		{
+			name: "no filter blocks",
+			rootConfig: `
+locals {
+  region = "us-east-1"
+}
+`,
+		},
+		{
+			name: "duplicated filter blocks",
+			rootConfig: `
+filter "apps" {
+  query = "./apps/*"
+}
+
+filter "apps" {
+  query = "./libs/*"
+}
+`,
+			expectedErr: config.DuplicatedFilterBlocksError{BlockName: []string{"apps"}}.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rootDir := t.TempDir()
+			unitDir := filepath.Join(rootDir, "prod", "network")
+			require.NoError(t, os.MkdirAll(unitDir, 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(rootDir, "root.hcl"), []byte(tc.rootConfig), 0644))
+
+			unitConfigPath := filepath.Join(unitDir, "terragrunt.hcl")
+			require.NoError(t, os.WriteFile(unitConfigPath, []byte(`include "root" { path = find_in_parent_folders("root.hcl") }`), 0644))
+
+			l := logger.CreateLogger()
+			_, pctx := newTestParsingContext(t, unitConfigPath)
+			pctx.ScaffoldRootFileName = "root.hcl"
+
+			filters, err := config.ReadFilterConfigs(t.Context(), l, pctx)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, filters)
+		})
+	}
+}
+
+func TestFilterNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	assert.EqualError(t,
+		config.FilterNotFoundError{Name: "prod"},
+		"Filter @prod not found: no filter blocks are defined in the root configuration",
+	)
+	assert.EqualError(t,
+		config.FilterNotFoundError{Name: "prod", Available: []string{"dev", "stage"}},
+		"Filter @prod not found, available filters: dev, stage",
+	)
+}
@@ -291,6 +291,30 @@ func (cfg *TerragruntConfig) Merge(l log.Logger, sourceConfig *TerragruntConfig)
 		cfg.IamWebIdentityToken = sourceConfig.IamWebIdentityToken
 	}
 
+	if sourceConfig.ImpersonateServiceAccount != "" {
+		cfg.ImpersonateServiceAccount = sourceConfig.ImpersonateServiceAccount
+	}
+
+	if sourceConfig.GCPWorkloadIdentityProvider != "" {
+		cfg.GCPWorkloadIdentityProvider = sourceConfig.GCPWorkloadIdentityProvider
+	}
+
+	if sourceConfig.GCPWebIdentityToken != "" {
+		cfg.GCPWebIdentityToken = sourceConfig.GCPWebIdentityToken
+	}
+
+	if sourceConfig.AzureTenantID != "" {
+		cfg.AzureTenantID = sourceConfig.AzureTenantID
+	}
+
+	if sourceConfig.AzureClientID != "" {
+		cfg.AzureClientID = sourceConfig.AzureClientID
+	}
+
+	if sourceConfig.AzureFederatedToken != "" {
+		cfg.AzureFederatedToken = sourceConfig.AzureFederatedToken
+	}
+
 	if sourceConfig.TerraformVersionConstraint != "" {
 		cfg.TerraformVersionConstraint = sourceConfig.TerraformVersionConstraint
 	}
@@ -319,6 +343,10 @@ func (cfg *TerragruntConfig) Merge(l log.Logger, sourceConfig *TerragruntConfig)
 		cfg.Errors = sourceConfig.Errors.Clone()
 	}
 
+	if sourceConfig.Concurrency != nil {
+		cfg.Concurrency = sourceConfig.Concurrency.Clone()
+	}
+
 	if sourceConfig.RemoteState != nil {
 		cfg.RemoteState = sourceConfig.RemoteState
 	}
@@ -347,6 +375,7 @@ func (cfg *TerragruntConfig) Merge(l log.Logger, sourceConfig *TerragruntConfig)
 	cfg.TerragruntDependencies = mergeDependencyBlocks(cfg.TerragruntDependencies, sourceConfig.TerragruntDependencies)
 
 	cfg.FeatureFlags = mergeFeatureFlags(cfg.FeatureFlags, sourceConfig.FeatureFlags)
+	cfg.Policies = mergePolicies(cfg.Policies, sourceConfig.Policies)
 
 	// Deep merge the dependencies list. This is different from dependency blocks, and refers to the deprecated
 	// dependencies block!
@@ -413,6 +442,30 @@ func (cfg *TerragruntConfig) DeepMerge(l log.Logger, sourceConfig *TerragruntCon
 		cfg.IamWebIdentityToken = sourceConfig.IamWebIdentityToken
 	}
 
+	if sourceConfig.ImpersonateServiceAccount != "" {
+		cfg.ImpersonateServiceAccount = sourceConfig.ImpersonateServiceAccount
+	}
+
+	if sourceConfig.GCPWorkloadIdentityProvider != "" {
+		cfg.GCPWorkloadIdentityProvider = sourceConfig.GCPWorkloadIdentityProvider
+	}
+
+	if sourceConfig.GCPWebIdentityToken != "" {
+		cfg.GCPWebIdentityToken = sourceConfig.GCPWebIdentityToken
+	}
+
+	if sourceConfig.AzureTenantID != "" {
+		cfg.AzureTenantID = sourceConfig.AzureTenantID
+	}
+
+	if sourceConfig.AzureClientID != "" {
+		cfg.AzureClientID = sourceConfig.AzureClientID
+	}
+
+	if sourceConfig.AzureFederatedToken != "" {
+		cfg.AzureFederatedToken = sourceConfig.AzureFederatedToken
+	}
+
 	if sourceConfig.TerraformVersionConstraint != "" {
 		cfg.TerraformVersionConstraint = sourceConfig.TerraformVersionConstraint
 	}
@@ -453,6 +506,14 @@ func (cfg *TerragruntConfig) DeepMerge(l log.Logger, sourceConfig *TerragruntCon
 		cfg.Errors.Merge(sourceConfig.Errors)
 	}
 
+	if sourceConfig.Concurrency != nil {
+		if cfg.Concurrency == nil {
+			cfg.Concurrency = &ConcurrencyConfig{}
+		}
+
+		cfg.Concurrency.Merge(sourceConfig.Concurrency)
+	}
+
 	// Copy only dependencies which doesn't exist in source
 	if sourceConfig.Dependencies != nil {
 		resultModuleDependencies := &ModuleDependencies{}
@@ -505,6 +566,7 @@ func (cfg *TerragruntConfig) DeepMerge(l log.Logger, sourceConfig *TerragruntCon
 	}
 
 	cfg.FeatureFlags = mergedFlags
+	cfg.Policies = mergePolicies(cfg.Policies, sourceConfig.Policies)
 
 	// Handle complex structs by recursively merging the structs together
 	if sourceConfig.Terraform != nil {
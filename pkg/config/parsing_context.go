@@ -12,6 +12,7 @@ import (
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/function"
 
+	"github.com/gruntwork-io/terragrunt/internal/cas"
 	"github.com/gruntwork-io/terragrunt/internal/engine"
 	"github.com/gruntwork-io/terragrunt/internal/experiment"
 	"github.com/gruntwork-io/terragrunt/internal/iacargs"
@@ -54,6 +55,9 @@ type ParsingContext struct {
 	FilesRead        *FilesRead
 	Telemetry        *telemetry.Options
 
+	// StackPreview is set when stacks are rendered for a dry run of stack generation instead of generated in place.
+	StackPreview *StackPreview
+
 	DecodedDependencies *cty.Value
 	Values              *cty.Value
 	Features            *cty.Value
@@ -89,6 +93,8 @@ type ParsingContext struct {
 
 	ProviderCacheOptions pcoptions.ProviderCacheOptions
 
+	CASRemote cas.RemoteOptions
+
 	MaxFoldersToCheck int
 	ParseDepth        int
 	CASCloneDepth     int
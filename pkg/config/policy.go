@@ -0,0 +1,205 @@
+package config
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/internal/runner/runcfg"
+)
+
+// changeWindowTimeLayout is the layout of the start and end times of a change window.
+const changeWindowTimeLayout = "15:04"
+
+// PolicyConfig is a guardrail evaluated against the plan of a unit before it is applied, e.g.
+//
+//	policy "no-db-deletes" {
+//	  deny_actions   = ["delete", "replace"]
+//	  resource_types = ["aws_db_instance"]
+//	}
+//
+//	policy "change-window" {
+//	  change_window {
+//	    days     = ["mon", "tue", "wed", "thu"]
+//	    start    = "09:00"
+//	    end      = "17:00"
+//	    timezone = "America/New_York"
+//	  }
+//	}
+type PolicyConfig struct {
+	// MaxReplacements is the maximum number of resources the plan may replace.
+	MaxReplacements *int `hcl:"max_replacements,attr" cty:"max_replacements"`
+	// MaxDestroys is the maximum number of resources the plan may destroy, including replacements.
+	MaxDestroys *int `hcl:"max_destroys,attr" cty:"max_destroys"`
+	// Message is shown when the policy is violated.
+	Message *string `hcl:"message,attr" cty:"message"`
+	// ChangeWindow restricts when the plan may change resources.
+	ChangeWindow *ChangeWindowConfig `hcl:"change_window,block" cty:"change_window"`
+	Name         string              `hcl:",label" cty:"name"`
+	// DenyActions are the actions the plan may not perform: create, update, delete or replace.
+	DenyActions []string `hcl:"deny_actions,optional" cty:"deny_actions"`
+	// ResourceTypes limits the policy to resources of the given types, which may contain glob patterns.
+	ResourceTypes []string `hcl:"resource_types,optional" cty:"resource_types"`
+}
+
+// ChangeWindowConfig is the time window in which a plan may change resources.
+type ChangeWindowConfig struct {
+	Timezone *string  `hcl:"timezone,attr" cty:"timezone"`
+	Start    string   `hcl:"start,attr" cty:"start"`
+	End      string   `hcl:"end,attr" cty:"end"`
+	Days     []string `hcl:"days,optional" cty:"days"`
+}
+
+// PolicyConfigs is a list of policies.
+type PolicyConfigs []*PolicyConfig
+
+// policyActions are the actions policies can deny.
+var policyActions = []string{
+	runcfg.PolicyActionCreate,
+	runcfg.PolicyActionUpdate,
+	runcfg.PolicyActionDelete,
+	runcfg.PolicyActionReplace,
+}
+
+// weekdays maps the accepted day names of a change window to weekdays.
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// Validate checks that the policy names are unique, and that every policy is valid.
+func (policies PolicyConfigs) Validate() error {
+	var duplicates []string
+
+	seen := make(map[string]struct{}, len(policies))
+
+	for _, policy := range policies {
+		if _, ok := seen[policy.Name]; ok {
+			duplicates = append(duplicates, policy.Name)
+
+			continue
+		}
+
+		seen[policy.Name] = struct{}{}
+
+		if _, err := policy.toRunConfig(); err != nil {
+			return err
+		}
+	}
+
+	if len(duplicates) > 0 {
+		return DuplicatedPolicyBlocksError{BlockName: duplicates}
+	}
+
+	return nil
+}
+
+// toRunConfig converts the policy to its run configuration, validating it.
+func (policy *PolicyConfig) toRunConfig() (runcfg.Policy, error) {
+	runPolicy := runcfg.Policy{
+		Name:            policy.Name,
+		DenyActions:     policy.DenyActions,
+		ResourceTypes:   policy.ResourceTypes,
+		MaxReplacements: policy.MaxReplacements,
+		MaxDestroys:     policy.MaxDestroys,
+	}
+
+	if policy.Message != nil {
+		runPolicy.Message = *policy.Message
+	}
+
+	for _, action := range policy.DenyActions {
+		if !slices.Contains(policyActions, action) {
+			return runPolicy, InvalidPolicyError{Name: policy.Name, Reason: "unknown action " + action + " in deny_actions, expected one of " + strings.Join(policyActions, ", ")}
+		}
+	}
+
+	if policy.MaxReplacements != nil && *policy.MaxReplacements < 0 {
+		return runPolicy, InvalidPolicyError{Name: policy.Name, Reason: "max_replacements must not be negative"}
+	}
+
+	if policy.MaxDestroys != nil && *policy.MaxDestroys < 0 {
+		return runPolicy, InvalidPolicyError{Name: policy.Name, Reason: "max_destroys must not be negative"}
+	}
+
+	if policy.ChangeWindow != nil {
+		changeWindow, err := policy.ChangeWindow.toRunConfig()
+		if err != nil {
+			return runPolicy, InvalidPolicyError{Name: policy.Name, Reason: err.Error()}
+		}
+
+		runPolicy.ChangeWindow = changeWindow
+	}
+
+	return runPolicy, nil
+}
+
+// toRunConfig converts the change window to its run configuration, validating it.
+func (window *ChangeWindowConfig) toRunConfig() (*runcfg.ChangeWindow, error) {
+	start, err := time.Parse(changeWindowTimeLayout, window.Start)
+	if err != nil {
+		return nil, InvalidChangeWindowError{Field: "start", Value: window.Start}
+	}
+
+	end, err := time.Parse(changeWindowTimeLayout, window.End)
+	if err != nil {
+		return nil, InvalidChangeWindowError{Field: "end", Value: window.End}
+	}
+
+	location := time.UTC
+
+	if window.Timezone != nil {
+		location, err = time.LoadLocation(*window.Timezone)
+		if err != nil {
+			return nil, InvalidChangeWindowError{Field: "timezone", Value: *window.Timezone}
+		}
+	}
+
+	changeWindow := &runcfg.ChangeWindow{
+		Start:    time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+		End:      time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute,
+		Location: location,
+	}
+
+	for _, day := range window.Days {
+		weekday, ok := weekdays[strings.ToLower(day)]
+		if !ok {
+			return nil, InvalidChangeWindowError{Field: "days", Value: day}
+		}
+
+		changeWindow.Days = append(changeWindow.Days, weekday)
+	}
+
+	return changeWindow, nil
+}
+
+// mergePolicies merges policies by name. Policies in the source override the policies in the target with the same name.
+func mergePolicies(targetPolicies PolicyConfigs, sourcePolicies PolicyConfigs) PolicyConfigs {
+	if sourcePolicies == nil && targetPolicies == nil {
+		return nil
+	}
+
+	merged := make(PolicyConfigs, 0, len(targetPolicies)+len(sourcePolicies))
+	merged = append(merged, targetPolicies...)
+
+	for _, policy := range sourcePolicies {
+		index := slices.IndexFunc(merged, func(existing *PolicyConfig) bool {
+			return existing.Name == policy.Name
+		})
+
+		if index >= 0 {
+			merged[index] = policy
+
+			continue
+		}
+
+		merged = append(merged, policy)
+	}
+
+	return merged
+}
@@ -0,0 +1,121 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terragrunt/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicyBlocks(t *testing.T) {
+	t.Parallel()
+
+	cfg := `
+policy "no-db-deletes" {
+  deny_actions   = ["delete", "replace"]
+  resource_types = ["aws_db_instance"]
+  message        = "Databases must not be deleted"
+}
+
+policy "change-window" {
+  max_replacements = 20
+
+  change_window {
+    days     = ["mon", "Tuesday"]
+    start    = "09:00"
+    end      = "17:30"
+    timezone = "UTC"
+  }
+}
+`
+
+	l := createLogger()
+
+	ctx, pctx := newTestParsingContext(t, config.DefaultTerragruntConfigPath)
+	terragruntConfig, err := config.ParseConfigString(ctx, pctx, l, config.DefaultTerragruntConfigPath, cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, terragruntConfig.Policies, 2)
+
+	runCfg := terragruntConfig.ToRunConfig(l)
+	require.Len(t, runCfg.Policies, 2)
+
+	noDBDeletes := runCfg.Policies[0]
+	assert.Equal(t, "no-db-deletes", noDBDeletes.Name)
+	assert.Equal(t, []string{"delete", "replace"}, noDBDeletes.DenyActions)
+	assert.Equal(t, []string{"aws_db_instance"}, noDBDeletes.ResourceTypes)
+	assert.Equal(t, "Databases must not be deleted", noDBDeletes.Message)
+
+	changeWindow := runCfg.Policies[1]
+	require.NotNil(t, changeWindow.MaxReplacements)
+	assert.Equal(t, 20, *changeWindow.MaxReplacements)
+	require.NotNil(t, changeWindow.ChangeWindow)
+	assert.Equal(t, []time.Weekday{time.Monday, time.Tuesday}, changeWindow.ChangeWindow.Days)
+	assert.Equal(t, 9*time.Hour, changeWindow.ChangeWindow.Start)
+	assert.Equal(t, 17*time.Hour+30*time.Minute, changeWindow.ChangeWindow.End)
+	assert.Equal(t, time.UTC, changeWindow.ChangeWindow.Location)
+}
+
+func TestParsePolicyBlocksInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		expected error
+		name     string
+		cfg      string
+	}{
+		{
+			name: "duplicated names",
+			cfg: `
+policy "limits" {
+  max_destroys = 1
+}
+
+policy "limits" {
+  max_replacements = 1
+}
+`,
+			expected: config.DuplicatedPolicyBlocksError{BlockName: []string{"limits"}},
+		},
+		{
+			name: "unknown action",
+			cfg: `
+policy "no-deletes" {
+  deny_actions = ["destroy"]
+}
+`,
+			expected: config.InvalidPolicyError{
+				Name:   "no-deletes",
+				Reason: "unknown action destroy in deny_actions, expected one of create, update, delete, replace",
+			},
+		},
+		{
+			name: "invalid change window",
+			cfg: `
+policy "office-hours" {
+  change_window {
+    start = "9am"
+    end   = "17:00"
+  }
+}
+`,
+			expected: config.InvalidPolicyError{
+				Name:   "office-hours",
+				Reason: config.InvalidChangeWindowError{Field: "start", Value: "9am"}.Error(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := createLogger()
+
+			ctx, pctx := newTestParsingContext(t, config.DefaultTerragruntConfigPath)
+			_, err := config.ParseConfigString(ctx, pctx, l, config.DefaultTerragruntConfigPath, tc.cfg, nil)
+			require.ErrorContains(t, err, tc.expected.Error())
+		})
+	}
+}
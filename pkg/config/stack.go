@@ -35,9 +35,11 @@ import (
 
 const (
 	// StackDir aliases inthclparse.StackDir so external callers (internal/stacks/output, etc.) keep their existing import path without a second source of truth.
-	StackDir      = inthclparse.StackDir
-	valuesFile    = "terragrunt.values.hcl"
-	manifestName  = ".terragrunt-stack-manifest"
+	StackDir = inthclparse.StackDir
+	// ManifestName is the file listing the files copied into a generated component.
+	ManifestName = ".terragrunt-stack-manifest"
+	// ValuesFile is the file the values of a generated component are written to.
+	ValuesFile    = "terragrunt.values.hcl"
 	unitDirPerm   = 0755
 	valueFilePerm = 0644
 )
@@ -117,6 +119,11 @@ func GenerateStackFile(ctx context.Context, l log.Logger, pctx *ParsingContext,
 
 	stackTargetDir := filepath.Join(stackSourceDir, StackDir)
 
+	// A dry run renders the stack into the preview, leaving the generated stack on disk untouched.
+	if pctx.StackPreview != nil {
+		stackTargetDir = pctx.StackPreview.Path(stackTargetDir)
+	}
+
 	// Perform a two-pass parse to resolve autoinclude blocks and generate
 	// terragrunt.autoinclude.hcl files.
 	autoIncludes, stackSrcBytes, err := resolveStackAutoIncludes(ctx, l, pctx, stackFilePath, stackFile, values)
@@ -130,7 +137,7 @@ func GenerateStackFile(ctx context.Context, l log.Logger, pctx *ParsingContext,
 		return err
 	}
 
-	cs, err := setupCAS(l, casEnabled, pctx.CASCloneDepth)
+	cs, err := setupCAS(l, casEnabled, pctx.CASCloneDepth, pctx.CASRemote)
 	if err != nil {
 		return err
 	}
@@ -144,6 +151,7 @@ func GenerateStackFile(ctx context.Context, l log.Logger, pctx *ParsingContext,
 		sourceFile:      stackFilePath,
 		sourceDir:       stackSourceDir,
 		targetDir:       stackTargetDir,
+		preview:         pctx.StackPreview,
 		autoIncludes:    autoIncludes,
 		stackSrcBytes:   stackSrcBytes,
 		casEnabled:      cs.Enabled,
@@ -199,7 +207,7 @@ func resolveStackAutoIncludes(ctx context.Context, l log.Logger, pctx *ParsingCo
 	}
 
 	// stackSrcBytes is read separately for the autoinclude parser, which slices expression byte ranges from the original file when generating terragrunt.autoinclude.hcl.
-	stackSrcBytes, err := os.ReadFile(stackFilePath)
+	stackSrcBytes, err := os.ReadFile(pctx.StackPreview.ReadPath(stackFilePath))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to read stack file bytes %s: %w", stackFilePath, err)
 	}
@@ -238,7 +246,7 @@ func resolveStackAutoIncludes(ctx context.Context, l log.Logger, pctx *ParsingCo
 	// The phased parser resolves autoincludes from the base stack file only. A sibling
 	// terragrunt.autoinclude.stack.hcl overrides same-name components wholesale, so an overridden
 	// component must not inherit the base block's resolved unit-level autoinclude.
-	if pruneErr := pruneOverriddenStackAutoIncludes(autoIncludes, stackSourceDir, prodEvalCtx, scopedPctx.ParserOptions); pruneErr != nil {
+	if pruneErr := pruneOverriddenStackAutoIncludes(autoIncludes, stackSourceDir, prodEvalCtx, scopedPctx.ParserOptions, scopedPctx.StackPreview); pruneErr != nil {
 		return nil, nil, AutoIncludeParserStageError{Stage: "autoinclude-override-prune", File: stackFilePath, Err: pruneErr}
 	}
 
@@ -326,7 +334,7 @@ type casSetup struct {
 // depth); transient setup failures log a warning and return an
 // Enabled=false bundle so the caller falls through to the standard
 // getter.
-func setupCAS(l log.Logger, enabled bool, cloneDepth int) (casSetup, error) {
+func setupCAS(l log.Logger, enabled bool, cloneDepth int, remote cas.RemoteOptions) (casSetup, error) {
 	if !enabled {
 		return casSetup{}, nil
 	}
@@ -335,7 +343,7 @@ func setupCAS(l log.Logger, enabled bool, cloneDepth int) (casSetup, error) {
 		return casSetup{}, err
 	}
 
-	c, err := cas.New(cas.WithCloneDepth(cloneDepth))
+	c, err := cas.New(cas.WithCloneDepth(cloneDepth), cas.WithRemote(remote))
 	if err != nil {
 		l.Warnf("Failed to initialize CAS for stack generation: %v. CAS features disabled.", err)
 		return casSetup{}, nil
@@ -354,6 +362,7 @@ func setupCAS(l log.Logger, enabled bool, cloneDepth int) (casSetup, error) {
 type generateOpts struct {
 	autoIncludes    map[string]*inthclparse.AutoIncludeResolved
 	casInstance     *cas.CAS
+	preview         *StackPreview
 	casVenv         cas.Venv
 	sourceMap       map[string]string
 	strictControls  strict.Controls
@@ -523,8 +532,9 @@ func validateGeneratedComponent(l log.Logger, cmp *componentToGenerate, opts *ge
 	return nil
 }
 
-// generateAutoInclude writes the autoinclude file for a component if one was resolved.
-func generateAutoInclude(l log.Logger, fs vfs.FS, opts *generateOpts, cmp *componentToGenerate, dest string) error {
+// generateAutoInclude writes the autoinclude file for a component if one was resolved. Paths in the file are relative
+// to generatedDest, the directory the component is generated in, even when a dry run writes it to another dest.
+func generateAutoInclude(l log.Logger, fs vfs.FS, opts *generateOpts, cmp *componentToGenerate, dest, generatedDest string) error {
 	if opts.autoIncludes == nil {
 		return nil
 	}
@@ -544,7 +554,28 @@ func generateAutoInclude(l log.Logger, fs vfs.FS, opts *generateOpts, cmp *compo
 	// The autoinclude resolves entirely in the stack file context, so the resolution-time eval context (functions
 	// scoped to the stack file, like the discovery path) is reused as-is: every expression except dependency.* is
 	// already a literal, and directory-context functions resolve where the autoinclude was authored.
-	if err := inthclparse.GenerateAutoIncludeFile(fs, resolved, dest, resolved.SourceBytes, resolved.EvalCtx); err != nil {
+	if dest == generatedDest {
+		if err := inthclparse.GenerateAutoIncludeFile(fs, resolved, dest, resolved.SourceBytes, resolved.EvalCtx); err != nil {
+			return fmt.Errorf("failed to write autoinclude for %s %s: %w", kind, cmp.name, err)
+		}
+
+		return nil
+	}
+
+	// Render the file at the path it would be generated at in memory, then copy it to dest.
+	memFS := vfs.NewMemMapFS()
+	if err := inthclparse.GenerateAutoIncludeFile(memFS, resolved, generatedDest, resolved.SourceBytes, resolved.EvalCtx); err != nil {
+		return fmt.Errorf("failed to write autoinclude for %s %s: %w", kind, cmp.name, err)
+	}
+
+	fileName := inthclparse.AutoIncludeFileNameForKind(resolved.Kind)
+
+	content, err := vfs.ReadFile(memFS, filepath.Join(generatedDest, fileName))
+	if err != nil {
+		return fmt.Errorf("failed to write autoinclude for %s %s: %w", kind, cmp.name, err)
+	}
+
+	if err := vfs.WriteFile(fs, filepath.Join(dest, fileName), content, valueFilePerm); err != nil {
 		return fmt.Errorf("failed to write autoinclude for %s %s: %w", kind, cmp.name, err)
 	}
 
@@ -565,6 +596,26 @@ func generateComponent(ctx context.Context, l log.Logger, fs vfs.FS, opts *gener
 		return err
 	}
 
+	// generatedDest is where the component is generated, which is not dest when a dry run renders it into the preview.
+	generatedDest := dest
+
+	if opts.preview != nil {
+		generatedDest = filepath.Join(opts.sourceDir, StackDir, cmp.path)
+
+		if cmp.noStack {
+			generatedDest = filepath.Join(opts.sourceDir, cmp.path)
+			opts.preview.AddNoStackDir(generatedDest)
+		}
+
+		// A local source within a stack rendered by the dry run is read from the preview.
+		if !filepath.IsAbs(source) {
+			local := filepath.Join(cmp.sourceDir, source)
+			if rendered := opts.preview.ReadPath(local); rendered != local {
+				source = rendered
+			}
+		}
+	}
+
 	kindStr := "unit"
 	if cmp.kind == stackKind {
 		kindStr = "stack"
@@ -590,7 +641,7 @@ func generateComponent(ctx context.Context, l log.Logger, fs vfs.FS, opts *gener
 		return fmt.Errorf("failed to write values %v %w", cmp.name, err)
 	}
 
-	return generateAutoInclude(l, fs, opts, cmp, dest)
+	return generateAutoInclude(l, fs, opts, cmp, dest, generatedDest)
 }
 
 // fetchComponentSource handles the paths for fetching a component's source:
@@ -709,7 +760,7 @@ func fetchViaCAS(
 	// A copy failure can leave partial content in dest, so reset it before
 	// falling through to the standard getter. ProcessStackComponent writes only
 	// to its own temp dir, so failures before this point never touch dest.
-	if copyErr := util.CopyFolderContentsWithFilter(l, result.ContentDir, dest, manifestName, func(_ string) bool {
+	if copyErr := util.CopyFolderContentsWithFilter(l, result.ContentDir, dest, ManifestName, func(_ string) bool {
 		return true
 	}); copyErr != nil {
 		if cleanupErr := os.RemoveAll(dest); cleanupErr != nil && !errors.Is(cleanupErr, os.ErrNotExist) {
@@ -780,7 +831,7 @@ func copyFiles(ctx context.Context, l log.Logger, identifier, sourceDir, src, de
 
 	localSrc = filepath.Clean(localSrc)
 
-	if err := util.CopyFolderContentsWithFilter(l, localSrc, dest, manifestName, func(absolutePath string) bool {
+	if err := util.CopyFolderContentsWithFilter(l, localSrc, dest, ManifestName, func(absolutePath string) bool {
 		return true
 	}); err != nil {
 		return fmt.Errorf("failed to copy %s to %s %w", localSrc, dest, err)
@@ -809,22 +860,18 @@ func isLocal(_ log.Logger, workingDir, src string) bool {
 }
 
 // ReadOutputs retrieves the OpenTofu/Terraform output JSON for this unit, converts it into a map of cty.Values,
-// and logs the operation for debugging. It returns early in case of any errors during retrieval or conversion.
-func (u *Unit) ReadOutputs(ctx context.Context, l log.Logger, pctx *ParsingContext, unitDir string) (map[string]cty.Value, error) {
+// and logs the operation for debugging. It also returns the set of outputs declared sensitive. It returns early in
+// case of any errors during retrieval or conversion.
+func (u *Unit) ReadOutputs(ctx context.Context, l log.Logger, pctx *ParsingContext, unitDir string) (map[string]cty.Value, map[string]bool, error) {
 	configPath := filepath.Join(unitDir, DefaultTerragruntConfigPath)
 	l.Debugf("Getting output from unit %s in %s", u.Name, unitDir)
 
 	jsonBytes, err := getOutputJSONWithCaching(ctx, pctx, l, configPath)
 	if err != nil {
-		return nil, err
-	}
-
-	outputMap, err := TerraformOutputJSONToCtyValueMap(configPath, jsonBytes)
-	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return outputMap, nil
+	return ParseTerraformOutputJSON(configPath, jsonBytes)
 }
 
 // ReadStackConfigFile reads and parses a Terragrunt stack configuration file from the given path.
@@ -837,7 +884,7 @@ func ReadStackConfigFile(ctx context.Context, l log.Logger, pctx *ParsingContext
 	stackPctx.TerragruntConfigPath = filePath
 	stackPctx.OriginalTerragruntConfigPath = filePath
 
-	file, err := hclparse.NewParser(stackPctx.ParserOptions...).ParseFromFile(filePath)
+	file, err := parseStackFile(stackPctx.StackPreview, stackPctx.ParserOptions, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -884,7 +931,7 @@ func ParseStackConfig(ctx context.Context, l log.Logger, parser *ParsingContext,
 	// Expose unit.<name>.path / stack.<name>.path so a unit or stack block's values
 	// can reference where sibling components generate to (e.g. to pass a unit path
 	// down to a child stack).
-	if err := injectStackComponentRefs(file, evalParsingContext, filepath.Dir(file.ConfigPath), parser.ParserOptions); err != nil {
+	if err := injectStackComponentRefs(file, evalParsingContext, filepath.Dir(file.ConfigPath), parser.ParserOptions, parser.StackPreview); err != nil {
 		return nil, err
 	}
 
@@ -896,11 +943,11 @@ func ParseStackConfig(ctx context.Context, l log.Logger, parser *ParsingContext,
 	// Process include blocks and merge any generated stack-level autoinclude file.
 	stackDir := filepath.Dir(file.ConfigPath)
 
-	if err := processStackConfigIncludes(config, stackDir, evalParsingContext, parser.ParserOptions); err != nil {
+	if err := processStackConfigIncludes(config, stackDir, evalParsingContext, parser.ParserOptions, parser.StackPreview); err != nil {
 		return nil, err
 	}
 
-	if err := mergeStackAutoIncludeFile(l, config, stackDir, filepath.Base(file.ConfigPath), evalParsingContext, parser.ParserOptions); err != nil {
+	if err := mergeStackAutoIncludeFile(l, config, stackDir, filepath.Base(file.ConfigPath), evalParsingContext, parser.ParserOptions, parser.StackPreview); err != nil {
 		return nil, err
 	}
 
@@ -957,7 +1004,7 @@ func (h *stackComponentHeader) GeneratedPath(stackDir string) string {
 // evaluated. A sibling terragrunt.autoinclude.stack.hcl is folded by name so an
 // overridden component's path reflects the override, not the stale base path.
 // stackDir is the directory containing the stack file.
-func injectStackComponentRefs(file *hclparse.File, evalCtx *hcl.EvalContext, stackDir string, parserOpts []hclparse.Option) error {
+func injectStackComponentRefs(file *hclparse.File, evalCtx *hcl.EvalContext, stackDir string, parserOpts []hclparse.Option, preview *StackPreview) error {
 	headers := &stackComponentHeaders{}
 	if err := file.Decode(headers, evalCtx); err != nil {
 		return err
@@ -967,7 +1014,7 @@ func injectStackComponentRefs(file *hclparse.File, evalCtx *hcl.EvalContext, sta
 	// stack.<name>.path can resolve against the base components, matching how the full decode resolves them.
 	setStackComponentRefVars(evalCtx, stackDir, headers.Units, headers.Stacks)
 
-	autoUnits, autoStacks, err := stackAutoIncludeComponentHeaders(stackDir, evalCtx, parserOpts)
+	autoUnits, autoStacks, err := stackAutoIncludeComponentHeaders(stackDir, evalCtx, parserOpts, preview)
 	if err != nil {
 		return err
 	}
@@ -1008,13 +1055,13 @@ func setStackComponentRefVars(evalCtx *hcl.EvalContext, stackDir string, units,
 
 // stackAutoIncludeComponentHeaders decodes the unit and stack block headers (name and path only) declared
 // by a sibling terragrunt.autoinclude.stack.hcl. It returns nil slices when no autoinclude file exists.
-func stackAutoIncludeComponentHeaders(stackDir string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option) ([]*stackComponentHeader, []*stackComponentHeader, error) {
+func stackAutoIncludeComponentHeaders(stackDir string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option, preview *StackPreview) ([]*stackComponentHeader, []*stackComponentHeader, error) {
 	autoIncludePath := filepath.Join(stackDir, inthclparse.AutoIncludeStackFile)
-	if !util.FileExists(autoIncludePath) {
+	if !util.FileExists(preview.ReadPath(autoIncludePath)) {
 		return nil, nil, nil
 	}
 
-	incFile, err := hclparse.NewParser(parserOpts...).ParseFromFile(autoIncludePath)
+	incFile, err := parseStackFile(preview, parserOpts, autoIncludePath)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to read stack autoinclude %q: %w", autoIncludePath, err)
 	}
@@ -1054,13 +1101,13 @@ type stackComponentLabels struct {
 // terragrunt.autoinclude.stack.hcl without evaluating their path expressions, so callers that only need
 // names do not depend on local.*/unit.*/stack.* being populated in the eval context. It returns nil slices
 // when no autoinclude file exists.
-func stackAutoIncludeComponentNames(stackDir string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option) (unitNames, stackNames []string, err error) {
+func stackAutoIncludeComponentNames(stackDir string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option, preview *StackPreview) (unitNames, stackNames []string, err error) {
 	autoIncludePath := filepath.Join(stackDir, inthclparse.AutoIncludeStackFile)
-	if !util.FileExists(autoIncludePath) {
+	if !util.FileExists(preview.ReadPath(autoIncludePath)) {
 		return nil, nil, nil
 	}
 
-	incFile, err := hclparse.NewParser(parserOpts...).ParseFromFile(autoIncludePath)
+	incFile, err := parseStackFile(preview, parserOpts, autoIncludePath)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to read stack autoinclude %q: %w", autoIncludePath, err)
 	}
@@ -1094,12 +1141,12 @@ func stackAutoIncludeComponentNames(stackDir string, evalCtx *hcl.EvalContext, p
 // the base block's autoinclude (the override is wholesale). A newly injected name has no base entry, so
 // pruning it is a no-op. It reads only block names so it never evaluates an injected path expression that
 // the generate-path eval context cannot resolve.
-func pruneOverriddenStackAutoIncludes(autoIncludes map[string]*inthclparse.AutoIncludeResolved, stackDir string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option) error {
+func pruneOverriddenStackAutoIncludes(autoIncludes map[string]*inthclparse.AutoIncludeResolved, stackDir string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option, preview *StackPreview) error {
 	if len(autoIncludes) == 0 {
 		return nil
 	}
 
-	unitNames, stackNames, err := stackAutoIncludeComponentNames(stackDir, evalCtx, parserOpts)
+	unitNames, stackNames, err := stackAutoIncludeComponentNames(stackDir, evalCtx, parserOpts, preview)
 	if err != nil {
 		return err
 	}
@@ -1119,14 +1166,14 @@ func pruneOverriddenStackAutoIncludes(autoIncludes map[string]*inthclparse.AutoI
 // It reads each included file, parses it with the same eval context, and merges
 // its units and stacks into the main config so generation sees all components,
 // not just those in the root file.
-func processStackConfigIncludes(config *StackConfigFile, stackDir string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option) error {
+func processStackConfigIncludes(config *StackConfigFile, stackDir string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option, preview *StackPreview) error {
 	for _, inc := range config.Includes {
 		includePath := inc.Path
 		if !filepath.IsAbs(includePath) {
 			includePath = filepath.Join(stackDir, includePath)
 		}
 
-		incFile, err := hclparse.NewParser(parserOpts...).ParseFromFile(includePath)
+		incFile, err := parseStackFile(preview, parserOpts, includePath)
 		if err != nil {
 			return fmt.Errorf("failed to read include %q: %w", inc.Name, err)
 		}
@@ -1177,18 +1224,18 @@ func processStackConfigIncludes(config *StackConfigFile, stackDir string, evalCt
 // beside the stack file, into the stack config. Units and stacks injected by a parent stack's
 // autoinclude block materialize in the nested stack the same way a unit's
 // terragrunt.autoinclude.hcl merges into its terragrunt.hcl via [mergeAutoIncludeIfPresent].
-func mergeStackAutoIncludeFile(l log.Logger, config *StackConfigFile, stackDir, stackFileName string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option) error {
+func mergeStackAutoIncludeFile(l log.Logger, config *StackConfigFile, stackDir, stackFileName string, evalCtx *hcl.EvalContext, parserOpts []hclparse.Option, preview *StackPreview) error {
 	// Never merge the autoinclude file into itself.
 	if stackFileName == inthclparse.AutoIncludeStackFile {
 		return nil
 	}
 
 	autoIncludePath := filepath.Join(stackDir, inthclparse.AutoIncludeStackFile)
-	if !util.FileExists(autoIncludePath) {
+	if !util.FileExists(preview.ReadPath(autoIncludePath)) {
 		return nil
 	}
 
-	incFile, err := hclparse.NewParser(parserOpts...).ParseFromFile(autoIncludePath)
+	incFile, err := parseStackFile(preview, parserOpts, autoIncludePath)
 	if err != nil {
 		return fmt.Errorf("failed to read stack autoinclude %q: %w", autoIncludePath, err)
 	}
@@ -1316,7 +1363,7 @@ func writeValues(l log.Logger, values *cty.Value, directory string) error {
 	}
 
 	l.Debugf("Writing values file in %s", directory)
-	filePath := filepath.Join(directory, valuesFile)
+	filePath := filepath.Join(directory, ValuesFile)
 
 	file := hclwrite.NewEmptyFile()
 	body := file.Body()
@@ -1362,15 +1409,15 @@ func ReadValues(ctx context.Context, pctx *ParsingContext, l log.Logger, directo
 		return nil, errors.New("ReadValues: directory path cannot be empty")
 	}
 
-	filePath := filepath.Join(directory, valuesFile)
+	filePath := filepath.Join(directory, ValuesFile)
 
-	if util.FileNotExists(filePath) {
+	if util.FileNotExists(pctx.StackPreview.ReadPath(filePath)) {
 		return nil, nil
 	}
 
 	l.Debugf("Reading Terragrunt stack values file at %s", filePath)
 
-	file, err := hclparse.NewParser(pctx.ParserOptions...).ParseFromFile(filePath)
+	file, err := parseStackFile(pctx.StackPreview, pctx.ParserOptions, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gruntwork-io/terragrunt/internal/util"
+	"github.com/gruntwork-io/terragrunt/pkg/config/hclparse"
+)
+
+// StackPreview is the temporary directory a dry run of stack generation renders stacks into. Every generated path
+// is rendered at the same absolute path under Dir, so the generated stacks on disk are left untouched. The stack
+// files rendered into the preview are parsed at the path they would be generated at, reading their files from the
+// preview, so their paths resolve as they would after generation.
+type StackPreview struct {
+	noStackDirs map[string]struct{}
+	// Dir is the temporary directory the stacks are rendered into.
+	Dir string
+	mu  sync.Mutex
+}
+
+// NewStackPreview returns a preview rendering stacks into dir.
+func NewStackPreview(dir string) *StackPreview {
+	return &StackPreview{
+		Dir:         dir,
+		noStackDirs: make(map[string]struct{}),
+	}
+}
+
+// Path returns the path path is rendered at in the preview.
+func (preview *StackPreview) Path(path string) string {
+	return filepath.Join(preview.Dir, strings.TrimPrefix(path, filepath.VolumeName(path)))
+}
+
+// ReadPath returns the path to read the file at path from: its rendering in the preview if there is one, and path
+// otherwise. It returns path unchanged on a nil preview.
+func (preview *StackPreview) ReadPath(path string) string {
+	if preview == nil {
+		return path
+	}
+
+	if rendered := preview.Path(path); util.FileExists(rendered) {
+		return rendered
+	}
+
+	return path
+}
+
+// AddNoStackDir records dir as the directory a no_dot_terragrunt_stack component would be generated in, next to the
+// stack file generating it instead of in its stack directory.
+func (preview *StackPreview) AddNoStackDir(dir string) {
+	preview.mu.Lock()
+	defer preview.mu.Unlock()
+
+	preview.noStackDirs[dir] = struct{}{}
+}
+
+// NoStackDirs returns the directories recorded with [StackPreview.AddNoStackDir], sorted.
+func (preview *StackPreview) NoStackDirs() []string {
+	preview.mu.Lock()
+	defer preview.mu.Unlock()
+
+	dirs := make([]string, 0, len(preview.noStackDirs))
+	for dir := range preview.noStackDirs {
+		dirs = append(dirs, dir)
+	}
+
+	slices.Sort(dirs)
+
+	return dirs
+}
+
+// parseStackFile parses the file at path, reading it from its rendering in the preview, if any, of a dry run.
+func parseStackFile(preview *StackPreview, parserOpts []hclparse.Option, path string) (*hclparse.File, error) {
+	parser := hclparse.NewParser(parserOpts...)
+
+	readPath := preview.ReadPath(path)
+	if readPath == path {
+		return parser.ParseFromFile(path)
+	}
+
+	content, err := os.ReadFile(readPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return parser.ParseFromBytes(content, path)
+}
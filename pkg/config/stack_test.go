@@ -422,3 +422,23 @@ terraform {
 	combined := stdout + "\n" + stderr + "\n" + err.Error()
 	assert.Contains(t, combined, "expected object or map")
 }
+
+func TestStackPreviewReadPath(t *testing.T) {
+	t.Parallel()
+
+	workingDir := helpers.TmpDirWOSymlinks(t)
+	preview := config.NewStackPreview(helpers.TmpDirWOSymlinks(t))
+
+	rendered := filepath.Join(workingDir, config.StackDir, "app", config.DefaultStackFile)
+	onDisk := filepath.Join(workingDir, config.DefaultStackFile)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(preview.Path(rendered)), 0755))
+	require.NoError(t, os.WriteFile(preview.Path(rendered), []byte(""), 0644))
+
+	assert.Equal(t, filepath.Join(preview.Dir, rendered), preview.ReadPath(rendered))
+	assert.Equal(t, onDisk, preview.ReadPath(onDisk))
+
+	var noPreview *config.StackPreview
+
+	assert.Equal(t, rendered, noPreview.ReadPath(rendered))
+}
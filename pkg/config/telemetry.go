@@ -117,6 +117,8 @@ func partialDecodeSectionName(section PartialDecodeSectionType) string {
 		return "exclude"
 	case ErrorsBlock:
 		return "errors"
+	case ConcurrencyBlock:
+		return "concurrency"
 	default:
 		return "unknown"
 	}
@@ -22,6 +22,8 @@ func (cfg *TerragruntConfig) ToRunConfig(l log.Logger) *runcfg.RunConfig {
 		GenerateConfigs:             translateGenerateConfigs(cfg.GenerateConfigs),
 		Inputs:                      translateInputs(cfg.Inputs),
 		IAMRole:                     cfg.GetIAMRoleOptions(),
+		GCPCredentials:              cfg.GetGCPCredentialsOptions(),
+		AzureCredentials:            cfg.GetAzureCredentialsOptions(),
 		DownloadDir:                 cfg.DownloadDir,
 		TerraformBinary:             cfg.TerraformBinary,
 		TerraformVersionConstraint:  cfg.TerraformVersionConstraint,
@@ -31,6 +33,7 @@ func (cfg *TerragruntConfig) ToRunConfig(l log.Logger) *runcfg.RunConfig {
 		Dependencies:                translateModuleDependencies(cfg.Dependencies),
 		Engine:                      translateEngineConfig(cfg.Engine),
 		Errors:                      translateErrorsConfig(cfg.Errors),
+		Policies:                    translatePolicies(cfg.Policies, l),
 	}
 
 	return runCfg
@@ -290,6 +293,29 @@ func translateExcludeConfig(exclude *ExcludeConfig) runcfg.ExcludeConfig {
 	}
 }
 
+// translatePolicies converts PolicyConfigs to []runcfg.Policy.
+// Policies are validated when the configuration is parsed, so an invalid policy is only logged and skipped.
+func translatePolicies(policies PolicyConfigs, l log.Logger) []runcfg.Policy {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	result := make([]runcfg.Policy, 0, len(policies))
+
+	for _, policy := range policies {
+		runPolicy, err := policy.toRunConfig()
+		if err != nil {
+			l.Errorf("Skipping policy %s: %v", policy.Name, err)
+
+			continue
+		}
+
+		result = append(result, runPolicy)
+	}
+
+	return result
+}
+
 // translateIncludeConfigs converts IncludeConfigsMap to map[string]runcfg.IncludeConfig.
 func translateIncludeConfigs(includes IncludeConfigsMap) map[string]runcfg.IncludeConfig {
 	if includes == nil {
@@ -373,8 +399,9 @@ func translateErrorsConfig(errors *ErrorsConfig) runcfg.ErrorsConfig {
 	}
 
 	return runcfg.ErrorsConfig{
-		Retry:  translateRetryBlocks(errors.Retry),
-		Ignore: translateIgnoreBlocks(errors.Ignore),
+		Retry:   translateRetryBlocks(errors.Retry),
+		Ignore:  translateIgnoreBlocks(errors.Ignore),
+		Explain: translateExplainBlocks(errors.Explain),
 	}
 }
 
@@ -401,6 +428,29 @@ func translateRetryBlocks(blocks []*RetryBlock) []*runcfg.RetryBlock {
 	return result
 }
 
+// translateExplainBlocks converts []*ExplainBlock to []*runcfg.ExplainBlock.
+func translateExplainBlocks(blocks []*ExplainBlock) []*runcfg.ExplainBlock {
+	if blocks == nil {
+		return nil
+	}
+
+	result := make([]*runcfg.ExplainBlock, len(blocks))
+	for i, block := range blocks {
+		if block == nil {
+			continue
+		}
+
+		result[i] = &runcfg.ExplainBlock{
+			Label:             block.Label,
+			ExplainableErrors: block.ExplainableErrors,
+			Message:           block.Message,
+			Command:           block.Command,
+		}
+	}
+
+	return result
+}
+
 // translateIgnoreBlocks converts []*IgnoreBlock to []*runcfg.IgnoreBlock.
 func translateIgnoreBlocks(blocks []*IgnoreBlock) []*runcfg.IgnoreBlock {
 	if blocks == nil {
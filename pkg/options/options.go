@@ -13,6 +13,7 @@ import (
 
 	"errors"
 
+	"github.com/gruntwork-io/terragrunt/internal/cas"
 	"github.com/gruntwork-io/terragrunt/internal/cloner"
 	"github.com/gruntwork-io/terragrunt/internal/engine"
 	"github.com/gruntwork-io/terragrunt/internal/errorconfig"
@@ -109,7 +110,11 @@ type TerragruntOptions struct {
 	// Current Terraform command being executed by Terragrunt
 	TerraformCommand string
 	// StackOutputFormat format how the stack output is rendered.
-	StackOutputFormat         string
+	StackOutputFormat string
+	// StackOutputShowSensitive renders sensitive outputs in the stack output instead of masking them.
+	StackOutputShowSensitive bool
+	// StackGenerateFormat format how the stack generate dry run diff is rendered.
+	StackGenerateFormat       string
 	TerragruntStackConfigPath string
 	// Location of the original Terragrunt config file.
 	OriginalTerragruntConfigPath string
@@ -159,6 +164,9 @@ type TerragruntOptions struct {
 	ReportFormat report.Format
 	// Path to the report schema file.
 	ReportSchemaFile string
+	// Path to the checkpoint file of a run --all. Setting it enables checkpoints. With --resume alone,
+	// .terragrunt-cache/run-checkpoint.json in the working dir is used.
+	CheckpointFile string
 	// CLI args that are intended for Terraform (i.e. all the CLI args except the --terragrunt ones)
 	TerraformCliArgs *iacargs.IacArgs
 	// Files with variables to be used in modules scaffolding.
@@ -171,6 +179,9 @@ type TerragruntOptions struct {
 	StrictControls strict.Controls `clone:"shadowcopy"`
 	// Filters contains parsed filter objects for component selection.
 	Filters filter.Filters `clone:"shadowcopy"`
+	// FilterAliases contains filter queries referencing named filters (e.g. @prod-network),
+	// which are expanded into Filters during setup.
+	FilterAliases []string
 	// When set, it will be used to compute the cache key for `-version` checks.
 	VersionManagerFileName []string
 	// Experiments is a map of experiments, and their status.
@@ -187,6 +198,16 @@ type TerragruntOptions struct {
 	// repository. Defaults to 1 (see internal/cas.DefaultCASCloneDepth). Values must be
 	// positive (git rejects --depth 0) or negative (e.g. -1) for a full clone without --depth.
 	CASCloneDepth int
+	// CASAutoGCMaxAge enables automatic garbage collection of the CAS store after runs, dropping
+	// content not used within this duration. Zero disables the age limit.
+	CASAutoGCMaxAge time.Duration
+	// CASAutoGCMaxSize enables automatic garbage collection of the CAS store after runs, keeping
+	// the content reachable from the most recently used trees within this size in bytes.
+	// Zero disables the size limit.
+	CASAutoGCMaxSize int64
+	// CASRemote configures the remote tier the CAS store pulls from on a local
+	// miss and pushes to after a fetch. Disabled when CASRemote.URL is empty.
+	CASRemote cas.RemoteOptions
 	// Output Terragrunt logs in JSON format
 	JSONLogFormat bool
 	// True if terragrunt should run in debug mode
@@ -257,6 +278,8 @@ type TerragruntOptions struct {
 	NoStackGenerate bool
 	// NoStackValidate disable generated stack validation.
 	NoStackValidate bool
+	// StackGenerateDryRun renders stacks into a temporary directory instead of generating them in place.
+	StackGenerateDryRun bool
 	// NoCAS disables the CAS feature even when the experiment is enabled.
 	NoCAS bool
 	// RunAll runs the provided OpenTofu/Terraform command against a stack.
@@ -272,10 +295,16 @@ type TerragruntOptions struct {
 	ForceBackendDelete bool
 	// ForceBackendMigrate forces the backend to be migrated, even if the bucket is not versioned.
 	ForceBackendMigrate bool
+	// DryRunBackendMigrate prints the state that would be migrated, without migrating it.
+	DryRunBackendMigrate bool
 	// SummaryDisable disables the summary output at the end of a run.
 	SummaryDisable bool
 	// SummaryPerUnit enables showing duration information for each unit in the summary.
 	SummaryPerUnit bool
+	// Resume resumes a run --all from its checkpoint, if there is one, skipping the units that already succeeded.
+	Resume bool
+	// Dashboard shows an interactive dashboard of the run queue during a run --all.
+	Dashboard bool
 	// NoAutoProviderCacheDir disables the auto-provider-cache-dir feature even when the experiment is enabled.
 	NoAutoProviderCacheDir bool
 	// NoDependencyFetchOutputFromState disables the
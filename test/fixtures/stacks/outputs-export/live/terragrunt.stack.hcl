@@ -0,0 +1,4 @@
+stack "infra" {
+	source = "${get_repo_root()}/stacks/infra"
+	path   = "infra"
+}
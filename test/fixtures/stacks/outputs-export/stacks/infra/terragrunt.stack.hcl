@@ -0,0 +1,4 @@
+unit "network" {
+	source = "${get_repo_root()}/units/network"
+	path   = "network"
+}
@@ -0,0 +1,12 @@
+output "vpc_id" {
+  value = "vpc-123"
+}
+
+output "subnet_ids" {
+  value = ["subnet-a", "subnet-b"]
+}
+
+output "db_password" {
+  value     = "hunter2"
+  sensitive = true
+}
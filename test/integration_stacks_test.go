@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/hcl/v2"
 
+	"github.com/gruntwork-io/terragrunt/internal/component"
 	"github.com/gruntwork-io/terragrunt/internal/discovery"
 	"github.com/gruntwork-io/terragrunt/internal/git"
 	"github.com/gruntwork-io/terragrunt/internal/runner/run"
@@ -37,6 +38,7 @@ const (
 	testFixtureStacksRemote                    = "fixtures/stacks/remote"
 	testFixtureStacksInputs                    = "fixtures/stacks/inputs"
 	testFixtureStacksOutputs                   = "fixtures/stacks/outputs"
+	testFixtureStacksOutputsExport             = "fixtures/stacks/outputs-export"
 	testFixtureStacksUnitValues                = "fixtures/stacks/unit-values"
 	testFixtureStacksLocalsError               = "fixtures/stacks/errors/locals-error"
 	testFixtureStacksUnitEmptyPath             = "fixtures/stacks/errors/unit-empty-path"
@@ -185,6 +187,69 @@ func TestNestedStacksGenerate(t *testing.T) {
 	validateStackDir(t, path)
 }
 
+func TestNestedStacksGenerateDryRun(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureNestedStacks)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureNestedStacks)
+	gitPath := filepath.Join(tmpEnvPath, testFixtureNestedStacks)
+
+	runner, err := git.NewGitRunner(vexec.NewOSExec())
+	require.NoError(t, err)
+
+	runner = runner.WithWorkDir(gitPath)
+
+	err = runner.Init(t.Context())
+	require.NoError(t, err)
+
+	rootPath := filepath.Join(gitPath, "live")
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack generate --dry-run --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout, "+ stack .terragrunt-stack/dev")
+	assert.Contains(t, stdout, "+ stack .terragrunt-stack/prod")
+	assert.Contains(t, stdout, "Stack generation would add 8 and change 0 components.")
+	assert.NoDirExists(t, filepath.Join(rootPath, ".terragrunt-stack"))
+	assert.NoDirExists(t, filepath.Join(rootPath, ".terragrunt-stack-preview"))
+
+	helpers.RunTerragrunt(t, "terragrunt stack generate --working-dir "+rootPath)
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack generate --dry-run --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "No changes.")
+
+	devStackFile := filepath.Join(gitPath, "stacks", "dev", "terragrunt.stack.hcl")
+
+	content, err := os.ReadFile(devStackFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(devStackFile, bytes.ReplaceAll(content, []byte("dev-api 1.0.0"), []byte("dev-api 1.1.0")), 0644))
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack generate --dry-run --format json --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	var diff generate.Diff
+	require.NoError(t, json.Unmarshal([]byte(stdout), &diff))
+
+	require.Len(t, diff.Components, 2)
+	assert.Equal(t, ".terragrunt-stack/dev", diff.Components[0].Path)
+	assert.Equal(t, generate.ChangeChanged, diff.Components[0].Change)
+
+	api := diff.Components[1]
+	assert.Equal(t, ".terragrunt-stack/dev/.terragrunt-stack/api", api.Path)
+	assert.Equal(t, component.UnitKind, api.Kind)
+	assert.Equal(t, generate.ChangeChanged, api.Change)
+	assert.Equal(t, []*generate.ValueDiff{{
+		Name:   "ver",
+		Change: generate.ChangeChanged,
+		Before: `"dev-api 1.0.0"`,
+		After:  `"dev-api 1.1.0"`,
+	}}, api.Values)
+
+	validateStackDir(t, filepath.Join(rootPath, ".terragrunt-stack"))
+	assert.NoDirExists(t, filepath.Join(rootPath, ".terragrunt-stack-preview"))
+}
+
 func TestStacksGenerateErrorOnCoexistingHclAndStackFiles(t *testing.T) {
 	t.Parallel()
 
@@ -482,6 +547,59 @@ func TestStackOutputs(t *testing.T) {
 	assert.Len(t, attr, 4)
 }
 
+func TestStackOutputsExportFormats(t *testing.T) {
+	t.Parallel()
+
+	helpers.CleanupTerraformFolder(t, testFixtureStacksOutputsExport)
+	tmpEnvPath := helpers.CopyEnvironment(t, testFixtureStacksOutputsExport)
+	gitPath := filepath.Join(tmpEnvPath, testFixtureStacksOutputsExport)
+
+	runner, err := git.NewGitRunner(vexec.NewOSExec())
+	require.NoError(t, err)
+
+	runner = runner.WithWorkDir(gitPath)
+
+	err = runner.Init(t.Context())
+	require.NoError(t, err)
+
+	rootPath := filepath.Join(gitPath, "live")
+
+	helpers.RunTerragrunt(t, "terragrunt stack run apply --non-interactive --working-dir "+rootPath)
+
+	stdout, _, err := helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack output --format dotenv --non-interactive --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `INFRA_NETWORK_VPC_ID="vpc-123"`)
+	assert.Contains(t, stdout, `INFRA_NETWORK_SUBNET_IDS="[\"subnet-a\",\"subnet-b\"]"`)
+	assert.Contains(t, stdout, `INFRA_NETWORK_DB_PASSWORD="<sensitive>"`)
+	assert.NotContains(t, stdout, "hunter2")
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack output infra.network.vpc_id --format dotenv --non-interactive --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Equal(t, `INFRA_NETWORK_VPC_ID="vpc-123"`, strings.TrimSpace(stdout))
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack output infra.network.db_password --format raw --show-sensitive --non-interactive --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", strings.TrimSpace(stdout))
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack output infra.network --format yaml --non-interactive --working-dir "+rootPath)
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"vpc_id": "vpc-123"`)
+
+	stdout, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack output --format tfvars --non-interactive --working-dir "+rootPath)
+	require.NoError(t, err)
+
+	parser := hclparse.NewParser()
+	file, diags := parser.ParseHCL([]byte(stdout), "outputs.tfvars")
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	attrs, _ := file.Body.JustAttributes()
+	assert.Contains(t, attrs, "infra")
+
+	_, _, err = helpers.RunTerragruntCommandWithOutput(t, "terragrunt stack output infra.missing --non-interactive --working-dir "+rootPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"infra" has no element "missing"`)
+}
+
 func TestStackOutputsRaw(t *testing.T) {
 	t.Parallel()
 